@@ -61,11 +61,12 @@ type KonnectExtensionList struct {
 }
 
 // KonnectExtensionSpec defines the desired state of KonnectExtension.
+// +kubebuilder:validation:XValidation:rule="!has(self.konnectControlPlaneAPIAuthConfiguration.clusterCertificateProvisioning) || self.konnectControlPlaneAPIAuthConfiguration.clusterCertificateProvisioning != 'Automatic' || self.controlPlaneRef.type == 'konnectNamespacedRef'", message="Automatic cluster certificate provisioning requires a konnectNamespacedRef controlPlaneRef."
 // +apireference:kgo:include
 type KonnectExtensionSpec struct {
 	// ControlPlaneRef is a reference to a ControlPlane this KonnectExtension is associated with.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self.type == 'konnectID'", message="Only konnectID type currently supported as controlPlaneRef."
+	// +kubebuilder:validation:XValidation:rule="self.type == 'konnectID' || self.type == 'konnectNamespacedRef'", message="Only konnectID and konnectNamespacedRef types are currently supported as controlPlaneRef."
 	ControlPlaneRef configurationv1alpha1.ControlPlaneRef `json:"controlPlaneRef"`
 
 	// ControlPlaneRegion is the region of the Konnect Control Plane.
@@ -98,13 +99,41 @@ type KonnectExtensionSpec struct {
 }
 
 // KonnectControlPlaneAPIAuthConfiguration contains the configuration to authenticate with Konnect API ControlPlane.
+// +kubebuilder:validation:XValidation:rule="(has(self.clusterCertificateProvisioning) && self.clusterCertificateProvisioning == 'Automatic') || has(self.clusterCertificateSecretRef)", message="clusterCertificateSecretRef is required when clusterCertificateProvisioning is Manual."
 // +apireference:kgo:include
 type KonnectControlPlaneAPIAuthConfiguration struct {
+	// ClusterCertificateProvisioning defines how the cluster certificate is provisioned.
+	// When set to Manual, the certificate has to be provided in the Secret referenced
+	// by ClusterCertificateSecretRef and registered in Konnect by the user.
+	// When set to Automatic, the operator generates the certificate, registers it
+	// as a KongDataPlaneClientCertificate in the Konnect ControlPlane referenced
+	// by a konnectNamespacedRef, and rotates it before it expires.
+	//
+	// +kubebuilder:validation:Enum=Manual;Automatic
+	// +kubebuilder:default=Manual
+	// +optional
+	ClusterCertificateProvisioning ClusterCertificateProvisioning `json:"clusterCertificateProvisioning,omitempty"`
+
 	// ClusterCertificateSecretRef is the reference to the Secret containing the Konnect Control Plane's cluster certificate.
-	// +kubebuilder:validation:Required
-	ClusterCertificateSecretRef ClusterCertificateSecretRef `json:"clusterCertificateSecretRef"`
+	// It is required when ClusterCertificateProvisioning is Manual.
+	//
+	// +optional
+	ClusterCertificateSecretRef *ClusterCertificateSecretRef `json:"clusterCertificateSecretRef,omitempty"`
 }
 
+// ClusterCertificateProvisioning defines how the cluster certificate is provisioned.
+// +apireference:kgo:include
+type ClusterCertificateProvisioning string
+
+const (
+	// ClusterCertificateProvisioningManual indicates that the cluster certificate
+	// is provided by the user.
+	ClusterCertificateProvisioningManual ClusterCertificateProvisioning = "Manual"
+	// ClusterCertificateProvisioningAutomatic indicates that the cluster certificate
+	// is generated, registered in Konnect and rotated by the operator.
+	ClusterCertificateProvisioningAutomatic ClusterCertificateProvisioning = "Automatic"
+)
+
 // ClusterCertificateSecretRef contains the reference to the Secret containing the Konnect Control Plane's cluster certificate.
 // +apireference:kgo:include
 type ClusterCertificateSecretRef struct {
@@ -122,4 +151,17 @@ type KonnectExtensionStatus struct {
 	//
 	// +kube:validation:Optional
 	DataPlaneRefs []NamespacedRef `json:"dataPlaneRefs,omitempty"`
+
+	// ClusterCertificateSecretRef is the reference to the Secret containing the
+	// cluster certificate provisioned by the operator, when the cluster certificate
+	// provisioning is Automatic. It's set once the certificate is registered in Konnect.
+	//
+	// +optional
+	ClusterCertificateSecretRef *ClusterCertificateSecretRef `json:"clusterCertificateSecretRef,omitempty"`
+}
+
+// IsClusterCertificateProvisioningAutomatic returns true when the cluster
+// certificate of the KonnectExtension is provisioned by the operator.
+func (e *KonnectExtension) IsClusterCertificateProvisioningAutomatic() bool {
+	return e.Spec.AuthConfiguration.ClusterCertificateProvisioning == ClusterCertificateProvisioningAutomatic
 }
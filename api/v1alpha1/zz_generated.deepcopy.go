@@ -491,7 +491,11 @@ func (in *KongPluginInstallationStatus) DeepCopy() *KongPluginInstallationStatus
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonnectControlPlaneAPIAuthConfiguration) DeepCopyInto(out *KonnectControlPlaneAPIAuthConfiguration) {
 	*out = *in
-	out.ClusterCertificateSecretRef = in.ClusterCertificateSecretRef
+	if in.ClusterCertificateSecretRef != nil {
+		in, out := &in.ClusterCertificateSecretRef, &out.ClusterCertificateSecretRef
+		*out = new(ClusterCertificateSecretRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectControlPlaneAPIAuthConfiguration.
@@ -567,7 +571,7 @@ func (in *KonnectExtensionList) DeepCopyObject() runtime.Object {
 func (in *KonnectExtensionSpec) DeepCopyInto(out *KonnectExtensionSpec) {
 	*out = *in
 	in.ControlPlaneRef.DeepCopyInto(&out.ControlPlaneRef)
-	out.AuthConfiguration = in.AuthConfiguration
+	in.AuthConfiguration.DeepCopyInto(&out.AuthConfiguration)
 	if in.ClusterDataPlaneLabels != nil {
 		in, out := &in.ClusterDataPlaneLabels, &out.ClusterDataPlaneLabels
 		*out = make(map[string]string, len(*in))
@@ -597,6 +601,11 @@ func (in *KonnectExtensionStatus) DeepCopyInto(out *KonnectExtensionStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClusterCertificateSecretRef != nil {
+		in, out := &in.ClusterCertificateSecretRef, &out.ClusterCertificateSecretRef
+		*out = new(ClusterCertificateSecretRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectExtensionStatus.
@@ -103,6 +103,19 @@ type ControlPlaneOptions struct {
 	//
 	// +optional
 	Extensions []v1alpha1.ExtensionRef `json:"extensions,omitempty"`
+
+	// Monitoring defines the Prometheus Operator resources created to scrape
+	// the ControlPlane's metrics.
+	//
+	// +optional
+	Monitoring *MonitoringOptions `json:"monitoring,omitempty"`
+
+	// Patches are applied to the resources generated for the ControlPlane:
+	// its Deployment, Services, ServiceAccount, ClusterRole and ClusterRoleBinding.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Patches []ResourcePatch `json:"patches,omitempty"`
 }
 
 // ControlPlaneDeploymentOptions is a shared type used on objects to indicate that their
@@ -88,8 +88,520 @@ type DataPlaneOptions struct {
 	// will be installed and available in the DataPlane.
 	// +optional
 	PluginsToInstall []NamespacedName `json:"pluginsToInstall,omitempty"`
+
+	// Monitoring defines the Prometheus Operator resources created to scrape
+	// the metrics exposed on the DataPlane's status port.
+	//
+	// +optional
+	Monitoring *MonitoringOptions `json:"monitoring,omitempty"`
+
+	// Observability defines the observability options of the DataPlane.
+	//
+	// +optional
+	Observability *DataPlaneObservabilityOptions `json:"observability,omitempty"`
+
+	// KongConfig is the typed configuration of Kong Gateway which is rendered to
+	// the environment variables of the DataPlane's proxy container.
+	// The environment variables it renders cannot be set in the proxy container
+	// of the PodTemplateSpec at the same time.
+	//
+	// +optional
+	KongConfig *KongConfig `json:"kongConfig,omitempty"`
+
+	// Database configures the DataPlane to run in traditional (database-backed)
+	// mode instead of the default DB-less mode.
+	// The operator runs the `kong migrations` Jobs against the database and
+	// rolls out a new Kong Gateway version only after its migrations completed.
+	//
+	// +optional
+	Database *DataPlaneDatabaseOptions `json:"database,omitempty"`
+
+	// Hybrid configures the DataPlane to run in Kong Gateway hybrid mode,
+	// either as a control plane which other DataPlanes connect to or as
+	// a data plane connecting to a control plane DataPlane.
+	// The cluster certificates are issued by the operator from its cluster CA.
+	//
+	// +optional
+	Hybrid *DataPlaneHybridOptions `json:"hybrid,omitempty"`
+
+	// Patches are applied to the resources generated for the DataPlane:
+	// its Deployment, Services, HorizontalPodAutoscaler and PodDisruptionBudget,
+	// as well as the NetworkPolicy generated for DataPlanes managed by a Gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Patches []ResourcePatch `json:"patches,omitempty"`
+}
+
+// DataPlaneHybridOptions defines the role of the DataPlane in Kong Gateway
+// hybrid mode.
+//
+// +kubebuilder:validation:XValidation:message="controlPlaneRef is required when role is data_plane",rule="self.role == 'data_plane' ? has(self.controlPlaneRef) : true"
+// +kubebuilder:validation:XValidation:message="controlPlaneRef can only be set when role is data_plane",rule="self.role == 'control_plane' ? !has(self.controlPlaneRef) : true"
+// +apireference:kgo:include
+type DataPlaneHybridOptions struct {
+	// Role is the hybrid mode role of the DataPlane.
+	Role DataPlaneHybridRole `json:"role"`
+
+	// ControlPlaneRef is the reference to the DataPlane in the same namespace
+	// running with the control_plane role which this DataPlane connects to.
+	// It is required when the role is data_plane.
+	//
+	// +optional
+	ControlPlaneRef *DataPlaneHybridControlPlaneRef `json:"controlPlaneRef,omitempty"`
+}
+
+// DataPlaneHybridRole is the role of the DataPlane in Kong Gateway hybrid mode.
+//
+// +kubebuilder:validation:Enum=control_plane;data_plane
+// +apireference:kgo:include
+type DataPlaneHybridRole string
+
+const (
+	// DataPlaneHybridRoleControlPlane is the role of a DataPlane serving
+	// the configuration to other DataPlanes. It requires a database.
+	DataPlaneHybridRoleControlPlane DataPlaneHybridRole = "control_plane"
+
+	// DataPlaneHybridRoleDataPlane is the role of a DataPlane receiving its
+	// configuration from a control plane DataPlane.
+	DataPlaneHybridRoleDataPlane DataPlaneHybridRole = "data_plane"
+)
+
+// DataPlaneHybridControlPlaneRef contains the reference to the control plane
+// DataPlane.
+// +apireference:kgo:include
+type DataPlaneHybridControlPlaneRef struct {
+	// Name is the name of the DataPlane running with the control_plane role.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// DataPlaneDatabaseOptions defines the database the DataPlane stores its
+// configuration in.
+// +apireference:kgo:include
+type DataPlaneDatabaseOptions struct {
+	// Postgres defines the connection to the PostgreSQL database.
+	Postgres DataPlanePostgresOptions `json:"postgres"`
+}
+
+// DataPlanePostgresOptions defines the connection to a PostgreSQL database.
+//
+// +kubebuilder:validation:XValidation:message="sslVerify can only be enabled when ssl is enabled",rule="has(self.sslVerify) && self.sslVerify ? (has(self.ssl) && self.ssl) : true"
+// +apireference:kgo:include
+type DataPlanePostgresOptions struct {
+	// Host is the host of the PostgreSQL server, e.g. the name of
+	// an in-cluster Service.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port is the port of the PostgreSQL server.
+	//
+	// +optional
+	// +kubebuilder:default=5432
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+
+	// Database is the name of the database.
+	//
+	// +optional
+	// +kubebuilder:default=kong
+	// +kubebuilder:validation:MinLength=1
+	Database string `json:"database,omitempty"`
+
+	// CredentialsSecretRef is the reference to the Secret in the DataPlane's
+	// namespace holding the `username` and `password` keys used to connect
+	// to the database.
+	CredentialsSecretRef DataPlanePostgresCredentialsSecretRef `json:"credentialsSecretRef"`
+
+	// SSL enables SSL connections to the database.
+	//
+	// +optional
+	SSL bool `json:"ssl,omitempty"`
+
+	// SSLVerify enables the verification of the database server certificate.
+	// It can only be enabled when SSL is enabled.
+	//
+	// +optional
+	SSLVerify bool `json:"sslVerify,omitempty"`
+}
+
+// DataPlanePostgresCredentialsSecretRef contains the reference to the Secret
+// holding the PostgreSQL credentials.
+// +apireference:kgo:include
+type DataPlanePostgresCredentialsSecretRef struct {
+	// Name is the name of the Secret holding the PostgreSQL credentials.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// DataPlaneObservabilityOptions defines the observability options of the DataPlane.
+// +apireference:kgo:include
+type DataPlaneObservabilityOptions struct {
+	// Tracing defines the OpenTelemetry tracing configuration of the DataPlane.
+	//
+	// +optional
+	Tracing *DataPlaneTracingOptions `json:"tracing,omitempty"`
+}
+
+// DataPlaneTracingOptions defines the OpenTelemetry tracing configuration of the DataPlane.
+// When set, the DataPlane is configured to generate traces and the ControlPlane
+// managing the DataPlane configures a global opentelemetry plugin sending the
+// traces to the collector.
+// +apireference:kgo:include
+type DataPlaneTracingOptions struct {
+	// Endpoint is the OTLP/HTTP traces endpoint of the OpenTelemetry collector,
+	// e.g. http://otel-collector.observability:4318/v1/traces.
+	//
+	// +kubebuilder:validation:Pattern=`^https?://.+`
+	Endpoint string `json:"endpoint"`
+
+	// SamplingRate is the probability of a request being traced, between 0 and 1.
+	// When not set, the DataPlane's default sampling rate is used.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	SamplingRate *string `json:"samplingRate,omitempty"`
+
+	// Instrumentations are the DataPlane's phases for which spans are generated.
+	// When not set, all the instrumentations are enabled.
+	//
+	// +optional
+	Instrumentations []TracingInstrumentation `json:"instrumentations,omitempty"`
+
+	// ResourceAttributes are the attributes set on the resource of the traces,
+	// e.g. service.name.
+	//
+	// +optional
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+}
+
+// TracingInstrumentation is the DataPlane's phase for which spans are generated.
+//
+// +kubebuilder:validation:Enum=all;off;request;router;http_client;balancer;db_query;plugin_rewrite;plugin_access;plugin_header_filter
+// +apireference:kgo:include
+type TracingInstrumentation string
+
+const (
+	// TracingInstrumentationAll enables all the instrumentations.
+	TracingInstrumentationAll TracingInstrumentation = "all"
+	// TracingInstrumentationOff disables all the instrumentations.
+	TracingInstrumentationOff TracingInstrumentation = "off"
+	// TracingInstrumentationRequest enables the spans of incoming requests.
+	TracingInstrumentationRequest TracingInstrumentation = "request"
+	// TracingInstrumentationRouter enables the spans of the router execution.
+	TracingInstrumentationRouter TracingInstrumentation = "router"
+	// TracingInstrumentationHTTPClient enables the spans of the HTTP client requests.
+	TracingInstrumentationHTTPClient TracingInstrumentation = "http_client"
+	// TracingInstrumentationBalancer enables the spans of the balancer retries.
+	TracingInstrumentationBalancer TracingInstrumentation = "balancer"
+	// TracingInstrumentationDBQuery enables the spans of the database queries.
+	TracingInstrumentationDBQuery TracingInstrumentation = "db_query"
+	// TracingInstrumentationPluginRewrite enables the spans of the plugins' rewrite phase.
+	TracingInstrumentationPluginRewrite TracingInstrumentation = "plugin_rewrite"
+	// TracingInstrumentationPluginAccess enables the spans of the plugins' access phase.
+	TracingInstrumentationPluginAccess TracingInstrumentation = "plugin_access"
+	// TracingInstrumentationPluginHeaderFilter enables the spans of the plugins' header filter phase.
+	TracingInstrumentationPluginHeaderFilter TracingInstrumentation = "plugin_header_filter"
+)
+
+// KongConfig defines the typed configuration of Kong Gateway running in the DataPlane.
+// +apireference:kgo:include
+type KongConfig struct {
+	// Listeners defines the addresses and ports on which the DataPlane
+	// listens for the proxied traffic.
+	//
+	// +optional
+	Listeners *KongListenersConfig `json:"listeners,omitempty"`
+
+	// NginxDirectives are the Nginx directives injected in the Nginx
+	// configuration of the DataPlane.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	NginxDirectives []KongNginxDirective `json:"nginxDirectives,omitempty"`
+
+	// LogLevel is the log level of the DataPlane's Nginx error logs.
+	//
+	// +optional
+	LogLevel *KongLogLevel `json:"logLevel,omitempty"`
+
+	// Plugins are the plugins enabled in the DataPlane, e.g. bundled or the names
+	// of custom plugins. The plugins installed with pluginsToInstall are always enabled.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	Plugins []string `json:"plugins,omitempty"`
+
+	// DNS defines the DNS resolution options of the DataPlane.
+	//
+	// +optional
+	DNS *KongDNSConfig `json:"dns,omitempty"`
+
+	// TLS defines the TLS options of the DataPlane's proxy listeners.
+	//
+	// +optional
+	TLS *KongTLSConfig `json:"tls,omitempty"`
+}
+
+// KongListenersConfig defines the listeners of the DataPlane.
+// +apireference:kgo:include
+type KongListenersConfig struct {
+	// Proxy are the listeners of the HTTP proxy traffic.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	Proxy []KongListener `json:"proxy,omitempty"`
+
+	// Stream are the listeners of the TCP and TLS proxy traffic.
+	//
+	// +optional
+	Stream []KongListener `json:"stream,omitempty"`
+
+	// PortMaps maps the ports exposed by the DataPlane's ingress Service
+	// to the ports of the listeners. They're used by the DataPlane
+	// to generate the upstream headers with the right ports.
+	//
+	// +optional
+	PortMaps []KongPortMap `json:"portMaps,omitempty"`
+}
+
+// KongListener defines an address and port on which the DataPlane listens.
+// +apireference:kgo:include
+type KongListener struct {
+	// Address is the IP address the listener binds to.
+	//
+	// +optional
+	// +kubebuilder:default="0.0.0.0"
+	Address string `json:"address,omitempty"`
+
+	// Port is the port the listener binds to.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Parameters are the parameters of the listener.
+	//
+	// +optional
+	Parameters []KongListenerParameter `json:"parameters,omitempty"`
+
+	// Backlog sets the maximum length of the queue of pending connections.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Backlog *int32 `json:"backlog,omitempty"`
+}
+
+// KongListenerParameter is a parameter of a DataPlane's listener.
+//
+// +kubebuilder:validation:Enum=ssl;http2;reuseport;proxy_protocol;deferred;bind
+// +apireference:kgo:include
+type KongListenerParameter string
+
+const (
+	// KongListenerParameterSSL makes the listener accept TLS connections only.
+	KongListenerParameterSSL KongListenerParameter = "ssl"
+	// KongListenerParameterHTTP2 enables HTTP/2 on the listener.
+	KongListenerParameterHTTP2 KongListenerParameter = "http2"
+	// KongListenerParameterReusePort creates an individual listening socket for each worker process.
+	KongListenerParameterReusePort KongListenerParameter = "reuseport"
+	// KongListenerParameterProxyProtocol enables the PROXY protocol on the listener.
+	KongListenerParameterProxyProtocol KongListenerParameter = "proxy_protocol"
+	// KongListenerParameterDeferred enables deferred accept on Linux.
+	KongListenerParameterDeferred KongListenerParameter = "deferred"
+	// KongListenerParameterBind makes the listener bind to its address:port pair separately.
+	KongListenerParameterBind KongListenerParameter = "bind"
+)
+
+// KongPortMap maps a port exposed by the DataPlane's ingress Service to the port of a listener.
+// +apireference:kgo:include
+type KongPortMap struct {
+	// Port is the port exposed by the DataPlane's ingress Service.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// TargetPort is the port of the listener.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	TargetPort int32 `json:"targetPort"`
+}
+
+// KongNginxDirective defines an Nginx directive injected in a block of the DataPlane's
+// Nginx configuration.
+// +apireference:kgo:include
+type KongNginxDirective struct {
+	// Block is the block of the Nginx configuration the directive is injected in.
+	Block KongNginxBlock `json:"block"`
+
+	// Name is the name of the directive, e.g. worker_connections.
+	//
+	// +kubebuilder:validation:Pattern=`^[a-z][a-z0-9_]*$`
+	Name string `json:"name"`
+
+	// Value is the value of the directive.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// KongNginxBlock is a block of the DataPlane's Nginx configuration.
+// The admin block is managed by the operator and cannot be configured.
+//
+// +kubebuilder:validation:Enum=main;events;http;proxy;upstream;stream;sproxy;supstream;status
+// +apireference:kgo:include
+type KongNginxBlock string
+
+const (
+	// KongNginxBlockMain is the top-level block of the Nginx configuration.
+	KongNginxBlockMain KongNginxBlock = "main"
+	// KongNginxBlockEvents is the events block of the Nginx configuration.
+	KongNginxBlockEvents KongNginxBlock = "events"
+	// KongNginxBlockHTTP is the http block of the Nginx configuration.
+	KongNginxBlockHTTP KongNginxBlock = "http"
+	// KongNginxBlockProxy is the server block of the HTTP proxy listeners.
+	KongNginxBlockProxy KongNginxBlock = "proxy"
+	// KongNginxBlockUpstream is the upstream block of the HTTP proxy.
+	KongNginxBlockUpstream KongNginxBlock = "upstream"
+	// KongNginxBlockStream is the stream block of the Nginx configuration.
+	KongNginxBlockStream KongNginxBlock = "stream"
+	// KongNginxBlockStreamProxy is the server block of the stream listeners.
+	KongNginxBlockStreamProxy KongNginxBlock = "sproxy"
+	// KongNginxBlockStreamUpstream is the upstream block of the stream proxy.
+	KongNginxBlockStreamUpstream KongNginxBlock = "supstream"
+	// KongNginxBlockStatus is the server block of the status listener.
+	KongNginxBlockStatus KongNginxBlock = "status"
+)
+
+// KongLogLevel is the log level of the DataPlane.
+//
+// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
+// +apireference:kgo:include
+type KongLogLevel string
+
+const (
+	// KongLogLevelDebug is the debug log level.
+	KongLogLevelDebug KongLogLevel = "debug"
+	// KongLogLevelInfo is the info log level.
+	KongLogLevelInfo KongLogLevel = "info"
+	// KongLogLevelNotice is the notice log level.
+	KongLogLevelNotice KongLogLevel = "notice"
+	// KongLogLevelWarn is the warn log level.
+	KongLogLevelWarn KongLogLevel = "warn"
+	// KongLogLevelError is the error log level.
+	KongLogLevelError KongLogLevel = "error"
+	// KongLogLevelCrit is the crit log level.
+	KongLogLevelCrit KongLogLevel = "crit"
+	// KongLogLevelAlert is the alert log level.
+	KongLogLevelAlert KongLogLevel = "alert"
+	// KongLogLevelEmerg is the emerg log level.
+	KongLogLevelEmerg KongLogLevel = "emerg"
+)
+
+// KongDNSConfig defines the DNS resolution options of the DataPlane.
+// +apireference:kgo:include
+type KongDNSConfig struct {
+	// Resolvers are the nameservers used by the DataPlane, in the ip[:port] format.
+	// When not set, the nameservers of the pod's resolv.conf are used.
+	//
+	// +optional
+	Resolvers []string `json:"resolvers,omitempty"`
+
+	// Order is the order in which the record types are resolved.
+	// LAST is the type of the last successful lookup.
+	//
+	// +optional
+	Order []KongDNSRecordType `json:"order,omitempty"`
+
+	// ValidTTL overrides the TTL, in seconds, of the resolved records.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ValidTTL *int32 `json:"validTTL,omitempty"`
+}
+
+// KongDNSRecordType is a DNS record type resolved by the DataPlane.
+//
+// +kubebuilder:validation:Enum=LAST;SRV;A;AAAA;CNAME
+// +apireference:kgo:include
+type KongDNSRecordType string
+
+const (
+	// KongDNSRecordTypeLast is the type of the last successful lookup.
+	KongDNSRecordTypeLast KongDNSRecordType = "LAST"
+	// KongDNSRecordTypeSRV is the SRV record type.
+	KongDNSRecordTypeSRV KongDNSRecordType = "SRV"
+	// KongDNSRecordTypeA is the A record type.
+	KongDNSRecordTypeA KongDNSRecordType = "A"
+	// KongDNSRecordTypeAAAA is the AAAA record type.
+	KongDNSRecordTypeAAAA KongDNSRecordType = "AAAA"
+	// KongDNSRecordTypeCNAME is the CNAME record type.
+	KongDNSRecordTypeCNAME KongDNSRecordType = "CNAME"
+)
+
+// KongTLSConfig defines the TLS options of the DataPlane's proxy listeners.
+//
+// +kubebuilder:validation:XValidation:message="ciphers can only be set when cipherSuite is custom",rule="has(self.ciphers) ? (has(self.cipherSuite) && self.cipherSuite == 'custom') : true"
+// +kubebuilder:validation:XValidation:message="ciphers must be set when cipherSuite is custom",rule="has(self.cipherSuite) && self.cipherSuite == 'custom' ? has(self.ciphers) : true"
+// +apireference:kgo:include
+type KongTLSConfig struct {
+	// CipherSuite is the predefined set of ciphers and protocols of the listeners.
+	// The custom cipher suite uses the ciphers and protocols set in this configuration.
+	//
+	// +optional
+	CipherSuite *KongTLSCipherSuite `json:"cipherSuite,omitempty"`
+
+	// Ciphers is the OpenSSL cipher list used by the custom cipher suite.
+	//
+	// +optional
+	Ciphers *string `json:"ciphers,omitempty"`
+
+	// Protocols are the TLS protocols enabled on the listeners.
+	//
+	// +optional
+	Protocols []KongTLSProtocol `json:"protocols,omitempty"`
 }
 
+// KongTLSCipherSuite is a predefined set of ciphers and protocols.
+//
+// +kubebuilder:validation:Enum=modern;intermediate;old;fips;custom
+// +apireference:kgo:include
+type KongTLSCipherSuite string
+
+const (
+	// KongTLSCipherSuiteModern is the modern cipher suite.
+	KongTLSCipherSuiteModern KongTLSCipherSuite = "modern"
+	// KongTLSCipherSuiteIntermediate is the intermediate cipher suite.
+	KongTLSCipherSuiteIntermediate KongTLSCipherSuite = "intermediate"
+	// KongTLSCipherSuiteOld is the old cipher suite.
+	KongTLSCipherSuiteOld KongTLSCipherSuite = "old"
+	// KongTLSCipherSuiteFIPS is the FIPS compliant cipher suite.
+	KongTLSCipherSuiteFIPS KongTLSCipherSuite = "fips"
+	// KongTLSCipherSuiteCustom is the cipher suite using the configured ciphers.
+	KongTLSCipherSuiteCustom KongTLSCipherSuite = "custom"
+)
+
+// KongTLSProtocol is a TLS protocol enabled on the DataPlane's listeners.
+//
+// +kubebuilder:validation:Enum=TLSv1.1;TLSv1.2;TLSv1.3
+// +apireference:kgo:include
+type KongTLSProtocol string
+
+const (
+	// KongTLSProtocolTLS11 is the TLSv1.1 protocol.
+	KongTLSProtocolTLS11 KongTLSProtocol = "TLSv1.1"
+	// KongTLSProtocolTLS12 is the TLSv1.2 protocol.
+	KongTLSProtocolTLS12 KongTLSProtocol = "TLSv1.2"
+	// KongTLSProtocolTLS13 is the TLSv1.3 protocol.
+	KongTLSProtocolTLS13 KongTLSProtocol = "TLSv1.3"
+)
+
 // DataPlaneResources defines the resources that will be created and managed
 // for the DataPlane.
 // +apireference:kgo:include
@@ -164,9 +676,42 @@ type DataPlaneDeploymentOptions struct {
 	// +optional
 	Rollout *Rollout `json:"rollout,omitempty"`
 
+	// Placement describes how the DataPlane pods are spread across the cluster.
+	//
+	// `zoneSpread` spreads the pods evenly across zones and, within a zone,
+	// across nodes.
+	//
+	// `hostSpread` spreads the pods evenly across nodes and prefers not to
+	// schedule two pods of the same Deployment on a single node.
+	//
+	// `none` (the default) doesn't set any topology spread constraints nor
+	// pod anti-affinity.
+	//
+	// The generated constraints select the pods of each Deployment separately
+	// so that BlueGreen live and preview pods are spread independently.
+	// They can be further customized through the PodTemplateSpec.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=zoneSpread;hostSpread;none
+	Placement DataPlanePlacementPolicy `json:"placement,omitempty"`
+
 	DeploymentOptions `json:",inline"`
 }
 
+// DataPlanePlacementPolicy is the policy describing how the DataPlane pods
+// are spread across the cluster.
+type DataPlanePlacementPolicy string
+
+const (
+	// DataPlanePlacementPolicyZoneSpread spreads the DataPlane pods across zones
+	// and nodes.
+	DataPlanePlacementPolicyZoneSpread DataPlanePlacementPolicy = "zoneSpread"
+	// DataPlanePlacementPolicyHostSpread spreads the DataPlane pods across nodes.
+	DataPlanePlacementPolicyHostSpread DataPlanePlacementPolicy = "hostSpread"
+	// DataPlanePlacementPolicyNone doesn't constrain the DataPlane pods placement.
+	DataPlanePlacementPolicyNone DataPlanePlacementPolicy = "none"
+)
+
 // DataPlaneNetworkOptions defines network related options for a DataPlane.
 // +apireference:kgo:include
 type DataPlaneNetworkOptions struct {
@@ -194,6 +739,35 @@ type DataPlaneServices struct {
 	//
 	// +optional
 	Ingress *DataPlaneServiceOptions `json:"ingress,omitempty"`
+
+	// AdditionalIngresses is a list of additional, named Kubernetes Services
+	// that expose ingress traffic for the DataPlane next to the Ingress Service.
+	// Each of them selects the same DataPlane Pods and can be configured
+	// independently, e.g. to expose the DataPlane through a public
+	// LoadBalancer and an internal ClusterIP Service at the same time.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	AdditionalIngresses []DataPlaneNamedServiceOptions `json:"additionalIngresses,omitempty"`
+}
+
+// DataPlaneNamedServiceOptions contains configuration of an additional, named
+// ingress Service of a DataPlane.
+// +apireference:kgo:include
+type DataPlaneNamedServiceOptions struct {
+	// Name uniquely identifies the additional ingress Service within the DataPlane.
+	// It is used to label the generated Service and to report its status.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// DataPlaneServiceOptions contains the configuration of the Service.
+	DataPlaneServiceOptions `json:",inline"`
 }
 
 // DataPlaneServiceOptions contains Services related DataPlane configuration.
@@ -288,6 +862,17 @@ type ServiceOptions struct {
 	// +kubebuilder:default=Cluster
 	// +kubebuilder:validation:Enum=Cluster;Local
 	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+
+	// LoadBalancerClass is the class of the load balancer implementation
+	// the Service belongs to. It is only applied to Services of type `LoadBalancer`.
+	// Since the field cannot be changed on existing LoadBalancer Services,
+	// changing it causes the Service to be recreated.
+	//
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-class
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
 }
 
 // DataPlaneStatus defines the observed state of DataPlane
@@ -308,6 +893,15 @@ type DataPlaneStatus struct {
 	// +optional
 	Addresses []Address `json:"addresses,omitempty"`
 
+	// AdditionalIngresses lists the additional ingress Services of the DataPlane
+	// together with the addresses that have been bound to them.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	AdditionalIngresses []DataPlaneIngressServiceStatus `json:"additionalIngresses,omitempty"`
+
 	// Selector contains a unique DataPlane identifier used as a deterministic
 	// label selector that is used throughout its dependent resources.
 	// This is used e.g. as a label selector for DataPlane's Services, Deployments and PodDisruptionBudgets.
@@ -331,6 +925,51 @@ type DataPlaneStatus struct {
 	//
 	// +optional
 	RolloutStatus *DataPlaneRolloutStatus `json:"rollout,omitempty"`
+
+	// Pods lists the configuration state of the ready Pods of the DataPlane
+	// as reported by the `/status` endpoint of their Admin API.
+	// It is only set when the operator runs with the
+	// `--enable-dataplane-configuration-sync-status` flag and it is not set
+	// for DataPlanes using a database, which don't report a configuration hash.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Pods []DataPlanePodStatus `json:"pods,omitempty"`
+}
+
+// DataPlanePodStatus describes the configuration state of a DataPlane Pod.
+// +apireference:kgo:include
+type DataPlanePodStatus struct {
+	// Name is the name of the Pod.
+	Name string `json:"name"`
+
+	// ConfigurationHash is the hash of the configuration loaded by the Pod.
+	// It is empty when the status of the Pod couldn't be retrieved.
+	//
+	// +optional
+	ConfigurationHash string `json:"configurationHash,omitempty"`
+
+	// Synced indicates whether the Pod has loaded a configuration which is
+	// the same as the one loaded by the majority of the DataPlane's Pods.
+	Synced bool `json:"synced"`
+}
+
+// DataPlaneIngressServiceStatus describes the status of an additional ingress
+// Service of a DataPlane.
+// +apireference:kgo:include
+type DataPlaneIngressServiceStatus struct {
+	// Name is the name of the additional ingress Service as configured
+	// in the DataPlane spec.
+	Name string `json:"name"`
+
+	// Service is the name of the Kubernetes Service.
+	Service string `json:"service"`
+
+	// Addresses lists the addresses that have actually been bound to the Service.
+	//
+	// +optional
+	Addresses []Address `json:"addresses,omitempty"`
 }
 
 // DataPlaneRolloutStatus describes the DataPlane rollout status.
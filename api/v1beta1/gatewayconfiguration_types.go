@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kong/gateway-operator/api/v1alpha1"
@@ -44,6 +46,11 @@ type GatewayConfiguration struct {
 
 // GatewayConfigurationSpec defines the desired state of GatewayConfiguration
 // +apireference:kgo:include
+// +kubebuilder:validation:XValidation:message="DataPlane replicas must not exceed guardrails.maxDataPlaneReplicas",rule="has(self.guardrails) && has(self.guardrails.maxDataPlaneReplicas) && has(self.dataPlaneOptions) && has(self.dataPlaneOptions.deployment.replicas) ? self.dataPlaneOptions.deployment.replicas <= self.guardrails.maxDataPlaneReplicas : true"
+// +kubebuilder:validation:XValidation:message="DataPlane horizontal scaling maxReplicas must not exceed guardrails.maxDataPlaneReplicas",rule="has(self.guardrails) && has(self.guardrails.maxDataPlaneReplicas) && has(self.dataPlaneOptions) && has(self.dataPlaneOptions.deployment.scaling) && has(self.dataPlaneOptions.deployment.scaling.horizontal) ? self.dataPlaneOptions.deployment.scaling.horizontal.maxReplicas <= self.guardrails.maxDataPlaneReplicas : true"
+// +kubebuilder:validation:XValidation:message="DataPlane event-driven scaling maxReplicas must not exceed guardrails.maxDataPlaneReplicas",rule="has(self.guardrails) && has(self.guardrails.maxDataPlaneReplicas) && has(self.dataPlaneOptions) && has(self.dataPlaneOptions.deployment.scaling) && has(self.dataPlaneOptions.deployment.scaling.eventDriven) ? self.dataPlaneOptions.deployment.scaling.eventDriven.maxReplicas <= self.guardrails.maxDataPlaneReplicas : true"
+// +kubebuilder:validation:XValidation:message="DataPlane ingress Service type must be one of guardrails.allowedServiceTypes",rule="has(self.guardrails) && has(self.guardrails.allowedServiceTypes) && size(self.guardrails.allowedServiceTypes) > 0 ? (has(self.dataPlaneOptions) && has(self.dataPlaneOptions.network.services) && has(self.dataPlaneOptions.network.services.ingress) && has(self.dataPlaneOptions.network.services.ingress.type) ? self.dataPlaneOptions.network.services.ingress.type : 'LoadBalancer') in self.guardrails.allowedServiceTypes : true"
+// +kubebuilder:validation:XValidation:message="DataPlane additional ingress Service types must be one of guardrails.allowedServiceTypes",rule="has(self.guardrails) && has(self.guardrails.allowedServiceTypes) && size(self.guardrails.allowedServiceTypes) > 0 && has(self.dataPlaneOptions) && has(self.dataPlaneOptions.network.services) && has(self.dataPlaneOptions.network.services.additionalIngresses) ? self.dataPlaneOptions.network.services.additionalIngresses.all(i, (has(i.type) ? i.type : 'LoadBalancer') in self.guardrails.allowedServiceTypes) : true"
 type GatewayConfigurationSpec struct {
 	// DataPlaneOptions is the specification for configuration
 	// overrides for DataPlane resources that will be created for the Gateway.
@@ -56,8 +63,103 @@ type GatewayConfigurationSpec struct {
 	//
 	// +optional
 	ControlPlaneOptions *ControlPlaneOptions `json:"controlPlaneOptions,omitempty"`
+
+	// DataPlaneSharing defines whether Gateways using this GatewayConfiguration
+	// (through their GatewayClass) get a dedicated DataPlane and ControlPlane
+	// each or share a single DataPlane and ControlPlane pair.
+	//
+	// +optional
+	DataPlaneSharing *DataPlaneSharing `json:"dataPlaneSharing,omitempty"`
+
+	// Guardrails defines limits enforced on Gateways using this
+	// GatewayConfiguration (through their GatewayClass) and on the
+	// GatewayConfiguration's DataPlane options.
+	//
+	// +optional
+	Guardrails *GatewayConfigGuardrails `json:"guardrails,omitempty"`
+}
+
+// GatewayConfigGuardrails defines limits enforced on Gateways using a GatewayConfiguration
+// and on the GatewayConfiguration's DataPlane options.
+// Gateways violating the Gateway limits are not accepted and nothing gets
+// provisioned for them. DataPlane options violating the DataPlane limits are
+// rejected when the GatewayConfiguration is created or updated.
+// +apireference:kgo:include
+type GatewayConfigGuardrails struct {
+	// MaxGatewaysPerNamespace is the maximum number of Gateways of a GatewayClass
+	// allowed in a single namespace. Gateways are admitted in the order of their
+	// creation, hence the newest Gateways exceeding the limit are not accepted.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxGatewaysPerNamespace *int32 `json:"maxGatewaysPerNamespace,omitempty"`
+
+	// MaxListeners is the maximum number of listeners allowed on a single Gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxListeners *int32 `json:"maxListeners,omitempty"`
+
+	// MaxDataPlaneReplicas is the maximum number of replicas allowed for
+	// DataPlanes, including the maximum number of replicas of horizontally
+	// and event-driven scaled DataPlanes.
+	// It is validated against the GatewayConfiguration's DataPlane options.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxDataPlaneReplicas *int32 `json:"maxDataPlaneReplicas,omitempty"`
+
+	// AllowedServiceTypes is the list of Service types allowed for DataPlanes'
+	// ingress Services. When empty, all Service types are allowed.
+	// It is validated against the GatewayConfiguration's DataPlane options.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=4
+	AllowedServiceTypes []corev1.ServiceType `json:"allowedServiceTypes,omitempty"`
 }
 
+// DataPlaneSharing defines how DataPlanes and ControlPlanes are provisioned
+// for the Gateways using a GatewayConfiguration.
+// +apireference:kgo:include
+type DataPlaneSharing struct {
+	// Mode indicates whether every Gateway gets a dedicated DataPlane and
+	// ControlPlane pair or whether all Gateways of a GatewayClass are merged
+	// onto a single shared pair.
+	//
+	// When set to `Shared`, the DataPlane and ControlPlane are created in the
+	// GatewayConfiguration's namespace and owned by the GatewayClass.
+	// Listeners of all the merged Gateways are exposed through the shared
+	// DataPlane's ingress Service. Listeners which conflict with a listener of
+	// another (older) Gateway are reported as Conflicted and not exposed.
+	//
+	// +optional
+	// +kubebuilder:default=Dedicated
+	// +kubebuilder:validation:Enum=Dedicated;Shared
+	Mode DataPlaneSharingMode `json:"mode,omitempty"`
+}
+
+// DataPlaneSharingMode is the type of the DataPlane sharing mode.
+//
+// Allowed values:
+//
+//   - `Dedicated` makes the operator provision a DataPlane and ControlPlane
+//     pair for every Gateway.
+//   - `Shared` makes the operator merge all the Gateways of a GatewayClass onto
+//     a single DataPlane and ControlPlane pair.
+//
+// +apireference:kgo:include
+type DataPlaneSharingMode string
+
+const (
+	// DataPlaneSharingModeDedicated makes the operator provision a DataPlane
+	// and ControlPlane pair for every Gateway.
+	DataPlaneSharingModeDedicated DataPlaneSharingMode = "Dedicated"
+
+	// DataPlaneSharingModeShared makes the operator merge all the Gateways of
+	// a GatewayClass onto a single DataPlane and ControlPlane pair.
+	DataPlaneSharingModeShared DataPlaneSharingMode = "Shared"
+)
+
 // GatewayConfigDataPlaneOptions indicates the specific information needed to
 // configure and deploy a DataPlane object.
 // +apireference:kgo:include
@@ -81,6 +183,31 @@ type GatewayConfigDataPlaneOptions struct {
 	// use this GatewayConfig.
 	// +optional
 	PluginsToInstall []NamespacedName `json:"pluginsToInstall,omitempty"`
+
+	// Monitoring defines the Prometheus Operator resources created to scrape
+	// the metrics exposed on the DataPlanes' status port.
+	//
+	// +optional
+	Monitoring *MonitoringOptions `json:"monitoring,omitempty"`
+
+	// Observability defines the observability options of the DataPlanes.
+	//
+	// +optional
+	Observability *DataPlaneObservabilityOptions `json:"observability,omitempty"`
+
+	// KongConfig is the typed configuration of Kong Gateway which is rendered to
+	// the environment variables of the DataPlanes' proxy container.
+	//
+	// +optional
+	KongConfig *KongConfig `json:"kongConfig,omitempty"`
+
+	// Patches are applied to the resources generated for the DataPlanes:
+	// their Deployment, Services, HorizontalPodAutoscaler, PodDisruptionBudget
+	// and NetworkPolicy.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Patches []ResourcePatch `json:"patches,omitempty"`
 }
 
 // GatewayConfigDataPlaneNetworkOptions defines network related options for a DataPlane.
@@ -90,10 +217,64 @@ type GatewayConfigDataPlaneNetworkOptions struct {
 	// the topology of various forms of traffic (including ingress, etc.) to
 	// and from the DataPlane.
 	Services *GatewayConfigDataPlaneServices `json:"services,omitempty"`
+
+	// NetworkPolicy defines the NetworkPolicy generated for the DataPlane.
+	//
+	// +optional
+	NetworkPolicy *GatewayConfigDataPlaneNetworkPolicy `json:"networkPolicy,omitempty"`
+}
+
+// GatewayConfigDataPlaneNetworkPolicy defines the NetworkPolicy generated for the DataPlane.
+//
+// By default, the generated NetworkPolicy allows traffic to the proxy ports
+// (including ports from KONG_STREAM_LISTEN) and to the status ports (from
+// KONG_STATUS_LISTEN) from any source, while the Admin API is only
+// reachable from the ControlPlane.
+// +apireference:kgo:include
+type GatewayConfigDataPlaneNetworkPolicy struct {
+	// Enabled indicates whether the NetworkPolicy is generated for the DataPlane.
+	// When set to false, the previously generated NetworkPolicy is removed.
+	//
+	// +optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Ingress contains additional ingress rules appended to the generated ones,
+	// e.g. to allow Prometheus namespaces or load balancer CIDRs to reach the
+	// DataPlane's ports.
+	//
+	// +optional
+	Ingress []networkingv1.NetworkPolicyIngressRule `json:"ingress,omitempty"`
+
+	// Egress restricts traffic originating from the DataPlane. When unset,
+	// egress traffic is not restricted.
+	//
+	// +optional
+	Egress *GatewayConfigDataPlaneNetworkPolicyEgress `json:"egress,omitempty"`
+}
+
+// GatewayConfigDataPlaneNetworkPolicyEgress defines the egress rules of the
+// NetworkPolicy generated for the DataPlane.
+//
+// When set, DNS traffic is always allowed, alongside traffic to the upstream
+// namespaces and any additional rules.
+// +apireference:kgo:include
+type GatewayConfigDataPlaneNetworkPolicyEgress struct {
+	// UpstreamNamespaces is a list of namespaces the DataPlane is allowed to
+	// proxy traffic to.
+	//
+	// +optional
+	UpstreamNamespaces []string `json:"upstreamNamespaces,omitempty"`
+
+	// Rules contains additional egress rules appended to the generated ones.
+	//
+	// +optional
+	Rules []networkingv1.NetworkPolicyEgressRule `json:"rules,omitempty"`
 }
 
 // GatewayConfigDataPlaneServices contains Services related DataPlane configuration.
 // +apireference:kgo:include
+// +kubebuilder:validation:XValidation:message="gatewayAddressesFrom must reference one of the additionalIngresses",rule="has(self.gatewayAddressesFrom) ? has(self.additionalIngresses) && self.additionalIngresses.exists(i, i.name == self.gatewayAddressesFrom) : true"
 type GatewayConfigDataPlaneServices struct {
 	// Ingress is the Kubernetes Service that will be used to expose ingress
 	// traffic for the DataPlane. Here you can determine whether the DataPlane
@@ -104,6 +285,23 @@ type GatewayConfigDataPlaneServices struct {
 	//
 	// +optional
 	Ingress *GatewayConfigServiceOptions `json:"ingress,omitempty"`
+
+	// AdditionalIngresses is a list of additional, named Kubernetes Services
+	// that expose ingress traffic for the DataPlane next to the Ingress Service.
+	// Each of them exposes the same ports as the Ingress Service.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	AdditionalIngresses []GatewayConfigNamedServiceOptions `json:"additionalIngresses,omitempty"`
+
+	// GatewayAddressesFrom is the name of the additional ingress Service whose
+	// addresses are reported in the Gateway's status.
+	// When not set, the addresses of the Ingress Service are used.
+	//
+	// +optional
+	GatewayAddressesFrom *string `json:"gatewayAddressesFrom,omitempty"`
 }
 
 // GatewayConfigServiceOptions is used to includes options to customize the ingress service,
@@ -113,6 +311,22 @@ type GatewayConfigServiceOptions struct {
 	ServiceOptions `json:",inline"`
 }
 
+// GatewayConfigNamedServiceOptions contains configuration of an additional,
+// named ingress Service of a DataPlane managed by a Gateway.
+// +apireference:kgo:include
+type GatewayConfigNamedServiceOptions struct {
+	// Name uniquely identifies the additional ingress Service within the DataPlane.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// GatewayConfigServiceOptions contains the configuration of the Service.
+	GatewayConfigServiceOptions `json:",inline"`
+}
+
 // GatewayConfigurationStatus defines the observed state of GatewayConfiguration
 // +apireference:kgo:include
 type GatewayConfigurationStatus struct {
@@ -37,11 +37,147 @@ type DeploymentOptions struct {
 }
 
 // Scaling defines the scaling options for the deployment.
+//
+// +kubebuilder:validation:XValidation:message="Using both horizontal and eventDriven fields is not allowed.",rule="!(has(self.horizontal) && has(self.eventDriven))"
 // +apireference:kgo:include
 type Scaling struct {
 	// HorizontalScaling defines horizontal scaling options for the deployment.
 	// +optional
 	HorizontalScaling *HorizontalScaling `json:"horizontal,omitempty"`
+
+	// EventDrivenScaling defines event-driven scaling options for the deployment.
+	// The deployment is scaled by a KEDA ScaledObject which is only created
+	// when the KEDA CRDs are installed in the cluster.
+	//
+	// More info: https://keda.sh/docs/latest/concepts/scaling-deployments/
+	//
+	// +optional
+	EventDrivenScaling *EventDrivenScaling `json:"eventDriven,omitempty"`
+}
+
+// EventDrivenScaling defines event-driven scaling options for the deployment.
+//
+// +kubebuilder:validation:XValidation:message="At least one trigger or schedule is required.",rule="has(self.triggers) || has(self.schedules)"
+// +apireference:kgo:include
+type EventDrivenScaling struct {
+	// MinReplicas is the lower limit for the number of replicas to which
+	// the deployment can scale down.
+	//
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas to which
+	// the deployment can scale up.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// PollingInterval is the interval in seconds at which the triggers are checked.
+	// Defaults to 30 seconds.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+
+	// CooldownPeriod is the period in seconds to wait after the last trigger
+	// reported active before scaling the deployment back to MinReplicas.
+	// Defaults to 300 seconds.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+
+	// Triggers define the metrics that the deployment is scaled on.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Triggers []EventDrivenScalingTrigger `json:"triggers,omitempty"`
+
+	// Schedules define time windows during which the deployment is scaled
+	// to at least the configured number of replicas, regardless of the triggers.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Schedules []EventDrivenScalingSchedule `json:"schedules,omitempty"`
+}
+
+// EventDrivenScalingTriggerType is the type of an event-driven scaling trigger.
+type EventDrivenScalingTriggerType string
+
+const (
+	// EventDrivenScalingTriggerTypeRequestRate scales on the rate of HTTP
+	// requests per second proxied by the DataPlane.
+	EventDrivenScalingTriggerTypeRequestRate EventDrivenScalingTriggerType = "RequestRate"
+	// EventDrivenScalingTriggerTypeActiveConnections scales on the number of
+	// active connections handled by the DataPlane.
+	EventDrivenScalingTriggerTypeActiveConnections EventDrivenScalingTriggerType = "ActiveConnections"
+	// EventDrivenScalingTriggerTypePrometheus scales on the result of a custom
+	// Prometheus query.
+	EventDrivenScalingTriggerTypePrometheus EventDrivenScalingTriggerType = "Prometheus"
+)
+
+// EventDrivenScalingTrigger defines a metric that the deployment is scaled on.
+// The metrics are queried from Prometheus, which is expected to scrape
+// the DataPlane metrics, e.g. using the DataPlane monitoring options.
+//
+// +kubebuilder:validation:XValidation:message="query must be set for Prometheus triggers and only for them",rule="self.type == 'Prometheus' ? has(self.query) : !has(self.query)"
+// +apireference:kgo:include
+type EventDrivenScalingTrigger struct {
+	// Type is the type of the trigger.
+	//
+	// `RequestRate` scales on the rate of HTTP requests per second proxied by the DataPlane.
+	//
+	// `ActiveConnections` scales on the number of active connections handled by the DataPlane.
+	//
+	// `Prometheus` scales on the result of the query set in the Query field.
+	//
+	// +kubebuilder:validation:Enum=RequestRate;ActiveConnections;Prometheus
+	Type EventDrivenScalingTriggerType `json:"type"`
+
+	// ServerAddress is the address of the Prometheus server the metrics are queried from.
+	//
+	// +kubebuilder:validation:MinLength=1
+	ServerAddress string `json:"serverAddress"`
+
+	// Threshold is the target value of the metric per replica.
+	//
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Threshold string `json:"threshold"`
+
+	// Query is the Prometheus query for triggers of the `Prometheus` type.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Query *string `json:"query,omitempty"`
+}
+
+// EventDrivenScalingSchedule defines a time window during which the deployment
+// is scaled to at least the configured number of replicas.
+// +apireference:kgo:include
+type EventDrivenScalingSchedule struct {
+	// Timezone is the IANA Time Zone Database name the schedule is evaluated in,
+	// e.g. `Europe/Berlin`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Timezone string `json:"timezone"`
+
+	// Start is the cron expression describing the start of the time window.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Start string `json:"start"`
+
+	// End is the cron expression describing the end of the time window.
+	//
+	// +kubebuilder:validation:MinLength=1
+	End string `json:"end"`
+
+	// MinReplicas is the number of replicas the deployment is scaled to at least
+	// during the time window.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas int32 `json:"minReplicas"`
 }
 
 // HorizontalScaling defines horizontal scaling options for the deployment.
@@ -246,3 +382,150 @@ type NamespacedName struct {
 	Namespace string `json:"namespace"`
 	Name      string `json:"name"`
 }
+
+// MonitoringOptions defines the Prometheus Operator resources which the operator
+// creates to have the metrics of the managed pods scraped.
+// When set, the operator creates a metrics Service and a ServiceMonitor or
+// a PodMonitor, provided that the monitoring.coreos.com CRDs are installed
+// in the cluster.
+// +apireference:kgo:include
+type MonitoringOptions struct {
+	// Kind is the kind of the Prometheus Operator resource created to scrape the metrics.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=ServiceMonitor;PodMonitor
+	// +kubebuilder:default=ServiceMonitor
+	Kind MonitorKind `json:"kind,omitempty"`
+
+	// Labels are the additional labels set on the created ServiceMonitor or PodMonitor,
+	// e.g. to match the Prometheus' serviceMonitorSelector or podMonitorSelector.
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Interval at which the metrics should be scraped.
+	// When not set, the Prometheus' global scrape interval is used.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`
+	Interval *string `json:"interval,omitempty"`
+
+	// ScrapeTimeout is the timeout after which the scrape is ended.
+	// When not set, the Prometheus' global scrape timeout is used.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`
+	ScrapeTimeout *string `json:"scrapeTimeout,omitempty"`
+}
+
+// MonitorKind is the kind of the Prometheus Operator resource used to scrape metrics.
+//
+// Allowed values:
+//
+//   - `ServiceMonitor` scrapes the metrics through the metrics Service.
+//   - `PodMonitor` scrapes the metrics directly from the pods.
+//
+// +apireference:kgo:include
+type MonitorKind string
+
+const (
+	// MonitorKindServiceMonitor indicates that a ServiceMonitor is used to scrape metrics.
+	MonitorKindServiceMonitor MonitorKind = "ServiceMonitor"
+
+	// MonitorKindPodMonitor indicates that a PodMonitor is used to scrape metrics.
+	MonitorKindPodMonitor MonitorKind = "PodMonitor"
+)
+
+// ResourcePatch is a patch applied to resources generated by the operator,
+// after they have been generated and before they are created or updated.
+//
+// +apireference:kgo:include
+type ResourcePatch struct {
+	// Target selects the generated resources the patch is applied to.
+	Target ResourcePatchTarget `json:"target"`
+
+	// Type is the type of the patch.
+	//
+	// +kubebuilder:validation:Enum=JSONPatch;StrategicMerge
+	Type ResourcePatchType `json:"type"`
+
+	// Patch is the patch document, in JSON or YAML.
+	// For the JSONPatch type it is a list of RFC 6902 operations, e.g.
+	// `[{"op": "add", "path": "/metadata/labels/team", "value": "gateway"}]`.
+	// For the StrategicMerge type it is a partial object of the target's kind.
+	//
+	// The name, namespace and owner references of the patched resources, as well
+	// as the labels set by the operator, cannot be changed.
+	// The fields set by the patch are enforced on existing resources as well.
+	// Lists are enforced as a whole.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Patch string `json:"patch"`
+}
+
+// ResourcePatchTarget selects the generated resources a patch is applied to.
+//
+// +apireference:kgo:include
+type ResourcePatchTarget struct {
+	// Kind is the kind of the targeted resources.
+	//
+	// +kubebuilder:validation:Enum=Deployment;Service;HorizontalPodAutoscaler;PodDisruptionBudget;NetworkPolicy;ServiceAccount;ClusterRole;ClusterRoleBinding
+	Kind ResourcePatchTargetKind `json:"kind"`
+
+	// Name is the name of the targeted resource. For resources whose names are
+	// generated, it is matched against their name prefix, e.g.
+	// `dataplane-admin-my-dataplane-`.
+	// When not set, the patch is applied to all the generated resources of Kind.
+	//
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// ResourcePatchType is the type of a ResourcePatch.
+//
+// Allowed values:
+//
+//   - `JSONPatch` is a RFC 6902 JSON patch.
+//   - `StrategicMerge` is a Kubernetes strategic merge patch.
+//
+// +apireference:kgo:include
+type ResourcePatchType string
+
+const (
+	// ResourcePatchTypeJSONPatch indicates a RFC 6902 JSON patch.
+	ResourcePatchTypeJSONPatch ResourcePatchType = "JSONPatch"
+
+	// ResourcePatchTypeStrategicMerge indicates a Kubernetes strategic merge patch.
+	ResourcePatchTypeStrategicMerge ResourcePatchType = "StrategicMerge"
+)
+
+// ResourcePatchTargetKind is the kind of the resources targeted by a ResourcePatch.
+//
+// +apireference:kgo:include
+type ResourcePatchTargetKind string
+
+const (
+	// ResourcePatchTargetKindDeployment targets Deployments.
+	ResourcePatchTargetKindDeployment ResourcePatchTargetKind = "Deployment"
+
+	// ResourcePatchTargetKindService targets Services.
+	ResourcePatchTargetKindService ResourcePatchTargetKind = "Service"
+
+	// ResourcePatchTargetKindHorizontalPodAutoscaler targets HorizontalPodAutoscalers.
+	ResourcePatchTargetKindHorizontalPodAutoscaler ResourcePatchTargetKind = "HorizontalPodAutoscaler"
+
+	// ResourcePatchTargetKindPodDisruptionBudget targets PodDisruptionBudgets.
+	ResourcePatchTargetKindPodDisruptionBudget ResourcePatchTargetKind = "PodDisruptionBudget"
+
+	// ResourcePatchTargetKindNetworkPolicy targets NetworkPolicies.
+	ResourcePatchTargetKindNetworkPolicy ResourcePatchTargetKind = "NetworkPolicy"
+
+	// ResourcePatchTargetKindServiceAccount targets ServiceAccounts.
+	ResourcePatchTargetKindServiceAccount ResourcePatchTargetKind = "ServiceAccount"
+
+	// ResourcePatchTargetKindClusterRole targets ClusterRoles.
+	ResourcePatchTargetKindClusterRole ResourcePatchTargetKind = "ClusterRole"
+
+	// ResourcePatchTargetKindClusterRoleBinding targets ClusterRoleBindings.
+	ResourcePatchTargetKindClusterRoleBinding ResourcePatchTargetKind = "ClusterRoleBinding"
+)
@@ -24,6 +24,7 @@ import (
 	"github.com/kong/gateway-operator/api/v1alpha1"
 	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -168,6 +169,16 @@ func (in *ControlPlaneOptions) DeepCopyInto(out *ControlPlaneOptions) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ResourcePatch, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneOptions.
@@ -255,6 +266,22 @@ func (in *DataPlane) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneDatabaseOptions) DeepCopyInto(out *DataPlaneDatabaseOptions) {
+	*out = *in
+	out.Postgres = in.Postgres
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneDatabaseOptions.
+func (in *DataPlaneDatabaseOptions) DeepCopy() *DataPlaneDatabaseOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneDatabaseOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlaneDeploymentOptions) DeepCopyInto(out *DataPlaneDeploymentOptions) {
 	*out = *in
@@ -276,6 +303,63 @@ func (in *DataPlaneDeploymentOptions) DeepCopy() *DataPlaneDeploymentOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneHybridControlPlaneRef) DeepCopyInto(out *DataPlaneHybridControlPlaneRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneHybridControlPlaneRef.
+func (in *DataPlaneHybridControlPlaneRef) DeepCopy() *DataPlaneHybridControlPlaneRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneHybridControlPlaneRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneHybridOptions) DeepCopyInto(out *DataPlaneHybridOptions) {
+	*out = *in
+	if in.ControlPlaneRef != nil {
+		in, out := &in.ControlPlaneRef, &out.ControlPlaneRef
+		*out = new(DataPlaneHybridControlPlaneRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneHybridOptions.
+func (in *DataPlaneHybridOptions) DeepCopy() *DataPlaneHybridOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneHybridOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneIngressServiceStatus) DeepCopyInto(out *DataPlaneIngressServiceStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]Address, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneIngressServiceStatus.
+func (in *DataPlaneIngressServiceStatus) DeepCopy() *DataPlaneIngressServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneIngressServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlaneList) DeepCopyInto(out *DataPlaneList) {
 	*out = *in
@@ -308,6 +392,22 @@ func (in *DataPlaneList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneNamedServiceOptions) DeepCopyInto(out *DataPlaneNamedServiceOptions) {
+	*out = *in
+	in.DataPlaneServiceOptions.DeepCopyInto(&out.DataPlaneServiceOptions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneNamedServiceOptions.
+func (in *DataPlaneNamedServiceOptions) DeepCopy() *DataPlaneNamedServiceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneNamedServiceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlaneNetworkOptions) DeepCopyInto(out *DataPlaneNetworkOptions) {
 	*out = *in
@@ -333,6 +433,26 @@ func (in *DataPlaneNetworkOptions) DeepCopy() *DataPlaneNetworkOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneObservabilityOptions) DeepCopyInto(out *DataPlaneObservabilityOptions) {
+	*out = *in
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(DataPlaneTracingOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneObservabilityOptions.
+func (in *DataPlaneObservabilityOptions) DeepCopy() *DataPlaneObservabilityOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneObservabilityOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlaneOptions) DeepCopyInto(out *DataPlaneOptions) {
 	*out = *in
@@ -351,6 +471,36 @@ func (in *DataPlaneOptions) DeepCopyInto(out *DataPlaneOptions) {
 		*out = make([]NamespacedName, len(*in))
 		copy(*out, *in)
 	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(DataPlaneObservabilityOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KongConfig != nil {
+		in, out := &in.KongConfig, &out.KongConfig
+		*out = new(KongConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(DataPlaneDatabaseOptions)
+		**out = **in
+	}
+	if in.Hybrid != nil {
+		in, out := &in.Hybrid, &out.Hybrid
+		*out = new(DataPlaneHybridOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ResourcePatch, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneOptions.
@@ -363,6 +513,52 @@ func (in *DataPlaneOptions) DeepCopy() *DataPlaneOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlanePodStatus) DeepCopyInto(out *DataPlanePodStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlanePodStatus.
+func (in *DataPlanePodStatus) DeepCopy() *DataPlanePodStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlanePodStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlanePostgresCredentialsSecretRef) DeepCopyInto(out *DataPlanePostgresCredentialsSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlanePostgresCredentialsSecretRef.
+func (in *DataPlanePostgresCredentialsSecretRef) DeepCopy() *DataPlanePostgresCredentialsSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlanePostgresCredentialsSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlanePostgresOptions) DeepCopyInto(out *DataPlanePostgresOptions) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlanePostgresOptions.
+func (in *DataPlanePostgresOptions) DeepCopy() *DataPlanePostgresOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlanePostgresOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlaneResources) DeepCopyInto(out *DataPlaneResources) {
 	*out = *in
@@ -500,6 +696,13 @@ func (in *DataPlaneServices) DeepCopyInto(out *DataPlaneServices) {
 		*out = new(DataPlaneServiceOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalIngresses != nil {
+		in, out := &in.AdditionalIngresses, &out.AdditionalIngresses
+		*out = make([]DataPlaneNamedServiceOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneServices.
@@ -512,6 +715,21 @@ func (in *DataPlaneServices) DeepCopy() *DataPlaneServices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSharing) DeepCopyInto(out *DataPlaneSharing) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSharing.
+func (in *DataPlaneSharing) DeepCopy() *DataPlaneSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlaneSpec) DeepCopyInto(out *DataPlaneSpec) {
 	*out = *in
@@ -545,11 +763,23 @@ func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AdditionalIngresses != nil {
+		in, out := &in.AdditionalIngresses, &out.AdditionalIngresses
+		*out = make([]DataPlaneIngressServiceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RolloutStatus != nil {
 		in, out := &in.RolloutStatus, &out.RolloutStatus
 		*out = new(DataPlaneRolloutStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]DataPlanePodStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneStatus.
@@ -562,6 +792,38 @@ func (in *DataPlaneStatus) DeepCopy() *DataPlaneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneTracingOptions) DeepCopyInto(out *DataPlaneTracingOptions) {
+	*out = *in
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Instrumentations != nil {
+		in, out := &in.Instrumentations, &out.Instrumentations
+		*out = make([]TracingInstrumentation, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceAttributes != nil {
+		in, out := &in.ResourceAttributes, &out.ResourceAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneTracingOptions.
+func (in *DataPlaneTracingOptions) DeepCopy() *DataPlaneTracingOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneTracingOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentOptions) DeepCopyInto(out *DeploymentOptions) {
 	*out = *in
@@ -592,6 +854,83 @@ func (in *DeploymentOptions) DeepCopy() *DeploymentOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventDrivenScaling) DeepCopyInto(out *EventDrivenScaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]EventDrivenScalingTrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]EventDrivenScalingSchedule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventDrivenScaling.
+func (in *EventDrivenScaling) DeepCopy() *EventDrivenScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(EventDrivenScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventDrivenScalingSchedule) DeepCopyInto(out *EventDrivenScalingSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventDrivenScalingSchedule.
+func (in *EventDrivenScalingSchedule) DeepCopy() *EventDrivenScalingSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(EventDrivenScalingSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventDrivenScalingTrigger) DeepCopyInto(out *EventDrivenScalingTrigger) {
+	*out = *in
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventDrivenScalingTrigger.
+func (in *EventDrivenScalingTrigger) DeepCopy() *EventDrivenScalingTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(EventDrivenScalingTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfigDataPlaneNetworkOptions) DeepCopyInto(out *GatewayConfigDataPlaneNetworkOptions) {
 	*out = *in
@@ -600,6 +939,11 @@ func (in *GatewayConfigDataPlaneNetworkOptions) DeepCopyInto(out *GatewayConfigD
 		*out = new(GatewayConfigDataPlaneServices)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(GatewayConfigDataPlaneNetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDataPlaneNetworkOptions.
@@ -612,6 +956,65 @@ func (in *GatewayConfigDataPlaneNetworkOptions) DeepCopy() *GatewayConfigDataPla
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigDataPlaneNetworkPolicy) DeepCopyInto(out *GatewayConfigDataPlaneNetworkPolicy) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]networkingv1.NetworkPolicyIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(GatewayConfigDataPlaneNetworkPolicyEgress)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDataPlaneNetworkPolicy.
+func (in *GatewayConfigDataPlaneNetworkPolicy) DeepCopy() *GatewayConfigDataPlaneNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigDataPlaneNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigDataPlaneNetworkPolicyEgress) DeepCopyInto(out *GatewayConfigDataPlaneNetworkPolicyEgress) {
+	*out = *in
+	if in.UpstreamNamespaces != nil {
+		in, out := &in.UpstreamNamespaces, &out.UpstreamNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDataPlaneNetworkPolicyEgress.
+func (in *GatewayConfigDataPlaneNetworkPolicyEgress) DeepCopy() *GatewayConfigDataPlaneNetworkPolicyEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigDataPlaneNetworkPolicyEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfigDataPlaneOptions) DeepCopyInto(out *GatewayConfigDataPlaneOptions) {
 	*out = *in
@@ -629,6 +1032,26 @@ func (in *GatewayConfigDataPlaneOptions) DeepCopyInto(out *GatewayConfigDataPlan
 		*out = make([]NamespacedName, len(*in))
 		copy(*out, *in)
 	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(DataPlaneObservabilityOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KongConfig != nil {
+		in, out := &in.KongConfig, &out.KongConfig
+		*out = new(KongConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ResourcePatch, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDataPlaneOptions.
@@ -649,6 +1072,18 @@ func (in *GatewayConfigDataPlaneServices) DeepCopyInto(out *GatewayConfigDataPla
 		*out = new(GatewayConfigServiceOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalIngresses != nil {
+		in, out := &in.AdditionalIngresses, &out.AdditionalIngresses
+		*out = make([]GatewayConfigNamedServiceOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GatewayAddressesFrom != nil {
+		in, out := &in.GatewayAddressesFrom, &out.GatewayAddressesFrom
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDataPlaneServices.
@@ -661,6 +1096,57 @@ func (in *GatewayConfigDataPlaneServices) DeepCopy() *GatewayConfigDataPlaneServ
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigGuardrails) DeepCopyInto(out *GatewayConfigGuardrails) {
+	*out = *in
+	if in.MaxGatewaysPerNamespace != nil {
+		in, out := &in.MaxGatewaysPerNamespace, &out.MaxGatewaysPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxListeners != nil {
+		in, out := &in.MaxListeners, &out.MaxListeners
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDataPlaneReplicas != nil {
+		in, out := &in.MaxDataPlaneReplicas, &out.MaxDataPlaneReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedServiceTypes != nil {
+		in, out := &in.AllowedServiceTypes, &out.AllowedServiceTypes
+		*out = make([]corev1.ServiceType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigGuardrails.
+func (in *GatewayConfigGuardrails) DeepCopy() *GatewayConfigGuardrails {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigGuardrails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigNamedServiceOptions) DeepCopyInto(out *GatewayConfigNamedServiceOptions) {
+	*out = *in
+	in.GatewayConfigServiceOptions.DeepCopyInto(&out.GatewayConfigServiceOptions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigNamedServiceOptions.
+func (in *GatewayConfigNamedServiceOptions) DeepCopy() *GatewayConfigNamedServiceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigNamedServiceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfigServiceOptions) DeepCopyInto(out *GatewayConfigServiceOptions) {
 	*out = *in
@@ -749,6 +1235,16 @@ func (in *GatewayConfigurationSpec) DeepCopyInto(out *GatewayConfigurationSpec)
 		*out = new(ControlPlaneOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DataPlaneSharing != nil {
+		in, out := &in.DataPlaneSharing, &out.DataPlaneSharing
+		*out = new(DataPlaneSharing)
+		**out = **in
+	}
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = new(GatewayConfigGuardrails)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigurationSpec.
@@ -815,6 +1311,200 @@ func (in *HorizontalScaling) DeepCopy() *HorizontalScaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongConfig) DeepCopyInto(out *KongConfig) {
+	*out = *in
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = new(KongListenersConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NginxDirectives != nil {
+		in, out := &in.NginxDirectives, &out.NginxDirectives
+		*out = make([]KongNginxDirective, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(KongLogLevel)
+		**out = **in
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(KongDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(KongTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongConfig.
+func (in *KongConfig) DeepCopy() *KongConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KongConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongDNSConfig) DeepCopyInto(out *KongDNSConfig) {
+	*out = *in
+	if in.Resolvers != nil {
+		in, out := &in.Resolvers, &out.Resolvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]KongDNSRecordType, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValidTTL != nil {
+		in, out := &in.ValidTTL, &out.ValidTTL
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongDNSConfig.
+func (in *KongDNSConfig) DeepCopy() *KongDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KongDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongListener) DeepCopyInto(out *KongListener) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]KongListenerParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Backlog != nil {
+		in, out := &in.Backlog, &out.Backlog
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongListener.
+func (in *KongListener) DeepCopy() *KongListener {
+	if in == nil {
+		return nil
+	}
+	out := new(KongListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongListenersConfig) DeepCopyInto(out *KongListenersConfig) {
+	*out = *in
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = make([]KongListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = make([]KongListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PortMaps != nil {
+		in, out := &in.PortMaps, &out.PortMaps
+		*out = make([]KongPortMap, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongListenersConfig.
+func (in *KongListenersConfig) DeepCopy() *KongListenersConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KongListenersConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongNginxDirective) DeepCopyInto(out *KongNginxDirective) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongNginxDirective.
+func (in *KongNginxDirective) DeepCopy() *KongNginxDirective {
+	if in == nil {
+		return nil
+	}
+	out := new(KongNginxDirective)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongPortMap) DeepCopyInto(out *KongPortMap) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongPortMap.
+func (in *KongPortMap) DeepCopy() *KongPortMap {
+	if in == nil {
+		return nil
+	}
+	out := new(KongPortMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongTLSConfig) DeepCopyInto(out *KongTLSConfig) {
+	*out = *in
+	if in.CipherSuite != nil {
+		in, out := &in.CipherSuite, &out.CipherSuite
+		*out = new(KongTLSCipherSuite)
+		**out = **in
+	}
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]KongTLSProtocol, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongTLSConfig.
+func (in *KongTLSConfig) DeepCopy() *KongTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KongTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonnectCertificateOptions) DeepCopyInto(out *KonnectCertificateOptions) {
 	*out = *in
@@ -831,6 +1521,38 @@ func (in *KonnectCertificateOptions) DeepCopy() *KonnectCertificateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringOptions) DeepCopyInto(out *MonitoringOptions) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScrapeTimeout != nil {
+		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringOptions.
+func (in *MonitoringOptions) DeepCopy() *MonitoringOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
@@ -907,6 +1629,37 @@ func (in *Promotion) DeepCopy() *Promotion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePatch) DeepCopyInto(out *ResourcePatch) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePatch.
+func (in *ResourcePatch) DeepCopy() *ResourcePatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePatchTarget) DeepCopyInto(out *ResourcePatchTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePatchTarget.
+func (in *ResourcePatchTarget) DeepCopy() *ResourcePatchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePatchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rollout) DeepCopyInto(out *Rollout) {
 	*out = *in
@@ -1004,6 +1757,11 @@ func (in *Scaling) DeepCopyInto(out *Scaling) {
 		*out = new(HorizontalScaling)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EventDrivenScaling != nil {
+		in, out := &in.EventDrivenScaling, &out.EventDrivenScaling
+		*out = new(EventDrivenScaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scaling.
@@ -1026,6 +1784,11 @@ func (in *ServiceOptions) DeepCopyInto(out *ServiceOptions) {
 			(*out)[key] = val
 		}
 	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOptions.
@@ -121,6 +121,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if k8sutils.IsReconciliationPaused(cp) {
+		log.Debug(logger, "reconciliation paused, skipping", cp)
+		k8sutils.SetPausedCondition(cp)
+		return r.patchStatus(ctx, logger, cp)
+	}
+	if k8sutils.RemoveCondition(consts.PausedType, cp) {
+		// Status update will trigger reconciliation.
+		return r.patchStatus(ctx, logger, cp)
+	}
+
 	// controlplane is deleted, just run garbage collection for cluster wide resources.
 	if !cp.DeletionTimestamp.IsZero() {
 		// wait for termination grace period before cleaning up roles and bindings
@@ -136,12 +146,32 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			}, nil
 		}
 
-		log.Trace(logger, "controlplane marked for deletion, removing owned cluster roles, cluster role bindings and validating webhook configurations", cp)
+		log.Trace(logger, "controlplane marked for deletion, removing owned cluster roles, cluster role bindings, validating webhook configurations and kong cluster plugins", cp)
 
 		newControlPlane := cp.DeepCopy()
 
+		// ensure that the KongClusterPlugins which were created for the ControlPlane are deleted
+		deletions, err := r.ensureOwnedKongClusterPluginsDeleted(ctx, cp)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if deletions {
+			log.Debug(logger, "KongClusterPlugin deleted", cp)
+			// KongClusterPlugins are not watched so requeue to ensure they are all gone.
+			return ctrl.Result{Requeue: true, RequeueAfter: controller.RequeueWithoutBackoff}, nil
+		}
+
+		// now that KongClusterPlugins are cleaned up, remove the relevant finalizer
+		if controllerutil.RemoveFinalizer(newControlPlane, string(ControlPlaneFinalizerCleanupKongClusterPlugin)) {
+			if err := r.Client.Patch(ctx, newControlPlane, client.MergeFrom(cp)); err != nil {
+				return ctrl.Result{}, err
+			}
+			log.Debug(logger, "KongClusterPlugins finalizer removed", cp)
+			return ctrl.Result{}, nil // ControlPlane update will requeue
+		}
+
 		// ensure that the ValidatingWebhookConfigurations which was created for the ControlPlane is deleted
-		deletions, err := r.ensureOwnedValidatingWebhookConfigurationDeleted(ctx, cp)
+		deletions, err = r.ensureOwnedValidatingWebhookConfigurationDeleted(ctx, cp)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -206,7 +236,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	crFinalizerSet := controllerutil.AddFinalizer(cp, string(ControlPlaneFinalizerCleanupClusterRole))
 	crbFinalizerSet := controllerutil.AddFinalizer(cp, string(ControlPlaneFinalizerCleanupClusterRoleBinding))
 	vwcFinalizerSet := controllerutil.AddFinalizer(cp, string(ControlPlaneFinalizerCleanupValidatingWebhookConfiguration))
-	if crFinalizerSet || crbFinalizerSet || vwcFinalizerSet {
+	kcpFinalizerSet := controllerutil.AddFinalizer(cp, string(ControlPlaneFinalizerCleanupKongClusterPlugin))
+	if crFinalizerSet || crbFinalizerSet || vwcFinalizerSet || kcpFinalizerSet {
 		log.Trace(logger, "setting finalizers", cp)
 		if err := r.Client.Update(ctx, cp); err != nil {
 			if k8serrors.IsConflict(err) {
@@ -370,6 +401,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 	deploymentParams.AdmissionWebhookCertSecretName = admissionWebhookCertificateSecretName
 
+	res, err = r.ensureMonitoringResources(ctx, logger, cp)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure monitoring resources: %w", err)
+	} else if res != op.Noop {
+		// Monitors are not watched so requeue to ensure they are up to date.
+		return ctrl.Result{Requeue: true, RequeueAfter: controller.RequeueWithoutBackoff}, nil
+	}
+
+	res, err = r.ensureTracingKongClusterPlugin(ctx, logger, cp, dataplane)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure opentelemetry KongClusterPlugin: %w", err)
+	} else if res != op.Noop {
+		// KongClusterPlugins are not watched so requeue to ensure they are up to date.
+		return ctrl.Result{Requeue: true, RequeueAfter: controller.RequeueWithoutBackoff}, nil
+	}
+
 	log.Trace(logger, "looking for existing Deployments for ControlPlane resource", cp)
 	res, controlplaneDeployment, err := r.ensureDeployment(ctx, logger, deploymentParams)
 	if err != nil {
@@ -14,4 +14,6 @@ const (
 	ControlPlaneFinalizerCleanupClusterRoleBinding ControlPlaneFinalizer = "gateway-operator.konghq.com/cleanup-clusterrolebinding"
 	// ControlPlaneFinalizerCleanupValidatingWebhookConfiguration is the finalizer to cleanup validatingwebhookconfigurations owned by controlplane on deleting.
 	ControlPlaneFinalizerCleanupValidatingWebhookConfiguration ControlPlaneFinalizer = "gateway-operator.konghq.com/cleanup-validatingwebhookconfiguration"
+	// ControlPlaneFinalizerCleanupKongClusterPlugin is the finalizer to cleanup kongclusterplugins owned by controlplane on deleting.
+	ControlPlaneFinalizerCleanupKongClusterPlugin ControlPlaneFinalizer = "gateway-operator.konghq.com/cleanup-kongclusterplugin"
 )
@@ -1,6 +1,7 @@
 package controlplane
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/controller/pkg/controlplane"
 	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/controller/pkg/monitoring"
 	"github.com/kong/gateway-operator/controller/pkg/op"
 	"github.com/kong/gateway-operator/controller/pkg/patch"
 	"github.com/kong/gateway-operator/controller/pkg/secrets"
@@ -28,6 +30,8 @@ import (
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 	k8sreduce "github.com/kong/gateway-operator/pkg/utils/kubernetes/reduce"
 	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
 )
 
 // numReplicasWhenNoDataPlane represents the desired number of replicas
@@ -230,6 +234,16 @@ func (r *Reconciler) ensureDeployment(
 			}
 		}
 
+		// ensure that the fields set by the user patches are up to date
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingDeployment, generatedDeployment,
+			operatorv1beta1.ResourcePatchTargetKindDeployment, params.ControlPlane.Spec.Patches)
+		if err != nil {
+			return op.Noop, nil, err
+		}
+		if patched {
+			updated = true
+		}
+
 		return patch.ApplyPatchIfNonEmpty(ctx, r.Client, logger, existingDeployment, oldExistingDeployment, params.ControlPlane, updated)
 	}
 
@@ -271,12 +285,20 @@ func (r *Reconciler) ensureServiceAccount(
 
 	generatedServiceAccount := k8sresources.GenerateNewServiceAccountForControlPlane(cp.Namespace, cp.Name)
 	k8sutils.SetOwnerForObject(generatedServiceAccount, cp)
+	if err := k8sresources.ApplyResourcePatches(generatedServiceAccount, operatorv1beta1.ResourcePatchTargetKindServiceAccount, cp.Spec.Patches); err != nil {
+		return false, nil, err
+	}
 
 	if count == 1 {
 		var updated bool
 		existingServiceAccount := &serviceAccounts[0]
 		updated, existingServiceAccount.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existingServiceAccount.ObjectMeta, generatedServiceAccount.ObjectMeta)
-		if updated {
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingServiceAccount, generatedServiceAccount,
+			operatorv1beta1.ResourcePatchTargetKindServiceAccount, cp.Spec.Patches)
+		if err != nil {
+			return false, nil, err
+		}
+		if updated || patched {
 			if err := r.Client.Update(ctx, existingServiceAccount); err != nil {
 				return false, existingServiceAccount, fmt.Errorf("failed updating ControlPlane's ServiceAccount %s: %w", existingServiceAccount.Name, err)
 			}
@@ -344,6 +366,9 @@ func (r *Reconciler) ensureClusterRole(
 		return false, nil, err
 	}
 	k8sutils.SetOwnerForObjectThroughLabels(generated, cp)
+	if err := k8sresources.ApplyResourcePatches(generated, operatorv1beta1.ResourcePatchTargetKindClusterRole, cp.Spec.Patches); err != nil {
+		return false, nil, err
+	}
 
 	if count == 1 {
 		var (
@@ -353,11 +378,18 @@ func (r *Reconciler) ensureClusterRole(
 		)
 
 		updated, existing.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existing.ObjectMeta, generated.ObjectMeta)
-		if updated ||
-			!cmp.Equal(existing.Rules, generated.Rules) ||
+		if !cmp.Equal(existing.Rules, generated.Rules) ||
 			!cmp.Equal(existing.AggregationRule, generated.AggregationRule) {
 			existing.Rules = generated.Rules
 			existing.AggregationRule = generated.AggregationRule
+			updated = true
+		}
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existing, generated,
+			operatorv1beta1.ResourcePatchTargetKindClusterRole, cp.Spec.Patches)
+		if err != nil {
+			return false, nil, err
+		}
+		if updated || patched {
 			if err := r.Client.Patch(ctx, existing, client.MergeFrom(old)); err != nil {
 				return false, existing, fmt.Errorf("failed patching ControlPlane's ClusterRole %s: %w", existing.Name, err)
 			}
@@ -425,6 +457,9 @@ func (r *Reconciler) ensureClusterRoleBinding(
 
 	generated := k8sresources.GenerateNewClusterRoleBindingForControlPlane(cp.Namespace, cp.Name, serviceAccountName, clusterRoleName)
 	k8sutils.SetOwnerForObjectThroughLabels(generated, cp)
+	if err := k8sresources.ApplyResourcePatches(generated, operatorv1beta1.ResourcePatchTargetKindClusterRoleBinding, cp.Spec.Patches); err != nil {
+		return false, nil, err
+	}
 
 	if count == 1 {
 		existing := &clusterRoleBindings[0]
@@ -453,7 +488,13 @@ func (r *Reconciler) ensureClusterRoleBinding(
 			updatedServiceAccount = true
 		}
 
-		if updated || updatedServiceAccount {
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existing, generated,
+			operatorv1beta1.ResourcePatchTargetKindClusterRoleBinding, cp.Spec.Patches)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if updated || updatedServiceAccount || patched {
 			if err := r.Client.Patch(ctx, existing, client.MergeFrom(old)); err != nil {
 				return false, existing, fmt.Errorf("failed patching ControlPlane's ClusterRoleBinding %s: %w", existing.Name, err)
 			}
@@ -680,6 +721,146 @@ func (r *Reconciler) ensureOwnedValidatingWebhookConfigurationDeleted(ctx contex
 	return deleted, errors.Join(errs...)
 }
 
+// ensureOwnedKongClusterPluginsDeleted removes all the KongClusterPlugins owned by the controlplane.
+// it is called on cleanup of owned cluster resources on controlplane deletion.
+func (r *Reconciler) ensureOwnedKongClusterPluginsDeleted(
+	ctx context.Context,
+	cp *operatorv1beta1.ControlPlane,
+) (deletions bool, err error) {
+	plugins, _, err := r.listOwnedKongClusterPlugins(ctx, cp)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		deleted bool
+		errs    []error
+	)
+	for i := range plugins {
+		err = r.Client.Delete(ctx, &plugins[i])
+		if client.IgnoreNotFound(err) != nil {
+			errs = append(errs, err)
+			continue
+		}
+		deleted = true
+	}
+	return deleted, errors.Join(errs...)
+}
+
+// listOwnedKongClusterPlugins lists the KongClusterPlugins owned by the controlplane.
+// It returns false when the KongClusterPlugin CRD is not installed in the cluster.
+func (r *Reconciler) listOwnedKongClusterPlugins(
+	ctx context.Context,
+	cp *operatorv1beta1.ControlPlane,
+) ([]configurationv1.KongClusterPlugin, bool, error) {
+	crdExists, err := k8sutils.CRDChecker{Client: r.Client}.CRDExists(
+		configurationv1.GroupVersion.WithResource("kongclusterplugins"),
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed checking if KongClusterPlugin CRD exists: %w", err)
+	}
+	if !crdExists {
+		return nil, false, nil
+	}
+
+	var plugins configurationv1.KongClusterPluginList
+	if err := r.Client.List(ctx, &plugins, client.MatchingLabels(k8sutils.GetManagedByLabelSet(cp))); err != nil {
+		return nil, true, fmt.Errorf("failed listing KongClusterPlugins for ControlPlane %s/%s: %w", cp.Namespace, cp.Name, err)
+	}
+	return plugins.Items, true, nil
+}
+
+// ensureTracingKongClusterPlugin ensures that the global opentelemetry KongClusterPlugin
+// exists when tracing is configured for the controlplane's dataplane, and that it is
+// deleted otherwise.
+func (r *Reconciler) ensureTracingKongClusterPlugin(
+	ctx context.Context,
+	logger logr.Logger,
+	cp *operatorv1beta1.ControlPlane,
+	dataplane *operatorv1beta1.DataPlane,
+) (op.Result, error) {
+	plugins, crdExists, err := r.listOwnedKongClusterPlugins(ctx, cp)
+	if err != nil {
+		return op.Noop, err
+	}
+
+	var tracing *operatorv1beta1.DataPlaneTracingOptions
+	if dataplane != nil && dataplane.Spec.Observability != nil {
+		tracing = dataplane.Spec.Observability.Tracing
+	}
+	if tracing == nil {
+		if len(plugins) == 0 {
+			return op.Noop, nil
+		}
+		deleted, err := r.ensureOwnedKongClusterPluginsDeleted(ctx, cp)
+		if err != nil {
+			return op.Noop, fmt.Errorf("failed deleting KongClusterPlugins for ControlPlane %s/%s: %w", cp.Namespace, cp.Name, err)
+		}
+		if deleted {
+			return op.Deleted, nil
+		}
+		return op.Noop, nil
+	}
+
+	if !crdExists {
+		log.Debug(logger, "KongClusterPlugin CRD not installed, skipping opentelemetry plugin creation", cp)
+		return op.Noop, nil
+	}
+
+	generated, err := k8sresources.GenerateNewOpenTelemetryKongClusterPluginForControlPlane(
+		cp,
+		controlplane.DeduceIngressClass(&cp.Spec.ControlPlaneOptions),
+		tracing,
+	)
+	if err != nil {
+		return op.Noop, err
+	}
+	k8sutils.SetOwnerForObjectThroughLabels(generated, cp)
+
+	if len(plugins) > 1 {
+		// Keep the first plugin and delete the rest.
+		for i := range plugins[1:] {
+			if err := r.Client.Delete(ctx, &plugins[1+i]); client.IgnoreNotFound(err) != nil {
+				return op.Noop, fmt.Errorf("failed deleting KongClusterPlugin %s: %w", plugins[1+i].Name, err)
+			}
+		}
+		plugins = plugins[:1]
+	}
+
+	if len(plugins) == 1 {
+		var updated bool
+		existing := &plugins[0]
+		updated, existing.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existing.ObjectMeta, generated.ObjectMeta)
+		if existing.Annotations[consts.IngressClassAnnotation] != generated.Annotations[consts.IngressClassAnnotation] {
+			if existing.Annotations == nil {
+				existing.Annotations = map[string]string{}
+			}
+			existing.Annotations[consts.IngressClassAnnotation] = generated.Annotations[consts.IngressClassAnnotation]
+			updated = true
+		}
+		if existing.PluginName != generated.PluginName {
+			existing.PluginName = generated.PluginName
+			updated = true
+		}
+		if !bytes.Equal(existing.Config.Raw, generated.Config.Raw) {
+			existing.Config = generated.Config
+			updated = true
+		}
+		if !updated {
+			return op.Noop, nil
+		}
+		if err := r.Client.Update(ctx, existing); err != nil {
+			return op.Noop, fmt.Errorf("failed updating KongClusterPlugin %s: %w", existing.Name, err)
+		}
+		return op.Updated, nil
+	}
+
+	if err := r.Client.Create(ctx, generated); err != nil {
+		return op.Noop, fmt.Errorf("failed creating KongClusterPlugin for ControlPlane %s/%s: %w", cp.Namespace, cp.Name, err)
+	}
+	return op.Created, nil
+}
+
 func (r *Reconciler) ensureAdmissionWebhookService(
 	ctx context.Context,
 	logger logr.Logger,
@@ -738,8 +919,13 @@ func (r *Reconciler) ensureAdmissionWebhookService(
 			existingService.Spec.Ports = generatedService.Spec.Ports
 			updated = true
 		}
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingService, generatedService,
+			operatorv1beta1.ResourcePatchTargetKindService, cp.Spec.Patches)
+		if err != nil {
+			return op.Noop, nil, err
+		}
 
-		if updated {
+		if updated || patched {
 			if err := cl.Update(ctx, existingService); err != nil {
 				return op.Noop, existingService, fmt.Errorf("failed updating ControlPlane admission webhook Service %s: %w", existingService.Name, err)
 			}
@@ -755,6 +941,100 @@ func (r *Reconciler) ensureAdmissionWebhookService(
 	return op.Created, generatedService, nil
 }
 
+// ensureMonitoringResources ensures the metrics Service and the ServiceMonitor
+// or PodMonitor scraping the ControlPlane's metrics exist when the ControlPlane
+// has monitoring configured. Otherwise, they are deleted.
+func (r *Reconciler) ensureMonitoringResources(
+	ctx context.Context,
+	logger logr.Logger,
+	cp *operatorv1beta1.ControlPlane,
+) (op.Result, error) {
+	matchingLabels := k8sresources.GetManagedLabelForOwner(cp)
+	matchingLabels[consts.ControlPlaneServiceLabel] = consts.ControlPlaneServiceKindMetrics
+
+	services, err := k8sutils.ListServicesForOwner(
+		ctx,
+		r.Client,
+		cp.Namespace,
+		cp.UID,
+		matchingLabels,
+	)
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed listing metrics Services for ControlPlane %s/%s: %w", cp.Namespace, cp.Name, err)
+	}
+
+	monitorMatchingLabels := k8sresources.GetManagedLabelForOwner(cp)
+	monitorMatchingLabels["app"] = cp.Name
+
+	if cp.Spec.Monitoring == nil {
+		// The metrics Service is created along with the monitor so there is nothing
+		// to clean up without it. This spares listing the monitors on every reconciliation.
+		if len(services) == 0 {
+			return op.Noop, nil
+		}
+		if _, err := monitoring.DeleteMonitors(ctx, r.Client, cp, monitorMatchingLabels); err != nil {
+			return op.Noop, fmt.Errorf("failed deleting monitors for ControlPlane %s/%s: %w", cp.Namespace, cp.Name, err)
+		}
+		for _, svc := range services {
+			if err := r.Client.Delete(ctx, &svc); client.IgnoreNotFound(err) != nil {
+				return op.Noop, fmt.Errorf("failed deleting ControlPlane metrics Service %s: %w", svc.Name, err)
+			}
+		}
+		return op.Deleted, nil
+	}
+
+	count := len(services)
+	if count > 1 {
+		if err := k8sreduce.ReduceServices(ctx, r.Client, services); err != nil {
+			return op.Noop, err
+		}
+		return op.Noop, errors.New("number of ControlPlane metrics Services reduced")
+	}
+
+	generatedService, err := k8sresources.GenerateNewMetricsServiceForControlPlane(cp)
+	if err != nil {
+		return op.Noop, err
+	}
+
+	if count == 1 {
+		var updated bool
+		existingService := &services[0]
+		updated, existingService.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existingService.ObjectMeta, generatedService.ObjectMeta)
+
+		if !cmp.Equal(existingService.Spec.Selector, generatedService.Spec.Selector) {
+			existingService.Spec.Selector = generatedService.Spec.Selector
+			updated = true
+		}
+		if !cmp.Equal(existingService.Spec.Ports, generatedService.Spec.Ports) {
+			existingService.Spec.Ports = generatedService.Spec.Ports
+			updated = true
+		}
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingService, generatedService,
+			operatorv1beta1.ResourcePatchTargetKindService, cp.Spec.Patches)
+		if err != nil {
+			return op.Noop, err
+		}
+
+		if updated || patched {
+			if err := r.Client.Update(ctx, existingService); err != nil {
+				return op.Noop, fmt.Errorf("failed updating ControlPlane metrics Service %s: %w", existingService.Name, err)
+			}
+			return op.Updated, nil
+		}
+	} else {
+		if err := r.Client.Create(ctx, generatedService); err != nil {
+			return op.Noop, fmt.Errorf("failed creating ControlPlane metrics Service: %w", err)
+		}
+		return op.Created, nil
+	}
+
+	return monitoring.EnsureMonitor(
+		ctx, r.Client, logger, cp,
+		k8sresources.GenerateMonitorForControlPlane(cp, cp.Spec.Monitoring),
+		monitorMatchingLabels,
+	)
+}
+
 func (r *Reconciler) ensureValidatingWebhookConfiguration(
 	ctx context.Context,
 	cp *operatorv1beta1.ControlPlane,
@@ -4,18 +4,23 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	admregv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/controller/pkg/op"
+	managerscheme "github.com/kong/gateway-operator/modules/manager/scheme"
 	"github.com/kong/gateway-operator/pkg/consts"
 	"github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
 )
 
 func Test_ensureValidatingWebhookConfiguration(t *testing.T) {
@@ -200,3 +205,86 @@ func Test_ensureValidatingWebhookConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func Test_ensureTracingKongClusterPlugin(t *testing.T) {
+	cp := &operatorv1beta1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp",
+			Namespace: "default",
+		},
+	}
+	dataplane := &operatorv1beta1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dp",
+			Namespace: "default",
+		},
+		Spec: operatorv1beta1.DataPlaneSpec{
+			DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+				Observability: &operatorv1beta1.DataPlaneObservabilityOptions{
+					Tracing: &operatorv1beta1.DataPlaneTracingOptions{
+						Endpoint: "http://otel-collector.observability:4318/v1/traces",
+					},
+				},
+			},
+		},
+	}
+
+	newReconciler := func(crdInstalled bool) *Reconciler {
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		if crdInstalled {
+			restMapper.Add(configurationv1.GroupVersion.WithKind("KongClusterPlugin"), meta.RESTScopeRoot)
+		}
+		return &Reconciler{
+			Client: fakectrlruntimeclient.NewClientBuilder().
+				WithScheme(managerscheme.Get()).
+				WithRESTMapper(restMapper).
+				Build(),
+		}
+	}
+	listPlugins := func(t *testing.T, r *Reconciler) []configurationv1.KongClusterPlugin {
+		var plugins configurationv1.KongClusterPluginList
+		require.NoError(t, r.Client.List(context.Background(), &plugins))
+		return plugins.Items
+	}
+
+	t.Run("plugin is created, updated and deleted", func(t *testing.T) {
+		ctx := context.Background()
+		r := newReconciler(true)
+		dp := dataplane.DeepCopy()
+
+		res, err := r.ensureTracingKongClusterPlugin(ctx, logr.Discard(), cp, dp)
+		require.NoError(t, err)
+		require.Equal(t, op.Created, res)
+		plugins := listPlugins(t, r)
+		require.Len(t, plugins, 1)
+		require.Equal(t, "opentelemetry", plugins[0].PluginName)
+		require.Equal(t, "true", plugins[0].Labels["global"])
+		require.Equal(t, "kong", plugins[0].Annotations["kubernetes.io/ingress.class"])
+
+		res, err = r.ensureTracingKongClusterPlugin(ctx, logr.Discard(), cp, dp)
+		require.NoError(t, err)
+		require.Equal(t, op.Noop, res)
+
+		dp.Spec.Observability.Tracing.Endpoint = "http://jaeger.observability:4318/v1/traces"
+		res, err = r.ensureTracingKongClusterPlugin(ctx, logr.Discard(), cp, dp)
+		require.NoError(t, err)
+		require.Equal(t, op.Updated, res)
+		plugins = listPlugins(t, r)
+		require.Len(t, plugins, 1)
+		require.JSONEq(t, `{"traces_endpoint":"http://jaeger.observability:4318/v1/traces"}`, string(plugins[0].Config.Raw))
+
+		dp.Spec.Observability = nil
+		res, err = r.ensureTracingKongClusterPlugin(ctx, logr.Discard(), cp, dp)
+		require.NoError(t, err)
+		require.Equal(t, op.Deleted, res)
+		require.Empty(t, listPlugins(t, r))
+	})
+
+	t.Run("plugin is skipped when its CRD is not installed", func(t *testing.T) {
+		r := newReconciler(false)
+
+		res, err := r.ensureTracingKongClusterPlugin(context.Background(), logr.Discard(), cp, dataplane)
+		require.NoError(t, err)
+		require.Equal(t, op.Noop, res)
+	})
+}
@@ -80,6 +80,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 						string(ControlPlaneFinalizerCleanupClusterRole),
 						string(ControlPlaneFinalizerCleanupClusterRoleBinding),
 						string(ControlPlaneFinalizerCleanupValidatingWebhookConfiguration),
+						string(ControlPlaneFinalizerCleanupKongClusterPlugin),
 					},
 				},
 				Spec: operatorv1beta1.ControlPlaneSpec{
@@ -262,6 +263,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 						string(ControlPlaneFinalizerCleanupClusterRole),
 						string(ControlPlaneFinalizerCleanupClusterRoleBinding),
 						string(ControlPlaneFinalizerCleanupValidatingWebhookConfiguration),
+						string(ControlPlaneFinalizerCleanupKongClusterPlugin),
 					},
 				},
 				Spec: operatorv1beta1.ControlPlaneSpec{
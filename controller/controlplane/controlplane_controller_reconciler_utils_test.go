@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -279,3 +280,88 @@ func TestEnsureClusterRoleBinding(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureServiceAccount(t *testing.T) {
+	controlPlane := &operatorv1beta1.ControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway-operator.konghq.com/v1beta1",
+			Kind:       "ControlPlane",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cp",
+			Namespace: "test-ns",
+			UID:       types.UID(uuid.NewString()),
+		},
+		Spec: operatorv1beta1.ControlPlaneSpec{
+			ControlPlaneOptions: operatorv1beta1.ControlPlaneOptions{
+				Patches: []operatorv1beta1.ResourcePatch{
+					{
+						Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindServiceAccount},
+						Type:   operatorv1beta1.ResourcePatchTypeStrategicMerge,
+						Patch:  "metadata:\n  annotations:\n    eks.amazonaws.com/role-arn: arn:aws:iam::123456789012:role/kong\nautomountServiceAccountToken: false\n",
+					},
+				},
+			},
+		},
+	}
+
+	generatedServiceAccount := func() *corev1.ServiceAccount {
+		sa := k8sresources.GenerateNewServiceAccountForControlPlane(controlPlane.Namespace, controlPlane.Name)
+		sa.Name = "test-sa"
+		k8sutils.SetOwnerForObject(sa, controlPlane)
+		return sa
+	}
+
+	upToDateServiceAccount := generatedServiceAccount()
+	upToDateServiceAccount.Annotations = map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/kong"}
+	upToDateServiceAccount.AutomountServiceAccountToken = lo.ToPtr(false)
+
+	testCases := []struct {
+		name             string
+		existingSA       *corev1.ServiceAccount
+		createdOrUpdated bool
+	}{
+		{
+			name:             "no ServiceAccount, should create a patched one",
+			createdOrUpdated: true,
+		},
+		{
+			name:             "ServiceAccount created before the patches, should update",
+			existingSA:       generatedServiceAccount(),
+			createdOrUpdated: true,
+		},
+		{
+			name:       "ServiceAccount is up to date",
+			existingSA: upToDateServiceAccount,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objectsToAdd := []controllerruntimeclient.Object{controlPlane}
+			if tc.existingSA != nil {
+				objectsToAdd = append(objectsToAdd, tc.existingSA)
+			}
+			fakeClient := fakectrlruntimeclient.
+				NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(objectsToAdd...).
+				Build()
+
+			r := Reconciler{
+				Client: fakeClient,
+				Scheme: scheme.Scheme,
+			}
+
+			createdOrUpdated, sa, err := r.ensureServiceAccount(context.Background(), controlPlane)
+			require.NoError(t, err)
+			require.Equal(t, tc.createdOrUpdated, createdOrUpdated)
+
+			var stored corev1.ServiceAccount
+			require.NoError(t, fakeClient.Get(context.Background(), controllerruntimeclient.ObjectKeyFromObject(sa), &stored))
+			require.Equal(t, "arn:aws:iam::123456789012:role/kong", stored.Annotations["eks.amazonaws.com/role-arn"])
+			require.Equal(t, lo.ToPtr(false), stored.AutomountServiceAccountToken)
+			require.Equal(t, consts.ControlPlaneManagedLabelValue, stored.Labels[consts.GatewayOperatorManagedByLabel])
+		})
+	}
+}
@@ -91,6 +91,12 @@ func (r *BlueGreenReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	logger := log.GetLogger(ctx, "dataplaneBlueGreen", r.DevelopmentMode)
 
+	// Paused reconciliation is handled by the DataPlane controller.
+	if k8sutils.IsReconciliationPaused(&dataplane) {
+		log.Trace(logger, "reconciliation paused, delegating to DataPlaneReconciler", req)
+		return r.DataPlaneController.Reconcile(ctx, req)
+	}
+
 	// Blue Green rollout strategy is not enabled, delegate to DataPlane controller.
 	if dataplane.Spec.Deployment.Rollout == nil || dataplane.Spec.Deployment.Rollout.Strategy.BlueGreen == nil {
 		if err := r.prunePreviewSubresources(ctx, &dataplane); err != nil {
@@ -174,8 +180,8 @@ func (r *BlueGreenReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err != nil {
 		cErr := r.ensureRolledOutCondition(ctx, logger, &dataplane, metav1.ConditionFalse, consts.DataPlaneConditionReasonRolloutFailed, "failed to ensure preview ingress Service")
 		return ctrl.Result{}, fmt.Errorf("failed ensuring preview Ingress service for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, errors.Join(cErr, err))
-	} else if res == op.Created || res == op.Updated {
-		return ctrl.Result{}, nil // dataplane ingress service creation/update will trigger reconciliation
+	} else if res == op.Created || res == op.Updated || res == op.Deleted {
+		return ctrl.Result{}, nil // dataplane ingress service creation/update/deletion will trigger reconciliation
 	}
 
 	// ensure status of "preview" service is updated in status.rollout.services.
@@ -789,6 +795,7 @@ func (r *BlueGreenReconciler) ensurePreviewIngressService(
 	case op.Noop:
 		log.Trace(logger, "no need for preview ingress service update", dataplane)
 	case op.Deleted:
+		log.Debug(logger, "preview ingress service deleted to be recreated", dataplane)
 	}
 
 	return res, svc, nil
@@ -0,0 +1,505 @@
+package dataplane
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+)
+
+const (
+	// configurationSyncCheckInterval is the interval at which the configuration
+	// state of DataPlane Pods is checked.
+	configurationSyncCheckInterval = 30 * time.Second
+
+	// emptyConfigurationHash is the configuration hash reported by Kong
+	// when it hasn't loaded any configuration yet.
+	emptyConfigurationHash = "00000000000000000000000000000000"
+)
+
+// -----------------------------------------------------------------------------
+// DataPlane - Configuration sync status
+// -----------------------------------------------------------------------------
+
+// ConfigurationHashGetter retrieves the hash of the configuration loaded by a DataPlane Pod.
+type ConfigurationHashGetter interface {
+	GetConfigurationHash(ctx context.Context, pod *corev1.Pod, adminService *corev1.Service) (string, error)
+}
+
+// ConfigurationSyncStatusUpdater periodically records the configuration hash
+// loaded by the ready Pods of every DataPlane in its status. It runs outside of
+// the DataPlane reconcilers so that querying the Pods' Admin API doesn't block
+// their workers. Pods are listed through the provided uncached reader, scoped
+// to the DataPlane's namespace and Deployment selector, so that no cluster-wide
+// Pod informer is started.
+type ConfigurationSyncStatusUpdater struct {
+	client          client.Client
+	podReader       client.Reader
+	getter          ConfigurationHashGetter
+	interval        time.Duration
+	developmentMode bool
+}
+
+// NewConfigurationSyncStatusUpdater returns a new ConfigurationSyncStatusUpdater.
+func NewConfigurationSyncStatusUpdater(
+	cl client.Client,
+	podReader client.Reader,
+	getter ConfigurationHashGetter,
+	developmentMode bool,
+) *ConfigurationSyncStatusUpdater {
+	return &ConfigurationSyncStatusUpdater{
+		client:          cl,
+		podReader:       podReader,
+		getter:          getter,
+		interval:        configurationSyncCheckInterval,
+		developmentMode: developmentMode,
+	}
+}
+
+// SetupWithManager adds the ConfigurationSyncStatusUpdater to the manager.
+func (u *ConfigurationSyncStatusUpdater) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return mgr.Add(u)
+}
+
+// NeedLeaderElection implements the manager.LeaderElectionRunnable interface.
+func (u *ConfigurationSyncStatusUpdater) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements the manager.Runnable interface. It updates the configuration
+// sync status of all the DataPlanes at every interval until the context is done.
+func (u *ConfigurationSyncStatusUpdater) Start(ctx context.Context) error {
+	logger := log.GetLogger(ctx, "dataplane_configuration_sync_status", u.developmentMode)
+	ticker := time.NewTicker(u.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			u.updateAll(ctx, logger)
+		}
+	}
+}
+
+// updateAll updates the configuration sync status of all the DataPlanes.
+// Failures are logged and retried at the next interval.
+func (u *ConfigurationSyncStatusUpdater) updateAll(ctx context.Context, logger logr.Logger) {
+	var dataplanes operatorv1beta1.DataPlaneList
+	if err := u.client.List(ctx, &dataplanes); err != nil {
+		logger.Error(err, "failed listing DataPlanes")
+		return
+	}
+	for i := range dataplanes.Items {
+		dataplane := &dataplanes.Items[i]
+		if !dataplane.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := u.update(ctx, logger, dataplane); err != nil {
+			log.Error(logger, err, "failed updating configuration sync status of DataPlane", dataplane)
+		}
+	}
+}
+
+// update updates the configuration sync status of the provided DataPlane using
+// its live Deployment and Admin API Service. DataPlanes which don't have them
+// yet are skipped.
+func (u *ConfigurationSyncStatusUpdater) update(ctx context.Context, logger logr.Logger, dataplane *operatorv1beta1.DataPlane) error {
+	deployments, err := k8sutils.ListDeploymentsForOwner(ctx, u.client, dataplane.Namespace, dataplane.UID,
+		client.MatchingLabels{consts.DataPlaneDeploymentStateLabel: consts.DataPlaneStateLabelValueLive},
+	)
+	if err != nil {
+		return fmt.Errorf("failed listing Deployments: %w", err)
+	}
+	services, err := k8sutils.ListServicesForOwner(ctx, u.client, dataplane.Namespace, dataplane.UID,
+		client.MatchingLabels{
+			consts.DataPlaneServiceTypeLabel:  string(consts.DataPlaneAdminServiceLabelValue),
+			consts.DataPlaneServiceStateLabel: consts.DataPlaneStateLabelValueLive,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed listing Services: %w", err)
+	}
+	if len(deployments) != 1 || len(services) != 1 {
+		return nil
+	}
+	return ensureDataPlaneConfigurationSyncStatus(ctx, u.client, u.podReader, logger, u.getter, dataplane, &deployments[0], &services[0])
+}
+
+// ensureDataPlaneConfigurationSyncStatus records the configuration hash of every
+// ready Pod of the DataPlane in its status, flags Pods whose configuration diverges
+// from the one loaded by the majority of Pods and sets the ConfigurationSynced
+// condition accordingly. The Pods are queried concurrently.
+// Only the Pods and the ConfigurationSynced condition are patched, with an optimistic
+// lock, so that concurrent status updates of the DataPlane reconcilers are not reverted.
+func ensureDataPlaneConfigurationSyncStatus(
+	ctx context.Context,
+	cl client.Client,
+	podReader client.Reader,
+	logger logr.Logger,
+	getter ConfigurationHashGetter,
+	dataplane *operatorv1beta1.DataPlane,
+	deployment *appsv1.Deployment,
+	adminService *corev1.Service,
+) error {
+	original := dataplane.DeepCopy()
+
+	// DataPlanes using a database don't report a configuration hash.
+	if dataplane.Spec.Database != nil {
+		k8sutils.RemoveCondition(consts.DataPlaneConditionTypeConfigurationSynced, dataplane)
+		dataplane.Status.Pods = nil
+		return patchDataPlaneConfigurationSyncStatus(ctx, cl, original, dataplane)
+	}
+
+	pods, err := listReadyPodsForDeployment(ctx, podReader, deployment)
+	if err != nil {
+		return err
+	}
+
+	var (
+		lock        sync.Mutex
+		wg          sync.WaitGroup
+		hashes      = make(map[string]string, len(pods))
+		unavailable []string
+	)
+	for i := range pods {
+		pod := &pods[i]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hash, err := getter.GetConfigurationHash(ctx, pod, adminService)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				log.Debug(logger, "failed to get configuration hash of DataPlane Pod", dataplane, "pod", pod.Name, "error", err)
+				unavailable = append(unavailable, pod.Name)
+				return
+			}
+			hashes[pod.Name] = hash
+		}()
+	}
+	wg.Wait()
+	sort.Strings(unavailable)
+
+	podStatuses, diverged, notLoaded := evaluateConfigurationSync(pods, hashes)
+	dataplane.Status.Pods = podStatuses
+
+	var (
+		status = metav1.ConditionTrue
+		reason = consts.DataPlaneConditionReasonConfigurationSynced
+		msg    = "all ready Pods have loaded the same configuration"
+	)
+	switch {
+	case len(pods) == 0:
+		status, reason, msg = metav1.ConditionUnknown, consts.DataPlaneConditionReasonConfigurationNoReadyPods,
+			"there are no ready Pods"
+	case len(unavailable) > 0:
+		status, reason, msg = metav1.ConditionUnknown, consts.DataPlaneConditionReasonConfigurationStatusUnavailable,
+			fmt.Sprintf("failed to get status of Pods: %s", strings.Join(unavailable, ", "))
+	case len(notLoaded) > 0:
+		status, reason, msg = metav1.ConditionFalse, consts.DataPlaneConditionReasonConfigurationNotLoaded,
+			fmt.Sprintf("Pods have not loaded any configuration: %s", strings.Join(notLoaded, ", "))
+	case len(diverged) > 0:
+		status, reason, msg = metav1.ConditionFalse, consts.DataPlaneConditionReasonConfigurationDiverged,
+			fmt.Sprintf("Pods have loaded a diverging configuration: %s", strings.Join(diverged, ", "))
+	}
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(consts.DataPlaneConditionTypeConfigurationSynced, status, reason, msg, dataplane.Generation),
+		dataplane,
+	)
+	return patchDataPlaneConfigurationSyncStatus(ctx, cl, original, dataplane)
+}
+
+// patchDataPlaneConfigurationSyncStatus patches the status of the DataPlane when
+// its conditions or Pods differ from the original ones.
+func patchDataPlaneConfigurationSyncStatus(
+	ctx context.Context,
+	cl client.Client,
+	original *operatorv1beta1.DataPlane,
+	updated *operatorv1beta1.DataPlane,
+) error {
+	if !k8sutils.NeedsUpdate(original, updated) && !podsChanged(original, updated) {
+		return nil
+	}
+	if err := cl.Status().Patch(ctx, updated, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("failed patching status for DataPlane %s/%s: %w", updated.Namespace, updated.Name, err)
+	}
+	return nil
+}
+
+// evaluateConfigurationSync compares the configuration hashes of the provided Pods
+// against the hash loaded by the majority of them (ties are broken by picking the
+// lexicographically smallest hash).
+// It returns the status of every Pod along with the names of Pods whose configuration
+// diverges and the names of Pods which haven't loaded any configuration.
+// Pods missing from hashes are reported as not synced with an empty hash.
+func evaluateConfigurationSync(
+	pods []corev1.Pod, hashes map[string]string,
+) (statuses []operatorv1beta1.DataPlanePodStatus, diverged []string, notLoaded []string) {
+	counts := make(map[string]int)
+	for _, hash := range hashes {
+		if isConfigurationLoaded(hash) {
+			counts[hash]++
+		}
+	}
+	var expected string
+	for hash, count := range counts {
+		if count > counts[expected] || (count == counts[expected] && hash < expected) {
+			expected = hash
+		}
+	}
+
+	statuses = make([]operatorv1beta1.DataPlanePodStatus, 0, len(pods))
+	for _, pod := range pods {
+		hash, ok := hashes[pod.Name]
+		synced := ok && isConfigurationLoaded(hash) && hash == expected
+		switch {
+		case !ok:
+		case !isConfigurationLoaded(hash):
+			notLoaded = append(notLoaded, pod.Name)
+		case !synced:
+			diverged = append(diverged, pod.Name)
+		}
+		statuses = append(statuses, operatorv1beta1.DataPlanePodStatus{
+			Name:              pod.Name,
+			ConfigurationHash: hash,
+			Synced:            synced,
+		})
+	}
+	return statuses, diverged, notLoaded
+}
+
+func isConfigurationLoaded(hash string) bool {
+	return hash != "" && hash != emptyConfigurationHash
+}
+
+// listReadyPodsForDeployment returns the ready, not terminating Pods of the
+// provided Deployment which have an IP assigned, sorted by name.
+func listReadyPodsForDeployment(ctx context.Context, cl client.Reader, deployment *appsv1.Deployment) ([]corev1.Pod, error) {
+	if deployment.Spec.Selector == nil || len(deployment.Spec.Selector.MatchLabels) == 0 {
+		return nil, nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := cl.List(ctx, podList,
+		client.InNamespace(deployment.Namespace),
+		client.MatchingLabels(deployment.Spec.Selector.MatchLabels),
+	); err != nil {
+		return nil, fmt.Errorf("failed listing Pods for Deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+
+	pods := make([]corev1.Pod, 0, len(podList.Items))
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp != nil || pod.Status.PodIP == "" || !isPodReady(&pod) {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	return pods, nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
+// Admin API configuration hash getter
+// -----------------------------------------------------------------------------
+
+// AdminAPIConfigurationHashGetter retrieves the configuration hash of DataPlane Pods
+// from the `/status` endpoint of their Admin API, using a client certificate
+// signed by the cluster CA.
+type AdminAPIConfigurationHashGetter struct {
+	client            client.Client
+	clusterCASecretNN types.NamespacedName
+	timeout           time.Duration
+
+	lock              sync.Mutex
+	caResourceVersion string
+	rootCAs           *x509.CertPool
+	certificate       *tls.Certificate
+}
+
+// NewAdminAPIConfigurationHashGetter returns a new AdminAPIConfigurationHashGetter
+// using the cluster CA stored in the provided Secret.
+func NewAdminAPIConfigurationHashGetter(cl client.Client, clusterCASecretNN types.NamespacedName) *AdminAPIConfigurationHashGetter {
+	return &AdminAPIConfigurationHashGetter{
+		client:            cl,
+		clusterCASecretNN: clusterCASecretNN,
+		timeout:           5 * time.Second,
+	}
+}
+
+// GetConfigurationHash returns the configuration hash reported by the Admin API of the provided Pod.
+func (g *AdminAPIConfigurationHashGetter) GetConfigurationHash(
+	ctx context.Context, pod *corev1.Pod, adminService *corev1.Service,
+) (string, error) {
+	rootCAs, certificate, err := g.getTLSCredentials(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	httpClient := &http.Client{
+		Timeout: g.timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				RootCAs:      rootCAs,
+				Certificates: []tls.Certificate{*certificate},
+				// The Admin API certificate is issued for the wildcard name of
+				// the Admin API Service, hence the Pod's IP based DNS name is used.
+				ServerName: podAdminAPIHostname(pod, adminService),
+			},
+		},
+	}
+	defer httpClient.CloseIdleConnections()
+
+	url := "https://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(consts.DataPlaneAdminAPIPort)) + "/status"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	var status struct {
+		ConfigurationHash string `json:"configuration_hash"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return "", fmt.Errorf("failed decoding response from %s: %w", url, err)
+	}
+	return status.ConfigurationHash, nil
+}
+
+// getTLSCredentials returns the cluster CA pool and a client certificate signed by
+// the cluster CA. The client certificate is regenerated when the CA Secret changes
+// or when the certificate is about to expire.
+func (g *AdminAPIConfigurationHashGetter) getTLSCredentials(ctx context.Context) (*x509.CertPool, *tls.Certificate, error) {
+	caSecret := &corev1.Secret{}
+	if err := g.client.Get(ctx, g.clusterCASecretNN, caSecret); err != nil {
+		return nil, nil, fmt.Errorf("failed getting cluster CA Secret %s: %w", g.clusterCASecretNN, err)
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.certificate != nil &&
+		g.caResourceVersion == caSecret.ResourceVersion &&
+		time.Until(g.certificate.Leaf.NotAfter) > time.Hour {
+		return g.rootCAs, g.certificate, nil
+	}
+
+	rootCAs, certificate, err := generateAdminAPIClientCertificate(caSecret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed generating Admin API client certificate: %w", err)
+	}
+	g.caResourceVersion = caSecret.ResourceVersion
+	g.rootCAs = rootCAs
+	g.certificate = certificate
+	return rootCAs, certificate, nil
+}
+
+// generateAdminAPIClientCertificate generates an in memory client certificate signed
+// by the CA stored in the provided Secret.
+func generateAdminAPIClientCertificate(caSecret *corev1.Secret) (*x509.CertPool, *tls.Certificate, error) {
+	caCertBlock, _ := pem.Decode(caSecret.Data["tls.crt"])
+	if caCertBlock == nil {
+		return nil, nil, fmt.Errorf("failed decoding 'tls.crt' data from secret %s", caSecret.Name)
+	}
+	caCert, err := x509.ParseCertificate(caCertBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	caKeyBlock, _ := pem.Decode(caSecret.Data["tls.key"])
+	if caKeyBlock == nil {
+		return nil, nil, fmt.Errorf("failed decoding 'tls.key' data from secret %s", caSecret.Name)
+	}
+	caKey, err := x509.ParseECPrivateKey(caKeyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	notAfter := now.Add(24 * time.Hour)
+	if caCert.NotAfter.Before(notAfter) {
+		notAfter = caCert.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "gateway-operator"},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(caCert)
+	return rootCAs, &tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+// podAdminAPIHostname returns the DNS name of the provided Pod under the
+// Admin API Service, e.g. 10-0-0-1.<service>.<namespace>.svc.
+func podAdminAPIHostname(pod *corev1.Pod, adminService *corev1.Service) string {
+	ip := strings.NewReplacer(".", "-", ":", "-").Replace(pod.Status.PodIP)
+	return fmt.Sprintf("%s.%s.%s.svc", ip, adminService.Name, adminService.Namespace)
+}
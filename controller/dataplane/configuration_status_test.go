@@ -0,0 +1,276 @@
+package dataplane
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/pkg/builder"
+	"github.com/kong/gateway-operator/modules/manager/scheme"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	"github.com/kong/gateway-operator/test/helpers"
+)
+
+type fakeConfigurationHashGetter struct {
+	hashes map[string]string
+}
+
+func (f fakeConfigurationHashGetter) GetConfigurationHash(_ context.Context, pod *corev1.Pod, _ *corev1.Service) (string, error) {
+	hash, ok := f.hashes[pod.Name]
+	if !ok {
+		return "", errors.New("connection refused")
+	}
+	return hash, nil
+}
+
+func TestEvaluateConfigurationSync(t *testing.T) {
+	pods := func(names ...string) []corev1.Pod {
+		pods := make([]corev1.Pod, 0, len(names))
+		for _, name := range names {
+			pods = append(pods, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		}
+		return pods
+	}
+
+	testCases := []struct {
+		name              string
+		pods              []corev1.Pod
+		hashes            map[string]string
+		expectedStatuses  []operatorv1beta1.DataPlanePodStatus
+		expectedDiverged  []string
+		expectedNotLoaded []string
+	}{
+		{
+			name:   "all pods synced",
+			pods:   pods("a", "b"),
+			hashes: map[string]string{"a": "hash1", "b": "hash1"},
+			expectedStatuses: []operatorv1beta1.DataPlanePodStatus{
+				{Name: "a", ConfigurationHash: "hash1", Synced: true},
+				{Name: "b", ConfigurationHash: "hash1", Synced: true},
+			},
+		},
+		{
+			name:   "pod diverging from the majority",
+			pods:   pods("a", "b", "c"),
+			hashes: map[string]string{"a": "hash2", "b": "hash1", "c": "hash2"},
+			expectedStatuses: []operatorv1beta1.DataPlanePodStatus{
+				{Name: "a", ConfigurationHash: "hash2", Synced: true},
+				{Name: "b", ConfigurationHash: "hash1", Synced: false},
+				{Name: "c", ConfigurationHash: "hash2", Synced: true},
+			},
+			expectedDiverged: []string{"b"},
+		},
+		{
+			name:   "tie is broken by the smallest hash",
+			pods:   pods("a", "b"),
+			hashes: map[string]string{"a": "hash2", "b": "hash1"},
+			expectedStatuses: []operatorv1beta1.DataPlanePodStatus{
+				{Name: "a", ConfigurationHash: "hash2", Synced: false},
+				{Name: "b", ConfigurationHash: "hash1", Synced: true},
+			},
+			expectedDiverged: []string{"a"},
+		},
+		{
+			name:   "pod without configuration and pod without status",
+			pods:   pods("a", "b", "c"),
+			hashes: map[string]string{"a": "hash1", "b": emptyConfigurationHash},
+			expectedStatuses: []operatorv1beta1.DataPlanePodStatus{
+				{Name: "a", ConfigurationHash: "hash1", Synced: true},
+				{Name: "b", ConfigurationHash: emptyConfigurationHash, Synced: false},
+				{Name: "c", Synced: false},
+			},
+			expectedNotLoaded: []string{"b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statuses, diverged, notLoaded := evaluateConfigurationSync(tc.pods, tc.hashes)
+			require.Equal(t, tc.expectedStatuses, statuses)
+			require.Equal(t, tc.expectedDiverged, diverged)
+			require.Equal(t, tc.expectedNotLoaded, notLoaded)
+		})
+	}
+}
+
+func TestEnsureDataPlaneConfigurationSyncStatus(t *testing.T) {
+	ctx := context.Background()
+
+	dp := builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      "dp",
+	}).Build()
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dataplane-dp"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "dp"}},
+		},
+	}
+	adminService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dataplane-admin-dp"}}
+	newPod := func(name string, ready bool) *corev1.Pod {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: map[string]string{"app": "dp"}},
+			Status: corev1.PodStatus{
+				PodIP:      "10.0.0.1",
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			},
+		}
+	}
+
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(dp, newPod("pod-a", true), newPod("pod-b", true), newPod("pod-c", false)).
+		WithStatusSubresource(dp).
+		Build()
+
+	ensure := func(t *testing.T, getter ConfigurationHashGetter) *operatorv1beta1.DataPlane {
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), dp))
+		require.NoError(t, ensureDataPlaneConfigurationSyncStatus(ctx, cl, cl, logr.Discard(), getter, dp, deployment, adminService))
+		current := &operatorv1beta1.DataPlane{}
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+		return current
+	}
+	requireCondition := func(t *testing.T, dataplane *operatorv1beta1.DataPlane, status metav1.ConditionStatus, reason consts.ConditionReason) {
+		c, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeConfigurationSynced, dataplane)
+		require.True(t, ok)
+		require.Equal(t, status, c.Status)
+		require.Equal(t, string(reason), c.Reason)
+	}
+
+	t.Log("ready pods with the same configuration are synced")
+	current := ensure(t, fakeConfigurationHashGetter{hashes: map[string]string{"pod-a": "hash1", "pod-b": "hash1"}})
+	requireCondition(t, current, metav1.ConditionTrue, consts.DataPlaneConditionReasonConfigurationSynced)
+	require.Equal(t, []operatorv1beta1.DataPlanePodStatus{
+		{Name: "pod-a", ConfigurationHash: "hash1", Synced: true},
+		{Name: "pod-b", ConfigurationHash: "hash1", Synced: true},
+	}, current.Status.Pods)
+
+	t.Log("pod without configuration")
+	current = ensure(t, fakeConfigurationHashGetter{hashes: map[string]string{"pod-a": "hash1", "pod-b": emptyConfigurationHash}})
+	requireCondition(t, current, metav1.ConditionFalse, consts.DataPlaneConditionReasonConfigurationNotLoaded)
+
+	t.Log("pod with unavailable status")
+	current = ensure(t, fakeConfigurationHashGetter{hashes: map[string]string{"pod-a": "hash1"}})
+	requireCondition(t, current, metav1.ConditionUnknown, consts.DataPlaneConditionReasonConfigurationStatusUnavailable)
+
+	t.Log("status is cleared for DataPlanes using a database")
+	dp.Spec.Database = &operatorv1beta1.DataPlaneDatabaseOptions{
+		Postgres: operatorv1beta1.DataPlanePostgresOptions{
+			Host:                 "postgres.db.svc",
+			CredentialsSecretRef: operatorv1beta1.DataPlanePostgresCredentialsSecretRef{Name: "kong-pg-credentials"},
+		},
+	}
+	require.NoError(t, cl.Update(ctx, dp))
+	current = ensure(t, fakeConfigurationHashGetter{hashes: map[string]string{"pod-a": "hash1", "pod-b": "hash1"}})
+	_, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeConfigurationSynced, current)
+	require.False(t, ok)
+	require.Empty(t, current.Status.Pods)
+}
+
+func TestConfigurationSyncStatusUpdater(t *testing.T) {
+	ctx := context.Background()
+
+	newDataPlane := func(name string, uid types.UID) *operatorv1beta1.DataPlane {
+		return builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			UID:       uid,
+		}).Build()
+	}
+	dp := newDataPlane("dp", "dp-uid")
+	notDeployed := newDataPlane("not-deployed", "not-deployed-uid")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "dataplane-dp",
+			Labels:    map[string]string{consts.DataPlaneDeploymentStateLabel: consts.DataPlaneStateLabelValueLive},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "dp"}},
+		},
+	}
+	k8sutils.SetOwnerForObject(deployment, dp)
+	adminService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "dataplane-admin-dp",
+			Labels: map[string]string{
+				consts.DataPlaneServiceTypeLabel:  string(consts.DataPlaneAdminServiceLabelValue),
+				consts.DataPlaneServiceStateLabel: consts.DataPlaneStateLabelValueLive,
+			},
+		},
+	}
+	k8sutils.SetOwnerForObject(adminService, dp)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod-a", Labels: map[string]string{"app": "dp"}},
+		Status: corev1.PodStatus{
+			PodIP:      "10.0.0.1",
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(dp, notDeployed, deployment, adminService).
+		WithStatusSubresource(dp, notDeployed).
+		Build()
+	// Pods are only available through the uncached reader.
+	podReader := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(pod).
+		Build()
+
+	updater := NewConfigurationSyncStatusUpdater(cl, podReader,
+		fakeConfigurationHashGetter{hashes: map[string]string{"pod-a": "hash1"}}, false,
+	)
+	updater.updateAll(ctx, logr.Discard())
+
+	current := &operatorv1beta1.DataPlane{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+	require.True(t, k8sutils.IsConditionTrue(consts.DataPlaneConditionTypeConfigurationSynced, current))
+	require.Equal(t, []operatorv1beta1.DataPlanePodStatus{
+		{Name: "pod-a", ConfigurationHash: "hash1", Synced: true},
+	}, current.Status.Pods)
+
+	t.Log("DataPlanes without a Deployment are skipped")
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(notDeployed), current))
+	_, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeConfigurationSynced, current)
+	require.False(t, ok)
+}
+
+func TestGenerateAdminAPIClientCertificate(t *testing.T) {
+	ca := helpers.CreateCA(t)
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kong-system", Name: "mtls-secret"},
+		Data: map[string][]byte{
+			"tls.crt": ca.CertPEM.Bytes(),
+			"tls.key": ca.KeyPEM.Bytes(),
+		},
+	}
+
+	rootCAs, certificate, err := generateAdminAPIClientCertificate(caSecret)
+	require.NoError(t, err)
+	_, err = certificate.Leaf.Verify(x509.VerifyOptions{
+		Roots:       rootCAs,
+		CurrentTime: time.Now(),
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.NoError(t, err)
+}
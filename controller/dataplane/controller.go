@@ -70,6 +70,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if k8sutils.IsReconciliationPaused(dataplane) {
+		log.Debug(logger, "reconciliation paused, skipping", dataplane)
+		k8sutils.SetPausedCondition(dataplane)
+		_, err := patchDataPlaneStatus(ctx, r.Client, logger, dataplane)
+		return ctrl.Result{}, err
+	}
+	if k8sutils.RemoveCondition(consts.PausedType, dataplane) {
+		if _, err := patchDataPlaneStatus(ctx, r.Client, logger, dataplane); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed removing DataPlane Paused condition: %w", err)
+		}
+		return ctrl.Result{}, nil // status update will trigger reconciliation
+	}
+
 	if k8sutils.InitReady(dataplane) {
 		if patched, err := patchDataPlaneStatus(ctx, r.Client, logger, dataplane); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed initializing DataPlane Ready condition: %w", err)
@@ -138,9 +151,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	if serviceRes == op.Created || serviceRes == op.Updated {
+	switch serviceRes {
+	case op.Created, op.Updated:
 		log.Debug(logger, "DataPlane ingress service created/updated", dataplane, "service", dataplaneIngressService.Name)
 		return ctrl.Result{}, nil
+	case op.Deleted:
+		log.Debug(logger, "DataPlane ingress service deleted to be recreated", dataplane)
+		return ctrl.Result{}, nil // dataplane ingress service deletion will trigger reconciliation
+	case op.Noop:
+	}
+
+	log.Trace(logger, "exposing DataPlane deployment via additional ingress services", dataplane)
+	additionalRes, additionalIngressServices, err := ensureAdditionalIngressServicesForDataPlane(
+		ctx,
+		log.GetLogger(ctx, "dataplane_ingress_service", r.DevelopmentMode),
+		r.Client,
+		dataplane,
+		matchingLabelsToServiceOpt(additionalServiceLabels),
+		k8sresources.LabelSelectorFromDataPlaneStatusSelectorServiceOpt(dataplane),
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if additionalRes != op.Noop {
+		log.Debug(logger, "DataPlane additional ingress services modified", dataplane, "reason", additionalRes)
+		return ctrl.Result{}, nil // dataplane additional ingress services changes will trigger reconciliation
 	}
 
 	dataplaneServiceChanged, err := r.ensureDataPlaneServiceStatus(ctx, logger, dataplane, dataplaneIngressService.Name)
@@ -152,12 +187,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil // dataplane status update will trigger reconciliation
 	}
 
+	clusterCASecretNN := types.NamespacedName{
+		Namespace: r.ClusterCASecretNamespace,
+		Name:      r.ClusterCASecretName,
+	}
+
 	log.Trace(logger, "ensuring mTLS certificate", dataplane)
 	res, certSecret, err := ensureDataPlaneCertificate(ctx, r.Client, dataplane,
-		types.NamespacedName{
-			Namespace: r.ClusterCASecretNamespace,
-			Name:      r.ClusterCASecretName,
-		},
+		clusterCASecretNN,
 		types.NamespacedName{
 			Namespace: dataplaneAdminService.Namespace,
 			Name:      dataplaneAdminService.Name,
@@ -171,6 +208,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil // requeue will be triggered by the creation or update of the owned object
 	}
 
+	log.Trace(logger, "ensuring hybrid mode resources", dataplane)
+	res, hybridCertSecret, hybridControlPlaneHost, err := ensureHybridModeForDataPlane(ctx, r.Client, logger, dataplane, clusterCASecretNN)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not ensure hybrid mode resources for DataPlane %s: %w", dpNn, err)
+	}
+	if res != op.Noop {
+		log.Debug(logger, "hybrid mode resources modified", dataplane, "reason", res)
+		return ctrl.Result{}, nil // requeue will be triggered by the changes of the owned objects
+	}
+	if dataplane.Spec.Hybrid != nil && hybridCertSecret == nil {
+		log.Debug(logger, "waiting for the hybrid mode control plane to be resolved", dataplane)
+		return ctrl.Result{}, nil // control plane cluster Service changes will trigger reconciliation
+	}
+
 	log.Trace(logger, "checking readiness of DataPlane service", dataplaneIngressService)
 	if dataplaneIngressService.Spec.ClusterIP == "" {
 		return ctrl.Result{}, nil // no need to requeue, the update will trigger.
@@ -184,6 +235,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil // no need to requeue, the update will trigger.
 	}
 
+	log.Trace(logger, "ensuring DataPlane has additional ingress services in status", dataplane)
+	if updated, err := r.ensureDataPlaneAdditionalIngressesStatus(ctx, logger, dataplane, additionalIngressServices); err != nil {
+		return ctrl.Result{}, err
+	} else if updated {
+		log.Debug(logger, "dataplane status.AdditionalIngresses updated", dataplane)
+		return ctrl.Result{}, nil // no need to requeue, the update will trigger.
+	}
+
 	deploymentLabels := client.MatchingLabels{
 		consts.DataPlaneDeploymentStateLabel: consts.DataPlaneStateLabelValueLive,
 	}
@@ -208,11 +267,35 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		WithOpts(deploymentOpts...).
 		WithDefaultImage(r.DefaultImage).
 		WithAdditionalLabels(deploymentLabels)
+	if hybridCertSecret != nil {
+		deploymentBuilder = deploymentBuilder.WithHybridClusterCertificate(hybridCertSecret.Name, hybridControlPlaneHost)
+	}
 
-	deployment, res, err := deploymentBuilder.BuildAndDeploy(ctx, dataplane, r.DevelopmentMode)
+	desiredDeployment, err := deploymentBuilder.Build(ctx, dataplane, r.DevelopmentMode)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("could not build Deployment for DataPlane %s: %w", dpNn, err)
 	}
+
+	if dataplane.Spec.Database != nil {
+		log.Trace(logger, "ensuring database migrations for DataPlane", dataplane)
+		// Migrations run with the configuration of the Deployment about to be rolled out.
+		migrated, err := ensureDatabaseMigrationsForDataPlane(ctx, r.Client, logger, dataplane, desiredDeployment,
+			k8sresources.DataPlaneMigrationPhaseBootstrap,
+			k8sresources.DataPlaneMigrationPhaseUp,
+		)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not ensure database migrations for DataPlane %s: %w", dpNn, err)
+		}
+		if !migrated {
+			log.Debug(logger, "waiting for database migrations to complete before rolling out Deployment", dataplane)
+			return ctrl.Result{}, nil // migrations Job status changes will trigger reconciliation
+		}
+	}
+
+	deployment, res, err := deploymentBuilder.Deploy(ctx, dataplane, desiredDeployment)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not deploy Deployment for DataPlane %s: %w", dpNn, err)
+	}
 	if res != op.Noop {
 		return ctrl.Result{}, nil
 	}
@@ -225,6 +308,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	res, err = ensureScaledObjectForDataPlane(ctx, r.Client, logger, dataplane, deployment.Name)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not ensure ScaledObject for DataPlane %s: %w", dpNn, err)
+	}
+	if res != op.Noop {
+		log.Debug(logger, "ScaledObject created/updated/deleted", dataplane)
+		// ScaledObjects are not watched so requeue to ensure they are up to date.
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	res, _, err = ensurePodDisruptionBudgetForDataPlane(ctx, r.Client, logger, dataplane)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("could not ensure PodDisruptionBudget for DataPlane %s: %w", dpNn, err)
@@ -234,6 +327,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	res, err = ensureMonitoringForDataPlane(ctx, r.Client, logger, dataplane)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not ensure monitoring resources for DataPlane %s: %w", dpNn, err)
+	}
+	if res != op.Noop {
+		log.Debug(logger, "monitoring resources created/updated/deleted", dataplane)
+		// Monitors are not watched so requeue to ensure they are up to date.
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if dataplane.Spec.Database != nil {
+		if err := ensureDatabaseMigrationsFinishedForDataPlane(ctx, r.Client, logger, dataplane, deployment); err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not ensure finished database migrations for DataPlane %s: %w", dpNn, err)
+		}
+	} else if err := ensureNoDatabaseMigrationsForDataPlane(ctx, r.Client, logger, dataplane); err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not clean up database migrations for DataPlane %s: %w", dpNn, err)
+	}
+
 	if res, err := ensureDataPlaneReadyStatus(ctx, r.Client, logger, dataplane, dataplane.Generation); err != nil {
 		return ctrl.Result{}, err
 	} else if !res.IsZero() {
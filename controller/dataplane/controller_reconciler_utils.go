@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -84,6 +85,36 @@ func (r *Reconciler) ensureDataPlaneAddressesStatus(
 	return false, nil
 }
 
+// ensureDataPlaneAdditionalIngressesStatus ensures that the DataPlane status
+// contains the names and addresses of the provided additional ingress Services.
+// The provided Services are expected to be ordered as in the DataPlane spec.
+func (r *Reconciler) ensureDataPlaneAdditionalIngressesStatus(
+	ctx context.Context,
+	log logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	services []corev1.Service,
+) (bool, error) {
+	var statuses []operatorv1beta1.DataPlaneIngressServiceStatus
+	for _, svc := range services {
+		addresses, err := address.AddressesFromService(&svc)
+		if err != nil {
+			return false, fmt.Errorf("failed getting addresses for service %s: %w", svc.Name, err)
+		}
+		statuses = append(statuses, operatorv1beta1.DataPlaneIngressServiceStatus{
+			Name:      svc.Labels[consts.DataPlaneIngressServiceNameLabel],
+			Service:   svc.Name,
+			Addresses: addresses,
+		})
+	}
+
+	if cmp.Equal(statuses, dataplane.Status.AdditionalIngresses, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+	dataplane.Status.AdditionalIngresses = statuses
+	_, err := patchDataPlaneStatus(ctx, r.Client, log, dataplane)
+	return true, err
+}
+
 // ensureMappedConfigMapToKongPluginInstallationForDataPlane ensures that the KongPluginInstallation
 // resources referenced by the DataPlane are resolved and DataPlane is configured to use them.
 // During resolving for each DataPlane based on each instance of KongPluginInstallation
@@ -310,12 +341,12 @@ func ensureDataPlaneIsMarkedNotReady(
 // owned by the `DataPlane`. It first removes outdated annotations and then update annotations
 // in current spec of `DataPlane`.
 func ensureDataPlaneIngressServiceAnnotationsUpdated(
-	dataplane *operatorv1beta1.DataPlane, existingAnnotations map[string]string, generatedAnnotations map[string]string,
+	specAnnotations map[string]string, existingAnnotations map[string]string, generatedAnnotations map[string]string,
 ) (bool, map[string]string, error) {
 	// Remove annotations applied from previous version of DataPlane but removed in the current version.
 	// Should be done before updating new annotations, because the updating process will overwrite the annotation
 	// to save last applied annotations.
-	outdatedAnnotations, err := extractOutdatedDataPlaneIngressServiceAnnotations(dataplane, existingAnnotations)
+	outdatedAnnotations, err := extractOutdatedDataPlaneIngressServiceAnnotations(specAnnotations, existingAnnotations)
 	if err != nil {
 		return true, existingAnnotations, fmt.Errorf("failed to extract outdated annotations: %w", err)
 	}
@@ -366,8 +397,21 @@ func dataPlaneIngressServiceIsReady(dataplaneIngressService *corev1.Service) boo
 	return false
 }
 
+// externallyManagedConditionTypes are the types of the DataPlane conditions
+// which are set by other controllers and must not be modified by the DataPlane
+// controller.
+var externallyManagedConditionTypes = []consts.ConditionType{
+	// Set by the KonnectDataPlaneNodes controller.
+	consts.KonnectConnectedType,
+	// Set by the ConfigurationSyncStatusUpdater.
+	consts.DataPlaneConditionTypeConfigurationSynced,
+}
+
 // patchDataPlaneStatus patches the resource status only when there are changes
 // that requires it.
+// Conditions set by other controllers (see externallyManagedConditionTypes) and
+// the Pods' configuration state are taken from the current object so that the
+// patch does not revert them.
 func patchDataPlaneStatus(ctx context.Context, cl client.Client, logger logr.Logger, updated *operatorv1beta1.DataPlane) (bool, error) {
 	current := &operatorv1beta1.DataPlane{}
 
@@ -376,6 +420,16 @@ func patchDataPlaneStatus(ctx context.Context, cl client.Client, logger logr.Log
 		return false, err
 	}
 
+	for _, conditionType := range externallyManagedConditionTypes {
+		if condition, ok := k8sutils.GetCondition(conditionType, current); ok {
+			k8sutils.SetCondition(condition, updated)
+		} else {
+			k8sutils.RemoveCondition(conditionType, updated)
+		}
+	}
+	// Set by the ConfigurationSyncStatusUpdater.
+	updated.Status.Pods = current.Status.Pods
+
 	if k8sutils.NeedsUpdate(current, updated) ||
 		addressesChanged(current, updated) ||
 		readinessChanged(current, updated) ||
@@ -392,7 +446,14 @@ func patchDataPlaneStatus(ctx context.Context, cl client.Client, logger logr.Log
 // addressesChanged returns a boolean indicating whether the addresses in provided
 // DataPlane stauses differ.
 func addressesChanged(current, updated *operatorv1beta1.DataPlane) bool {
-	return !cmp.Equal(current.Status.Addresses, updated.Status.Addresses)
+	return !cmp.Equal(current.Status.Addresses, updated.Status.Addresses) ||
+		!cmp.Equal(current.Status.AdditionalIngresses, updated.Status.AdditionalIngresses, cmpopts.EquateEmpty())
+}
+
+// podsChanged returns a boolean indicating whether the Pods configuration state
+// in provided DataPlane statuses differ.
+func podsChanged(current, updated *operatorv1beta1.DataPlane) bool {
+	return !cmp.Equal(current.Status.Pods, updated.Status.Pods, cmpopts.EquateEmpty())
 }
 
 func readinessChanged(current, updated *operatorv1beta1.DataPlane) bool {
@@ -24,6 +24,7 @@ import (
 	"github.com/kong/gateway-operator/controller/pkg/op"
 	"github.com/kong/gateway-operator/internal/versions"
 	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
 )
 
@@ -613,3 +614,45 @@ func TestDataPlaneIngressServiceIsReady(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchDataPlaneStatusPreservesExternallyManagedConditions(t *testing.T) {
+	ctx := context.Background()
+	connected := k8sutils.NewConditionWithGeneration(
+		consts.KonnectConnectedType, metav1.ConditionTrue, consts.KonnectConnectedReason, "2/2 ready Pod(s) connected to Konnect", 1,
+	)
+	dp := &operatorv1beta1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dp", Generation: 1},
+	}
+	k8sutils.SetCondition(k8sutils.NewConditionWithGeneration(
+		consts.ReadyType, metav1.ConditionFalse, consts.WaitingToBecomeReadyReason, "", 1,
+	), dp)
+	k8sutils.SetCondition(connected, dp)
+	dp.Status.Pods = []operatorv1beta1.DataPlanePodStatus{{Name: "pod-a", ConfigurationHash: "hash1", Synced: true}}
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(dp).
+		WithStatusSubresource(dp).
+		Build()
+
+	t.Log("stale copy of the DataPlane, read before the KonnectConnected condition and the Pods were set")
+	updated := dp.DeepCopy()
+	k8sutils.RemoveCondition(consts.KonnectConnectedType, updated)
+	updated.Status.Pods = nil
+	k8sutils.SetCondition(k8sutils.NewConditionWithGeneration(
+		consts.ReadyType, metav1.ConditionTrue, consts.ResourceReadyReason, "", 1,
+	), updated)
+
+	patched, err := patchDataPlaneStatus(ctx, cl, logr.Discard(), updated)
+	require.NoError(t, err)
+	require.True(t, patched)
+
+	current := &operatorv1beta1.DataPlane{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+	ready, ok := k8sutils.GetCondition(consts.ReadyType, current)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	cond, ok := k8sutils.GetCondition(consts.KonnectConnectedType, current)
+	require.True(t, ok)
+	assert.Equal(t, connected.Message, cond.Message)
+	assert.Equal(t, dp.Status.Pods, current.Status.Pods)
+}
@@ -902,6 +902,80 @@ func TestDataPlaneReconciler_Reconcile(t *testing.T) {
 				assert.EqualValues(t, 1, dp.Status.Replicas)
 			},
 		},
+		{
+			name: "paused reconciliation",
+			dataplaneReq: reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: "test-namespace",
+					Name:      "test-dataplane",
+				},
+			},
+			dataplane: &operatorv1beta1.DataPlane{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "gateway-operator.konghq.com/v1beta1",
+					Kind:       "DataPlane",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-dataplane",
+					Namespace: "test-namespace",
+					UID:       types.UID(uuid.NewString()),
+					Annotations: map[string]string{
+						consts.ReconcileAnnotation: consts.ReconcileAnnotationValuePaused,
+					},
+				},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+							DeploymentOptions: operatorv1beta1.DeploymentOptions{
+								PodTemplateSpec: &corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										Containers: []corev1.Container{
+											{
+												Name:  consts.DataPlaneProxyContainerName,
+												Image: consts.DefaultDataPlaneImage,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			testBody: func(t *testing.T, reconciler Reconciler, dataplaneReq reconcile.Request) {
+				ctx := context.Background()
+
+				for range 2 {
+					_, err := reconciler.Reconcile(ctx, dataplaneReq)
+					require.NoError(t, err)
+				}
+
+				dp := &operatorv1beta1.DataPlane{}
+				require.NoError(t, reconciler.Client.Get(ctx, dataplaneReq.NamespacedName, dp))
+				c, ok := k8sutils.GetCondition(consts.PausedType, dp)
+				require.True(t, ok, "DataPlane should have a Paused condition set")
+				assert.Equal(t, metav1.ConditionTrue, c.Status)
+				_, ok = k8sutils.GetCondition(consts.ReadyType, dp)
+				assert.False(t, ok, "paused DataPlane should not have been initialized")
+
+				services := &corev1.ServiceList{}
+				require.NoError(t, reconciler.Client.List(ctx, services, controllerruntimeclient.InNamespace(dataplaneReq.Namespace)))
+				assert.Empty(t, services.Items, "no Services should be created for a paused DataPlane")
+				deployments := &appsv1.DeploymentList{}
+				require.NoError(t, reconciler.Client.List(ctx, deployments, controllerruntimeclient.InNamespace(dataplaneReq.Namespace)))
+				assert.Empty(t, deployments.Items, "no Deployments should be created for a paused DataPlane")
+
+				t.Log("resuming the reconciliation")
+				delete(dp.Annotations, consts.ReconcileAnnotation)
+				require.NoError(t, reconciler.Client.Update(ctx, dp))
+				_, err := reconciler.Reconcile(ctx, dataplaneReq)
+				require.NoError(t, err)
+
+				require.NoError(t, reconciler.Client.Get(ctx, dataplaneReq.NamespacedName, dp))
+				_, ok = k8sutils.GetCondition(consts.PausedType, dp)
+				assert.False(t, ok, "Paused condition should be removed once the reconciliation is resumed")
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -58,8 +58,7 @@ func generateDataPlaneImage(dataplane *operatorv1beta1.DataPlane, defaultImage s
 // DataPlane - Private Functions - Kubernetes Object Labels and Annotations
 // -----------------------------------------------------------------------------
 
-func addAnnotationsForDataPlaneIngressService(obj client.Object, dataplane operatorv1beta1.DataPlane) {
-	specAnnotations := extractDataPlaneIngressServiceAnnotations(&dataplane)
+func addAnnotationsForDataPlaneIngressService(obj client.Object, specAnnotations map[string]string) {
 	if specAnnotations == nil {
 		return
 	}
@@ -91,7 +90,7 @@ func extractDataPlaneIngressServiceAnnotations(dataplane *operatorv1beta1.DataPl
 // extractOutdatedDataPlaneIngressServiceAnnotations returns the last applied annotations
 // of ingress service from `DataPlane` spec but disappeared in current `DataPlane` spec.
 func extractOutdatedDataPlaneIngressServiceAnnotations(
-	dataplane *operatorv1beta1.DataPlane, existingAnnotations map[string]string,
+	currentSpecifiedAnnotations map[string]string, existingAnnotations map[string]string,
 ) (map[string]string, error) {
 	if existingAnnotations == nil {
 		return nil, nil
@@ -109,7 +108,6 @@ func extractOutdatedDataPlaneIngressServiceAnnotations(
 	// the annotation is outdated and should be removed.
 	// So we remove the annotations present in current spec in last applied annotations,
 	// the remaining annotations are outdated and should be removed.
-	currentSpecifiedAnnotations := extractDataPlaneIngressServiceAnnotations(dataplane)
 	for k := range currentSpecifiedAnnotations {
 		delete(outdatedAnnotations, k)
 	}
@@ -17,6 +17,9 @@ import (
 	"github.com/kong/gateway-operator/pkg/consts"
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
 )
 
 var (
@@ -52,18 +55,27 @@ func applyKonnectExtension(ctx context.Context, cl client.Client, dataplane *v1b
 			}
 		}
 
+		secretName, err := konnectExtensionClusterCertificateSecretName(&konnectExt)
+		if err != nil {
+			return err
+		}
 		secret := corev1.Secret{}
 		if err := cl.Get(ctx, client.ObjectKey{
 			Namespace: namespace,
-			Name:      konnectExt.Spec.AuthConfiguration.ClusterCertificateSecretRef.Name,
+			Name:      secretName,
 		}, &secret); err != nil {
 			if k8serrors.IsNotFound(err) {
-				return errors.Join(ErrClusterCertificateNotFound, fmt.Errorf("the cluster certificate secret %s/%s referenced by the extension %s/%s is not found", namespace, konnectExt.Spec.AuthConfiguration.ClusterCertificateSecretRef.Name, namespace, extensionRef.Name))
+				return errors.Join(ErrClusterCertificateNotFound, fmt.Errorf("the cluster certificate secret %s/%s referenced by the extension %s/%s is not found", namespace, secretName, namespace, extensionRef.Name))
 			} else {
 				return err
 			}
 		}
 
+		cpID, err := konnectExtensionControlPlaneID(ctx, cl, &konnectExt)
+		if err != nil {
+			return err
+		}
+
 		if dataplane.Spec.Deployment.PodTemplateSpec == nil {
 			dataplane.Spec.Deployment.PodTemplateSpec = &corev1.PodTemplateSpec{}
 		}
@@ -81,12 +93,11 @@ func applyKonnectExtension(ctx context.Context, cl client.Client, dataplane *v1b
 
 		d.WithVolume(kongInKonnectClusterCertificateVolume())
 		d.WithVolumeMount(kongInKonnectClusterCertificateVolumeMount(), consts.DataPlaneProxyContainerName)
-		d.WithVolume(kongInKonnectClusterCertVolume(konnectExt.Spec.AuthConfiguration.ClusterCertificateSecretRef.Name))
+		d.WithVolume(kongInKonnectClusterCertVolume(secretName))
 		d.WithVolumeMount(kongInKonnectClusterVolumeMount(), consts.DataPlaneProxyContainerName)
 
-		// KonnectID is the only supported type for now, and its presence is guaranteed by a proper CEL rule.
 		envSet := dputils.KongInKonnectDefaults(dputils.KongInKonnectParams{
-			ControlPlane: *konnectExt.Spec.ControlPlaneRef.KonnectID,
+			ControlPlane: cpID,
 			Region:       konnectExt.Spec.ControlPlaneRegion,
 			Server:       konnectExt.Spec.ServerHostname,
 		})
@@ -97,6 +108,48 @@ func applyKonnectExtension(ctx context.Context, cl client.Client, dataplane *v1b
 	return nil
 }
 
+// konnectExtensionClusterCertificateSecretName returns the name of the Secret
+// containing the cluster certificate used by the KonnectExtension.
+func konnectExtensionClusterCertificateSecretName(konnectExt *operatorv1alpha1.KonnectExtension) (string, error) {
+	if konnectExt.IsClusterCertificateProvisioningAutomatic() {
+		if konnectExt.Status.ClusterCertificateSecretRef == nil {
+			return "", errors.Join(ErrClusterCertificateNotFound, fmt.Errorf("the cluster certificate of the extension %s/%s is not provisioned yet", konnectExt.Namespace, konnectExt.Name))
+		}
+		return konnectExt.Status.ClusterCertificateSecretRef.Name, nil
+	}
+
+	if konnectExt.Spec.AuthConfiguration.ClusterCertificateSecretRef == nil {
+		return "", errors.Join(ErrClusterCertificateNotFound, fmt.Errorf("the extension %s/%s does not reference a cluster certificate secret", konnectExt.Namespace, konnectExt.Name))
+	}
+	return konnectExt.Spec.AuthConfiguration.ClusterCertificateSecretRef.Name, nil
+}
+
+// konnectExtensionControlPlaneID returns the Konnect ID of the ControlPlane
+// referenced by the KonnectExtension.
+func konnectExtensionControlPlaneID(ctx context.Context, cl client.Client, konnectExt *operatorv1alpha1.KonnectExtension) (string, error) {
+	cpRef := konnectExt.Spec.ControlPlaneRef
+	switch {
+	case cpRef.Type == configurationv1alpha1.ControlPlaneRefKonnectNamespacedRef && cpRef.KonnectNamespacedRef != nil:
+		var cp konnectv1alpha1.KonnectGatewayControlPlane
+		nn := client.ObjectKey{
+			// TODO: handle cross namespace refs.
+			Namespace: konnectExt.Namespace,
+			Name:      cpRef.KonnectNamespacedRef.Name,
+		}
+		if err := cl.Get(ctx, nn, &cp); err != nil {
+			return "", fmt.Errorf("failed to get KonnectGatewayControlPlane %s referenced by the extension %s/%s: %w", nn, konnectExt.Namespace, konnectExt.Name, err)
+		}
+		if cp.GetKonnectStatus().GetKonnectID() == "" {
+			return "", fmt.Errorf("KonnectGatewayControlPlane %s referenced by the extension %s/%s is not programmed yet", nn, konnectExt.Namespace, konnectExt.Name)
+		}
+		return cp.GetKonnectStatus().GetKonnectID(), nil
+	case cpRef.KonnectID != nil:
+		return *cpRef.KonnectID, nil
+	default:
+		return "", fmt.Errorf("the extension %s/%s does not reference a Konnect ControlPlane", konnectExt.Namespace, konnectExt.Name)
+	}
+}
+
 func kongInKonnectClusterCertVolume(secretName string) corev1.Volume {
 	return corev1.Volume{
 		Name: consts.KongClusterCertVolume,
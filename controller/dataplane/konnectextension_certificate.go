@@ -0,0 +1,312 @@
+package dataplane
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorv1alpha1 "github.com/kong/gateway-operator/api/v1alpha1"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/controller/pkg/secrets"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+const (
+	// konnectExtensionClusterCertificateValidity is the validity of the cluster
+	// certificates provisioned for KonnectExtensions.
+	konnectExtensionClusterCertificateValidity = 365 * 24 * time.Hour
+
+	// konnectExtensionClusterCertificateRenewBefore is how long before the cluster
+	// certificate expires a new one is provisioned. The previous certificate is
+	// kept registered in Konnect until it expires so that DataPlanes can be rolled
+	// out with the new one in the meantime.
+	konnectExtensionClusterCertificateRenewBefore = 30 * 24 * time.Hour
+
+	// konnectExtensionClusterCertificateCommonName is the common name of the
+	// cluster certificates provisioned for KonnectExtensions.
+	konnectExtensionClusterCertificateCommonName = "kong-dataplane"
+)
+
+// clusterCertificate is a cluster certificate provisioned for a KonnectExtension.
+type clusterCertificate struct {
+	secret   *corev1.Secret
+	notAfter time.Time
+}
+
+// ensureClusterCertificate provisions the cluster certificate of a KonnectExtension
+// with automatic cluster certificate provisioning: it generates the certificate,
+// registers it in Konnect as a KongDataPlaneClientCertificate and sets it in the
+// KonnectExtension's status once it's programmed. Certificates are rotated before
+// they expire and the expired ones are removed.
+func (r *KonnectExtensionReconciler) ensureClusterCertificate(
+	ctx context.Context,
+	logger logr.Logger,
+	konnectExtension *operatorv1alpha1.KonnectExtension,
+	now time.Time,
+) (ctrl.Result, error) {
+	certs, err := r.listClusterCertificates(ctx, konnectExtension)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !konnectExtension.IsClusterCertificateProvisioningAutomatic() {
+		for _, cert := range certs {
+			if err := r.deleteClusterCertificate(ctx, cert); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if konnectExtension.Status.ClusterCertificateSecretRef != nil {
+			konnectExtension.Status.ClusterCertificateSecretRef = nil
+			return r.updateStatus(ctx, konnectExtension)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	var latest *clusterCertificate
+	for i := range certs {
+		if latest == nil || certs[i].notAfter.After(latest.notAfter) {
+			latest = &certs[i]
+		}
+	}
+	if latest == nil || !now.Before(latest.notAfter.Add(-konnectExtensionClusterCertificateRenewBefore)) {
+		var previous string
+		if latest != nil {
+			previous = latest.secret.Name
+		}
+		secret, err := generateClusterCertificateSecret(konnectExtension, clusterCertificateSecretName(konnectExtension, previous), now)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := controllerutil.SetControllerReference(konnectExtension, secret, r.Scheme()); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to set owner of cluster certificate Secret: %w", err)
+		}
+		if err := r.Client.Create(ctx, secret); err != nil {
+			// The Secret was created by a previous reconciliation but it's not
+			// in the cache yet. Its creation will trigger another reconciliation.
+			if k8serrors.IsAlreadyExists(err) {
+				log.Debug(logger, "cluster certificate already generated", konnectExtension, "secret", secret.Name)
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to create cluster certificate Secret: %w", err)
+		}
+		log.Info(logger, "cluster certificate generated", konnectExtension, "secret", secret.Name)
+		// Secret's creation will trigger another reconciliation.
+		return ctrl.Result{}, nil
+	}
+
+	programmed, err := r.ensureClusterCertificateRegistered(ctx, konnectExtension, latest.secret)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !programmed {
+		// KongDataPlaneClientCertificate's update will trigger another reconciliation.
+		log.Debug(logger, "waiting for the cluster certificate to be registered in Konnect", konnectExtension, "secret", latest.secret.Name)
+		return ctrl.Result{}, nil
+	}
+
+	if ref := konnectExtension.Status.ClusterCertificateSecretRef; ref == nil || ref.Name != latest.secret.Name {
+		konnectExtension.Status.ClusterCertificateSecretRef = &operatorv1alpha1.ClusterCertificateSecretRef{
+			Name: latest.secret.Name,
+		}
+		log.Info(logger, "cluster certificate set", konnectExtension, "secret", latest.secret.Name)
+		return r.updateStatus(ctx, konnectExtension)
+	}
+
+	requeueAt := latest.notAfter.Add(-konnectExtensionClusterCertificateRenewBefore)
+	for _, cert := range certs {
+		if cert.secret.Name == latest.secret.Name {
+			continue
+		}
+		if !now.Before(cert.notAfter) {
+			if err := r.deleteClusterCertificate(ctx, cert); err != nil {
+				return ctrl.Result{}, err
+			}
+			log.Debug(logger, "expired cluster certificate removed", konnectExtension, "secret", cert.secret.Name)
+			continue
+		}
+		if cert.notAfter.Before(requeueAt) {
+			requeueAt = cert.notAfter
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAt.Sub(now)}, nil
+}
+
+// listClusterCertificates lists the cluster certificates provisioned for the KonnectExtension.
+func (r *KonnectExtensionReconciler) listClusterCertificates(
+	ctx context.Context,
+	konnectExtension *operatorv1alpha1.KonnectExtension,
+) ([]clusterCertificate, error) {
+	var secrets corev1.SecretList
+	if err := r.Client.List(ctx, &secrets,
+		client.InNamespace(konnectExtension.Namespace),
+		client.MatchingLabels(clusterCertificateLabels(konnectExtension)),
+	); err != nil {
+		return nil, fmt.Errorf("failed to list cluster certificate Secrets: %w", err)
+	}
+
+	certs := make([]clusterCertificate, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if !k8sutils.IsOwnedByRefUID(secret, konnectExtension.GetUID()) {
+			continue
+		}
+		cert, err := parseCertificate(secret.Data[consts.TLSCRT])
+		if err != nil {
+			// Invalid certificates are treated as expired so that they get replaced.
+			certs = append(certs, clusterCertificate{secret: secret})
+			continue
+		}
+		certs = append(certs, clusterCertificate{secret: secret, notAfter: cert.NotAfter})
+	}
+	return certs, nil
+}
+
+// ensureClusterCertificateRegistered ensures that the cluster certificate from
+// the provided Secret is registered in Konnect as a KongDataPlaneClientCertificate.
+// It returns true when the KongDataPlaneClientCertificate is programmed.
+func (r *KonnectExtensionReconciler) ensureClusterCertificateRegistered(
+	ctx context.Context,
+	konnectExtension *operatorv1alpha1.KonnectExtension,
+	secret *corev1.Secret,
+) (bool, error) {
+	var dpCert configurationv1alpha1.KongDataPlaneClientCertificate
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(secret), &dpCert)
+	switch {
+	case k8serrors.IsNotFound(err):
+		dpCert = configurationv1alpha1.KongDataPlaneClientCertificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: secret.Namespace,
+				Name:      secret.Name,
+				Labels:    clusterCertificateLabels(konnectExtension),
+			},
+			Spec: configurationv1alpha1.KongDataPlaneClientCertificateSpec{
+				ControlPlaneRef: konnectExtension.Spec.ControlPlaneRef.DeepCopy(),
+				KongDataPlaneClientCertificateAPISpec: configurationv1alpha1.KongDataPlaneClientCertificateAPISpec{
+					Cert: string(secret.Data[consts.TLSCRT]),
+				},
+			},
+		}
+		if err := controllerutil.SetControllerReference(konnectExtension, &dpCert, r.Scheme()); err != nil {
+			return false, fmt.Errorf("failed to set owner of KongDataPlaneClientCertificate: %w", err)
+		}
+		if err := r.Client.Create(ctx, &dpCert); err != nil {
+			return false, fmt.Errorf("failed to create KongDataPlaneClientCertificate %s: %w", client.ObjectKeyFromObject(&dpCert), err)
+		}
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to get KongDataPlaneClientCertificate %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, &dpCert)
+	return ok &&
+		cond.Status == metav1.ConditionTrue &&
+		cond.ObservedGeneration == dpCert.GetGeneration(), nil
+}
+
+// deleteClusterCertificate deletes the cluster certificate Secret and the
+// KongDataPlaneClientCertificate registering it in Konnect.
+func (r *KonnectExtensionReconciler) deleteClusterCertificate(ctx context.Context, cert clusterCertificate) error {
+	dpCert := configurationv1alpha1.KongDataPlaneClientCertificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cert.secret.Namespace,
+			Name:      cert.secret.Name,
+		},
+	}
+	if err := r.Client.Delete(ctx, &dpCert); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete KongDataPlaneClientCertificate %s: %w", client.ObjectKeyFromObject(&dpCert), err)
+	}
+	if err := r.Client.Delete(ctx, cert.secret); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete cluster certificate Secret %s: %w", client.ObjectKeyFromObject(cert.secret), err)
+	}
+	return nil
+}
+
+func (r *KonnectExtensionReconciler) updateStatus(ctx context.Context, konnectExtension *operatorv1alpha1.KonnectExtension) (ctrl.Result, error) {
+	if err := r.Client.Status().Update(ctx, konnectExtension); err != nil {
+		if k8serrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to update KonnectExtension status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// clusterCertificateLabels returns the labels of the objects created for the
+// cluster certificates of the KonnectExtension.
+func clusterCertificateLabels(konnectExtension *operatorv1alpha1.KonnectExtension) map[string]string {
+	return map[string]string{
+		consts.GatewayOperatorManagedByLabel:          consts.KonnectExtensionManagedLabelValue,
+		consts.GatewayOperatorManagedByNamespaceLabel: konnectExtension.Namespace,
+		consts.GatewayOperatorManagedByNameLabel:      konnectExtension.Name,
+	}
+}
+
+// clusterCertificateSecretName returns the name of the cluster certificate
+// Secret which replaces the Secret with the provided name, or of the first
+// Secret when the provided name is empty.
+// Names are deterministic so that a stale cache can't make the KonnectExtension
+// get (and register in Konnect) more than one new certificate: creating the
+// Secret again fails instead.
+func clusterCertificateSecretName(konnectExtension *operatorv1alpha1.KonnectExtension, previous string) string {
+	hash := sha256.Sum256([]byte(string(konnectExtension.UID) + "/" + previous))
+	return k8sutils.TrimGenerateName(fmt.Sprintf("%s-cluster-cert-", konnectExtension.Name)) +
+		hex.EncodeToString(hash[:])[:10]
+}
+
+// generateClusterCertificateSecret generates a self-signed cluster certificate
+// and returns the TLS Secret with the provided name containing it.
+func generateClusterCertificateSecret(
+	konnectExtension *operatorv1alpha1.KonnectExtension,
+	name string,
+	now time.Time,
+) (*corev1.Secret, error) {
+	cert, key, err := secrets.GenerateSelfSignedCertificate(
+		konnectExtensionClusterCertificateCommonName,
+		now,
+		konnectExtensionClusterCertificateValidity,
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: konnectExtension.Namespace,
+			Name:      name,
+			Labels:    clusterCertificateLabels(konnectExtension),
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			consts.TLSCRT: cert,
+			consts.TLSKey: key,
+		},
+	}, nil
+}
+
+// parseCertificate parses the first certificate from the provided PEM data.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,245 @@
+package dataplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	operatorv1alpha1 "github.com/kong/gateway-operator/api/v1alpha1"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func TestEnsureClusterCertificate(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, operatorv1alpha1.AddToScheme(s))
+	require.NoError(t, configurationv1alpha1.AddToScheme(s))
+
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	newKonnectExtension := func(provisioning operatorv1alpha1.ClusterCertificateProvisioning) *operatorv1alpha1.KonnectExtension {
+		return &operatorv1alpha1.KonnectExtension{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: operatorv1alpha1.SchemeGroupVersion.String(),
+				Kind:       operatorv1alpha1.KonnectExtensionKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "konnect-ext",
+				Namespace: "default",
+				UID:       "konnect-ext-uid",
+			},
+			Spec: operatorv1alpha1.KonnectExtensionSpec{
+				AuthConfiguration: operatorv1alpha1.KonnectControlPlaneAPIAuthConfiguration{
+					ClusterCertificateProvisioning: provisioning,
+				},
+				ControlPlaneRef: configurationv1alpha1.ControlPlaneRef{
+					Type: configurationv1alpha1.ControlPlaneRefKonnectNamespacedRef,
+					KonnectNamespacedRef: &configurationv1alpha1.KonnectNamespacedRef{
+						Name: "cp",
+					},
+				},
+			},
+		}
+	}
+	newCertificateSecret := func(t *testing.T, ext *operatorv1alpha1.KonnectExtension, name string, issuedAt time.Time) *corev1.Secret {
+		secret, err := generateClusterCertificateSecret(ext, name, issuedAt)
+		require.NoError(t, err)
+		secret.OwnerReferences = []metav1.OwnerReference{
+			{
+				APIVersion: operatorv1alpha1.SchemeGroupVersion.String(),
+				Kind:       operatorv1alpha1.KonnectExtensionKind,
+				Name:       ext.Name,
+				UID:        ext.UID,
+			},
+		}
+		return secret
+	}
+	newDataPlaneClientCertificate := func(ext *operatorv1alpha1.KonnectExtension, name string, programmed bool) *configurationv1alpha1.KongDataPlaneClientCertificate {
+		dpCert := &configurationv1alpha1.KongDataPlaneClientCertificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ext.Namespace,
+				Labels:    clusterCertificateLabels(ext),
+			},
+		}
+		if programmed {
+			k8sutils.SetCondition(
+				k8sutils.NewConditionWithGeneration(
+					konnectv1alpha1.KonnectEntityProgrammedConditionType,
+					metav1.ConditionTrue,
+					konnectv1alpha1.KonnectEntityProgrammedReasonProgrammed,
+					"",
+					dpCert.GetGeneration(),
+				),
+				dpCert,
+			)
+		}
+		return dpCert
+	}
+
+	t.Run("certificate is generated when none exists", func(t *testing.T) {
+		ext := newKonnectExtension(operatorv1alpha1.ClusterCertificateProvisioningAutomatic)
+		r := &KonnectExtensionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(s).WithObjects(ext).WithStatusSubresource(ext).Build(),
+		}
+
+		res, err := r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+		require.NoError(t, err)
+		assert.Zero(t, res)
+
+		certs, err := r.listClusterCertificates(context.Background(), ext)
+		require.NoError(t, err)
+		require.Len(t, certs, 1)
+		assert.Equal(t, corev1.SecretTypeTLS, certs[0].secret.Type)
+		assert.Equal(t, now.Add(konnectExtensionClusterCertificateValidity), certs[0].notAfter)
+		assert.NotEmpty(t, certs[0].secret.Data[consts.TLSKey])
+		assert.Nil(t, ext.Status.ClusterCertificateSecretRef)
+	})
+
+	t.Run("certificate is generated once when the cache is stale", func(t *testing.T) {
+		ext := newKonnectExtension(operatorv1alpha1.ClusterCertificateProvisioningAutomatic)
+		cl := fake.NewClientBuilder().WithScheme(s).WithObjects(ext).WithStatusSubresource(ext).Build()
+		r := &KonnectExtensionReconciler{
+			// Secrets created by the first reconciliation are not listed.
+			Client: interceptor.NewClient(cl, interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return nil
+				},
+			}),
+		}
+
+		for range 2 {
+			res, err := r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+			require.NoError(t, err)
+			assert.Zero(t, res)
+		}
+
+		var secrets corev1.SecretList
+		require.NoError(t, cl.List(context.Background(), &secrets))
+		require.Len(t, secrets.Items, 1)
+		assert.Equal(t, clusterCertificateSecretName(ext, ""), secrets.Items[0].Name)
+	})
+
+	t.Run("certificate is registered in Konnect", func(t *testing.T) {
+		ext := newKonnectExtension(operatorv1alpha1.ClusterCertificateProvisioningAutomatic)
+		secret := newCertificateSecret(t, ext, "cert-1", now)
+		r := &KonnectExtensionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(s).WithObjects(ext, secret).WithStatusSubresource(ext).Build(),
+		}
+
+		res, err := r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+		require.NoError(t, err)
+		assert.Zero(t, res)
+
+		var dpCert configurationv1alpha1.KongDataPlaneClientCertificate
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKeyFromObject(secret), &dpCert))
+		assert.Equal(t, string(secret.Data[consts.TLSCRT]), dpCert.Spec.Cert)
+		assert.Equal(t, &ext.Spec.ControlPlaneRef, dpCert.Spec.ControlPlaneRef)
+		assert.True(t, k8sutils.IsOwnedByRefUID(&dpCert, ext.UID))
+		assert.Nil(t, ext.Status.ClusterCertificateSecretRef)
+	})
+
+	t.Run("programmed certificate is set in status", func(t *testing.T) {
+		ext := newKonnectExtension(operatorv1alpha1.ClusterCertificateProvisioningAutomatic)
+		secret := newCertificateSecret(t, ext, "cert-1", now)
+		dpCert := newDataPlaneClientCertificate(ext, "cert-1", true)
+		r := &KonnectExtensionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(s).WithObjects(ext, secret, dpCert).WithStatusSubresource(ext).Build(),
+		}
+
+		_, err := r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+		require.NoError(t, err)
+		require.NotNil(t, ext.Status.ClusterCertificateSecretRef)
+		assert.Equal(t, "cert-1", ext.Status.ClusterCertificateSecretRef.Name)
+
+		res, err := r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+		require.NoError(t, err)
+		assert.Equal(t, konnectExtensionClusterCertificateValidity-konnectExtensionClusterCertificateRenewBefore, res.RequeueAfter)
+	})
+
+	t.Run("certificate is rotated before it expires and the expired one is removed", func(t *testing.T) {
+		ext := newKonnectExtension(operatorv1alpha1.ClusterCertificateProvisioningAutomatic)
+		ext.Status.ClusterCertificateSecretRef = &operatorv1alpha1.ClusterCertificateSecretRef{Name: "cert-1"}
+		issuedAt := now.Add(-konnectExtensionClusterCertificateValidity + konnectExtensionClusterCertificateRenewBefore/2)
+		r := &KonnectExtensionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(s).
+				WithObjects(
+					ext,
+					newCertificateSecret(t, ext, "cert-1", issuedAt),
+					newDataPlaneClientCertificate(ext, "cert-1", true),
+				).
+				WithStatusSubresource(ext).
+				Build(),
+		}
+
+		_, err := r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+		require.NoError(t, err)
+		certs, err := r.listClusterCertificates(context.Background(), ext)
+		require.NoError(t, err)
+		require.Len(t, certs, 2)
+
+		for _, cert := range certs {
+			if cert.secret.Name != "cert-1" {
+				require.NoError(t, r.Client.Create(context.Background(), newDataPlaneClientCertificate(ext, cert.secret.Name, true)))
+			}
+		}
+		_, err = r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+		require.NoError(t, err)
+		require.NotNil(t, ext.Status.ClusterCertificateSecretRef)
+		assert.NotEqual(t, "cert-1", ext.Status.ClusterCertificateSecretRef.Name)
+
+		res, err := r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+		require.NoError(t, err)
+		assert.Equal(t, konnectExtensionClusterCertificateRenewBefore/2, res.RequeueAfter,
+			"the reconciliation should be requeued when the previous certificate expires")
+
+		_, err = r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now.Add(res.RequeueAfter))
+		require.NoError(t, err)
+		certs, err = r.listClusterCertificates(context.Background(), ext)
+		require.NoError(t, err)
+		require.Len(t, certs, 1)
+		assert.NotEqual(t, "cert-1", certs[0].secret.Name)
+		var dpCerts configurationv1alpha1.KongDataPlaneClientCertificateList
+		require.NoError(t, r.Client.List(context.Background(), &dpCerts))
+		require.Len(t, dpCerts.Items, 1)
+		assert.Equal(t, certs[0].secret.Name, dpCerts.Items[0].Name)
+	})
+
+	t.Run("provisioned certificates are removed with manual provisioning", func(t *testing.T) {
+		ext := newKonnectExtension(operatorv1alpha1.ClusterCertificateProvisioningManual)
+		ext.Status.ClusterCertificateSecretRef = &operatorv1alpha1.ClusterCertificateSecretRef{Name: "cert-1"}
+		r := &KonnectExtensionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(s).
+				WithObjects(
+					ext,
+					newCertificateSecret(t, ext, "cert-1", now),
+					newDataPlaneClientCertificate(ext, "cert-1", true),
+				).
+				WithStatusSubresource(ext).
+				Build(),
+		}
+
+		_, err := r.ensureClusterCertificate(context.Background(), logr.Discard(), ext, now)
+		require.NoError(t, err)
+		assert.Nil(t, ext.Status.ClusterCertificateSecretRef)
+		var secrets corev1.SecretList
+		require.NoError(t, r.Client.List(context.Background(), &secrets))
+		assert.Empty(t, secrets.Items)
+		var dpCerts configurationv1alpha1.KongDataPlaneClientCertificateList
+		require.NoError(t, r.Client.List(context.Background(), &dpCerts))
+		assert.Empty(t, dpCerts.Items)
+	})
+}
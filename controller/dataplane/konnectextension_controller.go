@@ -3,7 +3,9 @@ package dataplane
 import (
 	"context"
 	"reflect"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +21,8 @@ import (
 	operatorerrors "github.com/kong/gateway-operator/internal/errors"
 	"github.com/kong/gateway-operator/internal/utils/index"
 	"github.com/kong/gateway-operator/pkg/consts"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -38,6 +42,8 @@ type KonnectExtensionReconciler struct {
 func (r *KonnectExtensionReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&operatorv1alpha1.KonnectExtension{}).
+		Owns(&corev1.Secret{}).
+		Owns(&configurationv1alpha1.KongDataPlaneClientCertificate{}).
 		Watches(&operatorv1beta1.DataPlane{}, handler.EnqueueRequestsFromMapFunc(r.listDataPlaneExtensionsReferenced)).
 		Complete(r)
 }
@@ -115,5 +121,10 @@ func (r *KonnectExtensionReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		log.Info(logger, "KonnectExtension finalizer updated", konnectExtension)
 	}
 
-	return ctrl.Result{}, nil
+	if !konnectExtension.GetDeletionTimestamp().IsZero() {
+		// Objects owned by the KonnectExtension are garbage collected.
+		return ctrl.Result{}, nil
+	}
+
+	return r.ensureClusterCertificate(ctx, logger, &konnectExtension, time.Now())
 }
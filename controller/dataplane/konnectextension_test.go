@@ -21,19 +21,23 @@ import (
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 
 	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
 )
 
 func TestApplyKonnectExtension(t *testing.T) {
 	s := scheme.Scheme
 	require.NoError(t, operatorv1alpha1.AddToScheme(s))
 	require.NoError(t, operatorv1beta1.AddToScheme(s))
+	require.NoError(t, konnectv1alpha1.AddToScheme(s))
 
 	tests := []struct {
-		name          string
-		dataplane     *operatorv1beta1.DataPlane
-		konnectExt    *operatorv1alpha1.KonnectExtension
-		secret        *corev1.Secret
-		expectedError error
+		name                   string
+		dataplane              *operatorv1beta1.DataPlane
+		konnectExt             *operatorv1alpha1.KonnectExtension
+		controlPlane           *konnectv1alpha1.KonnectGatewayControlPlane
+		secret                 *corev1.Secret
+		expectedControlPlaneID string
+		expectedError          error
 	}{
 		{
 			name: "no extensions",
@@ -78,7 +82,7 @@ func TestApplyKonnectExtension(t *testing.T) {
 				},
 				Spec: operatorv1alpha1.KonnectExtensionSpec{
 					AuthConfiguration: operatorv1alpha1.KonnectControlPlaneAPIAuthConfiguration{
-						ClusterCertificateSecretRef: operatorv1alpha1.ClusterCertificateSecretRef{
+						ClusterCertificateSecretRef: &operatorv1alpha1.ClusterCertificateSecretRef{
 							Name: "cluster-cert-secret",
 						},
 					},
@@ -150,7 +154,7 @@ func TestApplyKonnectExtension(t *testing.T) {
 				},
 				Spec: operatorv1alpha1.KonnectExtensionSpec{
 					AuthConfiguration: operatorv1alpha1.KonnectControlPlaneAPIAuthConfiguration{
-						ClusterCertificateSecretRef: operatorv1alpha1.ClusterCertificateSecretRef{
+						ClusterCertificateSecretRef: &operatorv1alpha1.ClusterCertificateSecretRef{
 							Name: "cluster-cert-secret",
 						},
 					},
@@ -195,7 +199,7 @@ func TestApplyKonnectExtension(t *testing.T) {
 				},
 				Spec: operatorv1alpha1.KonnectExtensionSpec{
 					AuthConfiguration: operatorv1alpha1.KonnectControlPlaneAPIAuthConfiguration{
-						ClusterCertificateSecretRef: operatorv1alpha1.ClusterCertificateSecretRef{
+						ClusterCertificateSecretRef: &operatorv1alpha1.ClusterCertificateSecretRef{
 							Name: "cluster-cert-secret",
 						},
 					},
@@ -259,7 +263,7 @@ func TestApplyKonnectExtension(t *testing.T) {
 				},
 				Spec: operatorv1alpha1.KonnectExtensionSpec{
 					AuthConfiguration: operatorv1alpha1.KonnectControlPlaneAPIAuthConfiguration{
-						ClusterCertificateSecretRef: operatorv1alpha1.ClusterCertificateSecretRef{
+						ClusterCertificateSecretRef: &operatorv1alpha1.ClusterCertificateSecretRef{
 							Name: "cluster-cert-secret",
 						},
 					},
@@ -277,6 +281,120 @@ func TestApplyKonnectExtension(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Extension with automatic cluster certificate provisioning, certificate not provisioned yet",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Extensions: []operatorv1alpha1.ExtensionRef{
+							{
+								Group: operatorv1alpha1.SchemeGroupVersion.Group,
+								Kind:  operatorv1alpha1.KonnectExtensionKind,
+								NamespacedRef: operatorv1alpha1.NamespacedRef{
+									Name: "konnect-ext",
+								},
+							},
+						},
+						Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+							DeploymentOptions: operatorv1beta1.DeploymentOptions{
+								PodTemplateSpec: &corev1.PodTemplateSpec{},
+							},
+						},
+					},
+				},
+			},
+			konnectExt: &operatorv1alpha1.KonnectExtension{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "konnect-ext",
+					Namespace: "default",
+				},
+				Spec: operatorv1alpha1.KonnectExtensionSpec{
+					AuthConfiguration: operatorv1alpha1.KonnectControlPlaneAPIAuthConfiguration{
+						ClusterCertificateProvisioning: operatorv1alpha1.ClusterCertificateProvisioningAutomatic,
+					},
+					ControlPlaneRef: configurationv1alpha1.ControlPlaneRef{
+						Type: configurationv1alpha1.ControlPlaneRefKonnectNamespacedRef,
+						KonnectNamespacedRef: &configurationv1alpha1.KonnectNamespacedRef{
+							Name: "cp",
+						},
+					},
+					ControlPlaneRegion: "us-west",
+					ServerHostname:     "konnect.example.com",
+				},
+			},
+			expectedError: ErrClusterCertificateNotFound,
+		},
+		{
+			name: "Extension with automatic cluster certificate provisioning, certificate provisioned",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Extensions: []operatorv1alpha1.ExtensionRef{
+							{
+								Group: operatorv1alpha1.SchemeGroupVersion.Group,
+								Kind:  operatorv1alpha1.KonnectExtensionKind,
+								NamespacedRef: operatorv1alpha1.NamespacedRef{
+									Name: "konnect-ext",
+								},
+							},
+						},
+						Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+							DeploymentOptions: operatorv1beta1.DeploymentOptions{
+								PodTemplateSpec: &corev1.PodTemplateSpec{},
+							},
+						},
+					},
+				},
+			},
+			konnectExt: &operatorv1alpha1.KonnectExtension{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "konnect-ext",
+					Namespace: "default",
+				},
+				Spec: operatorv1alpha1.KonnectExtensionSpec{
+					AuthConfiguration: operatorv1alpha1.KonnectControlPlaneAPIAuthConfiguration{
+						ClusterCertificateProvisioning: operatorv1alpha1.ClusterCertificateProvisioningAutomatic,
+					},
+					ControlPlaneRef: configurationv1alpha1.ControlPlaneRef{
+						Type: configurationv1alpha1.ControlPlaneRefKonnectNamespacedRef,
+						KonnectNamespacedRef: &configurationv1alpha1.KonnectNamespacedRef{
+							Name: "cp",
+						},
+					},
+					ControlPlaneRegion: "us-west",
+					ServerHostname:     "konnect.example.com",
+				},
+				Status: operatorv1alpha1.KonnectExtensionStatus{
+					ClusterCertificateSecretRef: &operatorv1alpha1.ClusterCertificateSecretRef{
+						Name: "konnect-ext-cluster-cert-abcde",
+					},
+				},
+			},
+			controlPlane: &konnectv1alpha1.KonnectGatewayControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cp",
+					Namespace: "default",
+				},
+				Status: konnectv1alpha1.KonnectGatewayControlPlaneStatus{
+					KonnectEntityStatus: konnectv1alpha1.KonnectEntityStatus{
+						ID: "konnect-cp-id",
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "konnect-ext-cluster-cert-abcde",
+					Namespace: "default",
+				},
+			},
+			expectedControlPlaneID: "konnect-cp-id",
+		},
 	}
 
 	for _, tt := range tests {
@@ -285,6 +403,9 @@ func TestApplyKonnectExtension(t *testing.T) {
 			if tt.konnectExt != nil {
 				objs = append(objs, tt.konnectExt)
 			}
+			if tt.controlPlane != nil {
+				objs = append(objs, tt.controlPlane)
+			}
 			if tt.secret != nil {
 				objs = append(objs, tt.secret)
 			}
@@ -304,7 +425,11 @@ func TestApplyKonnectExtension(t *testing.T) {
 				}
 
 				if tt.konnectExt != nil {
-					requiredEnv = append(requiredEnv, getKongInKonnectEnvVars(*tt.konnectExt)...)
+					cpID := tt.expectedControlPlaneID
+					if cpID == "" {
+						cpID = *tt.konnectExt.Spec.ControlPlaneRef.KonnectID
+					}
+					requiredEnv = append(requiredEnv, getKongInKonnectEnvVars(*tt.konnectExt, cpID)...)
 					sort.Sort(k8sutils.SortableEnvVars(requiredEnv))
 					assert.NotNil(t, dataplane.Spec.Deployment.PodTemplateSpec)
 					assert.Equal(t, requiredEnv, dataplane.Spec.Deployment.PodTemplateSpec.Spec.Containers[0].Env)
@@ -314,10 +439,10 @@ func TestApplyKonnectExtension(t *testing.T) {
 	}
 }
 
-func getKongInKonnectEnvVars(konnectExt operatorv1alpha1.KonnectExtension) []corev1.EnvVar {
+func getKongInKonnectEnvVars(konnectExt operatorv1alpha1.KonnectExtension, cpID string) []corev1.EnvVar {
 	envSet := []corev1.EnvVar{}
 	for k, v := range dputils.KongInKonnectDefaults(dputils.KongInKonnectParams{
-		ControlPlane: *konnectExt.Spec.ControlPlaneRef.KonnectID,
+		ControlPlane: cpID,
 		Region:       konnectExt.Spec.ControlPlaneRegion,
 		Server:       konnectExt.Spec.ServerHostname,
 	}) {
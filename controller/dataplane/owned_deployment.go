@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
@@ -30,6 +32,8 @@ import (
 // DeploymentBuilder builds a Deployment for a DataPlane.
 type DeploymentBuilder struct {
 	clusterCertificateName string
+	hybridCertificateName  string
+	hybridControlPlaneHost string
 	beforeCallbacks        CallbackManager
 	afterCallbacks         CallbackManager
 	logger                 logr.Logger
@@ -65,6 +69,15 @@ func (d *DeploymentBuilder) WithClusterCertificate(name string) *DeploymentBuild
 	return d
 }
 
+// WithHybridClusterCertificate configures the hybrid mode cluster certificate name
+// and the hostname of the control plane's cluster Service for a DeploymentBuilder.
+// The control plane hostname is only used by DataPlanes with the data_plane role.
+func (d *DeploymentBuilder) WithHybridClusterCertificate(name, controlPlaneHost string) *DeploymentBuilder {
+	d.hybridCertificateName = name
+	d.hybridControlPlaneHost = controlPlaneHost
+	return d
+}
+
 // WithAdditionalLabels configures additional labels for a DeploymentBuilder.
 func (d *DeploymentBuilder) WithAdditionalLabels(labels client.MatchingLabels) *DeploymentBuilder {
 	d.additionalLabels = labels
@@ -91,6 +104,20 @@ func (d *DeploymentBuilder) BuildAndDeploy(
 	dataplane *operatorv1beta1.DataPlane,
 	developmentMode bool,
 ) (*appsv1.Deployment, op.Result, error) {
+	desired, err := d.Build(ctx, dataplane, developmentMode)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	return d.Deploy(ctx, dataplane, desired)
+}
+
+// Build builds the desired DataPlane Deployment, with the callbacks and
+// the user patches applied, without pushing it to Kubernetes.
+func (d *DeploymentBuilder) Build(
+	ctx context.Context,
+	dataplane *operatorv1beta1.DataPlane,
+	developmentMode bool,
+) (*appsv1.Deployment, error) {
 	// run any preparatory callbacks
 	beforeDeploymentCallbacks := NewCallbackRunner(d.client)
 	cbErrors := beforeDeploymentCallbacks.For(dataplane).Runs(d.beforeCallbacks).Do(ctx, nil)
@@ -98,27 +125,23 @@ func (d *DeploymentBuilder) BuildAndDeploy(
 		for _, err := range cbErrors {
 			d.logger.Error(err, "callback failed")
 		}
-		return nil, op.Noop, fmt.Errorf("before generation callbacks failed")
-	}
-
-	// if there is more than one Deployment, delete the extras
-	reduced, existingDeployment, err := listOrReduceDataPlaneDeployments(ctx, d.client, dataplane, d.additionalLabels)
-	if err != nil {
-		return nil, op.Noop, fmt.Errorf("failed listing existing Deployments: %w", err)
-	}
-	if reduced {
-		return nil, op.Noop, nil
+		return nil, fmt.Errorf("before generation callbacks failed")
 	}
 
 	// generate the initial Deployment struct
 	desiredDeployment, err := generateDataPlaneDeployment(developmentMode, dataplane, d.defaultImage, d.additionalLabels, d.opts...)
 	if err != nil {
-		return nil, op.Noop, fmt.Errorf("could not generate Deployment: %w", err)
+		return nil, fmt.Errorf("could not generate Deployment: %w", err)
 	}
 
 	// Add the cluster certificate to the generated Deployment
 	desiredDeployment = setClusterCertVars(desiredDeployment, d.clusterCertificateName)
 
+	// Configure the hybrid mode role, overriding the cluster certificate variables set above.
+	if dataplane.Spec.Hybrid != nil {
+		desiredDeployment = setHybridVars(desiredDeployment, dataplane.Spec.Hybrid, d.hybridCertificateName, d.hybridControlPlaneHost)
+	}
+
 	// run any callbacks that patch the initial Deployment struct
 	afterDeploymentCallbacks := NewCallbackRunner(d.client)
 	cbErrors = afterDeploymentCallbacks.For(dataplane).Runs(d.afterCallbacks).
@@ -127,7 +150,7 @@ func (d *DeploymentBuilder) BuildAndDeploy(
 		for _, err := range cbErrors {
 			d.logger.Error(err, "callback failed")
 		}
-		return nil, op.Noop, fmt.Errorf("after generation callbacks failed")
+		return nil, fmt.Errorf("after generation callbacks failed")
 	}
 
 	// TODO https://github.com/Kong/gateway-operator/issues/128
@@ -138,14 +161,40 @@ func (d *DeploymentBuilder) BuildAndDeploy(
 	// apply user patches and set any default environment variables that aren't already set
 	desiredDeployment, err = applyDeploymentUserPatchesForDataPlane(dataplane, desiredDeployment)
 	if err != nil {
-		return nil, op.Noop, err
+		return nil, err
 	}
 	// apply default envvars and restore the hacked-out ones
 	desiredDeployment = applyEnvForDataPlane(existingEnvVars, desiredDeployment, dputils.KongDefaults)
 
+	// apply user patches targeting the complete Deployment
+	desired := desiredDeployment.Unwrap()
+	if err := k8sresources.ApplyResourcePatches(desired, operatorv1beta1.ResourcePatchTargetKindDeployment, dataplane.Spec.Patches); err != nil {
+		return nil, err
+	}
+	return desired, nil
+}
+
+// Deploy pushes the desired DataPlane Deployment, as returned by Build, to Kubernetes,
+// or reduces Deployments if there are more than one. It returns the Deployment if it
+// created or updated one, or nil if it needed to reduce or did not need to update an
+// existing Deployment.
+func (d *DeploymentBuilder) Deploy(
+	ctx context.Context,
+	dataplane *operatorv1beta1.DataPlane,
+	desired *appsv1.Deployment,
+) (*appsv1.Deployment, op.Result, error) {
+	// if there is more than one Deployment, delete the extras
+	reduced, existingDeployment, err := listOrReduceDataPlaneDeployments(ctx, d.client, dataplane, d.additionalLabels)
+	if err != nil {
+		return nil, op.Noop, fmt.Errorf("failed listing existing Deployments: %w", err)
+	}
+	if reduced {
+		return nil, op.Noop, nil
+	}
+
 	// push the complete Deployment to Kubernetes
 	res, deployment, err := reconcileDataPlaneDeployment(ctx, d.client, d.logger,
-		dataplane, existingDeployment, desiredDeployment.Unwrap())
+		dataplane, existingDeployment, desired)
 	if err != nil {
 		return nil, op.Noop, err
 	}
@@ -164,6 +213,15 @@ func generateDataPlaneDeployment(
 	if len(additionalDeploymentLabels) > 0 {
 		opts = append(opts, matchingLabelsToDeploymentOpt(additionalDeploymentLabels))
 	}
+	if observability := dataplane.Spec.Observability; observability != nil && observability.Tracing != nil {
+		opts = append(opts, withTracing(observability.Tracing))
+	}
+	if dataplane.Spec.KongConfig != nil {
+		opts = append(opts, withKongConfig(dataplane.Spec.KongConfig))
+	}
+	if dataplane.Spec.Database != nil {
+		opts = append(opts, withDatabase(dataplane.Spec.Database))
+	}
 
 	versionValidationOptions := make([]versions.VersionValidationOption, 0)
 	if !developmentMode {
@@ -181,6 +239,63 @@ func generateDataPlaneDeployment(
 	return generatedDeployment, nil
 }
 
+// withTracing returns a DeploymentOpt setting the environment variables which
+// configure the DataPlane to generate traces. They can be overridden by
+// the user through the DataPlane's PodTemplateSpec.
+func withTracing(tracing *operatorv1beta1.DataPlaneTracingOptions) k8sresources.DeploymentOpt {
+	return func(deployment *appsv1.Deployment) {
+		container := k8sutils.GetPodContainerByName(&deployment.Spec.Template.Spec, consts.DataPlaneProxyContainerName)
+		if container == nil {
+			return
+		}
+		container.Env = append(container.Env, dputils.ConfigureTracingRelatedEnvVars(tracing)...)
+	}
+}
+
+// withKongConfig returns a DeploymentOpt setting the environment variables rendered
+// from the DataPlane's typed Kong configuration. The names of the plugins installed
+// through KongPluginInstallations are kept in the enabled plugins.
+func withKongConfig(kongConfig *operatorv1beta1.KongConfig) k8sresources.DeploymentOpt {
+	return func(deployment *appsv1.Deployment) {
+		container := k8sutils.GetPodContainerByName(&deployment.Spec.Template.Spec, consts.DataPlaneProxyContainerName)
+		if container == nil {
+			return
+		}
+		installedPlugins := k8sutils.EnvValueByName(container.Env, consts.EnvVarKongPlugins)
+		for _, envVar := range dputils.ConfigureKongConfigEnvVars(kongConfig) {
+			value := envVar.Value
+			if envVar.Name == consts.EnvVarKongPlugins && installedPlugins != "" {
+				plugins := slices.Clone(kongConfig.Plugins)
+				for _, p := range strings.Split(installedPlugins, ",") {
+					if p != "bundled" && !slices.Contains(plugins, p) {
+						plugins = append(plugins, p)
+					}
+				}
+				value = strings.Join(plugins, ",")
+			}
+			container.Env = k8sutils.UpdateEnv(container.Env, envVar.Name, value)
+		}
+	}
+}
+
+// withDatabase returns a DeploymentOpt setting the environment variables which
+// configure the DataPlane to use the provided database.
+func withDatabase(database *operatorv1beta1.DataPlaneDatabaseOptions) k8sresources.DeploymentOpt {
+	return func(deployment *appsv1.Deployment) {
+		container := k8sutils.GetPodContainerByName(&deployment.Spec.Template.Spec, consts.DataPlaneProxyContainerName)
+		if container == nil {
+			return
+		}
+		for _, envVar := range dputils.ConfigureDatabaseEnvVars(database) {
+			if envVar.ValueFrom != nil {
+				container.Env = k8sutils.UpdateEnvSource(container.Env, envVar.Name, envVar.ValueFrom)
+			} else {
+				container.Env = k8sutils.UpdateEnv(container.Env, envVar.Name, envVar.Value)
+			}
+		}
+	}
+}
+
 // applyDeploymentUserPatchesForDataPlane applies user PodTemplateSpec patches and fills in defaults
 // for any previously unset environment variables.
 func applyDeploymentUserPatchesForDataPlane(
@@ -227,6 +342,47 @@ func setClusterCertVars(
 		)
 }
 
+// setHybridVars mounts the hybrid mode cluster certificate and sets the environment
+// variables configuring the Kong Gateway hybrid mode role. The control plane
+// role additionally exposes the cluster and telemetry ports.
+func setHybridVars(
+	deployment *k8sresources.Deployment,
+	hybrid *operatorv1beta1.DataPlaneHybridOptions,
+	secretName string,
+	controlPlaneHost string,
+) *k8sresources.Deployment {
+	deployment = deployment.WithVolume(k8sresources.HybridClusterCertificateVolume(secretName)).
+		WithVolumeMount(k8sresources.HybridClusterCertificateVolumeMount(), consts.DataPlaneProxyContainerName)
+	for _, envVar := range dputils.ConfigureHybridEnvVars(hybrid, controlPlaneHost) {
+		deployment = deployment.WithEnvVar(envVar, consts.DataPlaneProxyContainerName)
+	}
+
+	if hybrid.Role != operatorv1beta1.DataPlaneHybridRoleControlPlane {
+		return deployment
+	}
+	container := k8sutils.GetPodContainerByName(&deployment.Spec.Template.Spec, consts.DataPlaneProxyContainerName)
+	if container == nil {
+		return deployment
+	}
+	for _, port := range []corev1.ContainerPort{
+		{
+			Name:          consts.DataPlaneClusterServicePortName,
+			ContainerPort: consts.DataPlaneClusterPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+		{
+			Name:          consts.DataPlaneClusterTelemetryServicePortName,
+			ContainerPort: consts.DataPlaneClusterTelemetryPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+	} {
+		if !slices.ContainsFunc(container.Ports, func(p corev1.ContainerPort) bool { return p.Name == port.Name }) {
+			container.Ports = append(container.Ports, port)
+		}
+	}
+	return deployment
+}
+
 // listOrReduceDataPlaneDeployments lists existing DataPlane Deployments. If only one is present, it returns it. If
 // multiple are present, it reduces them to one and notifies the caller it reduced, so that the caller can try its
 // operation again once there's only a single Deployment to work with.
@@ -325,7 +481,7 @@ func reconcileDataPlaneDeployment(
 
 		if scaling := dataplane.Spec.Deployment.DeploymentOptions.Scaling; false ||
 			// If the scaling strategy is not specified, we compare the replicas.
-			(scaling == nil || scaling.HorizontalScaling == nil) ||
+			(scaling == nil || (scaling.HorizontalScaling == nil && scaling.EventDrivenScaling == nil)) ||
 			// If the scaling strategy is specified with minReplicas, we compare
 			// the minReplicas with the existing Deployment replicas and we set
 			// the replicas to the minReplicas if the existing Deployment replicas
@@ -334,12 +490,29 @@ func reconcileDataPlaneDeployment(
 			(scaling != nil && scaling.HorizontalScaling != nil &&
 				scaling.HorizontalScaling.MinReplicas != nil &&
 				existing.Spec.Replicas != nil &&
-				*existing.Spec.Replicas < *scaling.HorizontalScaling.MinReplicas) {
+				*existing.Spec.Replicas < *scaling.HorizontalScaling.MinReplicas) ||
+			// The same applies to event-driven scaling before the KEDA ScaledObject
+			// kicks in.
+			(scaling != nil && scaling.EventDrivenScaling != nil &&
+				scaling.EventDrivenScaling.MinReplicas != nil &&
+				existing.Spec.Replicas != nil &&
+				*existing.Spec.Replicas < *scaling.EventDrivenScaling.MinReplicas) {
 			if !cmp.Equal(existing.Spec.Replicas, desired.Spec.Replicas) {
 				existing.Spec.Replicas = desired.Spec.Replicas
 				updated = true
 			}
 		}
+
+		// ensure that the fields set by the user patches are up to date
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existing, desired,
+			operatorv1beta1.ResourcePatchTargetKindDeployment, dataplane.Spec.Patches)
+		if err != nil {
+			return op.Noop, nil, err
+		}
+		if patched {
+			updated = true
+		}
+
 		if updated {
 			diff := cmp.Diff(original.Spec.Template, desired.Spec.Template, opts...)
 			log.Trace(logger, "Deployment diff detected", diff)
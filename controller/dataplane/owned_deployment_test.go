@@ -0,0 +1,118 @@
+package dataplane
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+)
+
+func TestWithKongConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		env         []corev1.EnvVar
+		kongConfig  *operatorv1beta1.KongConfig
+		expectedEnv []corev1.EnvVar
+	}{
+		{
+			name: "rendered env vars override the existing ones",
+			env: []corev1.EnvVar{
+				{Name: "KONG_DATABASE", Value: "off"},
+				{Name: "KONG_LOG_LEVEL", Value: "notice"},
+			},
+			kongConfig: &operatorv1beta1.KongConfig{
+				LogLevel: lo.ToPtr(operatorv1beta1.KongLogLevelDebug),
+			},
+			expectedEnv: []corev1.EnvVar{
+				{Name: "KONG_DATABASE", Value: "off"},
+				{Name: "KONG_LOG_LEVEL", Value: "debug"},
+			},
+		},
+		{
+			name: "plugins installed with KongPluginInstallations are kept enabled",
+			env: []corev1.EnvVar{
+				{Name: "KONG_PLUGINS", Value: "bundled,plugin-a,plugin-b"},
+			},
+			kongConfig: &operatorv1beta1.KongConfig{
+				Plugins: []string{"key-auth", "plugin-a"},
+			},
+			expectedEnv: []corev1.EnvVar{
+				{Name: "KONG_PLUGINS", Value: "key-auth,plugin-a,plugin-b"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deployment := &appsv1.Deployment{}
+			deployment.Spec.Template.Spec.Containers = []corev1.Container{
+				{
+					Name: consts.DataPlaneProxyContainerName,
+					Env:  tc.env,
+				},
+			}
+			withKongConfig(tc.kongConfig)(deployment)
+			require.Equal(t, tc.expectedEnv, deployment.Spec.Template.Spec.Containers[0].Env)
+		})
+	}
+}
+
+func TestSetHybridVars(t *testing.T) {
+	newDeployment := func() *k8sresources.Deployment {
+		deployment := &k8sresources.Deployment{}
+		deployment.Spec.Template.Spec.Containers = []corev1.Container{
+			{
+				Name: consts.DataPlaneProxyContainerName,
+				Env: []corev1.EnvVar{
+					{Name: "KONG_CLUSTER_CERT", Value: "/var/cluster-certificate/tls.crt"},
+				},
+			},
+		}
+		return deployment
+	}
+	envValue := func(deployment *k8sresources.Deployment, name string) string {
+		envVar, ok := lo.Find(deployment.Spec.Template.Spec.Containers[0].Env, func(e corev1.EnvVar) bool { return e.Name == name })
+		if !ok {
+			return ""
+		}
+		return envVar.Value
+	}
+
+	t.Run("control plane", func(t *testing.T) {
+		deployment := setHybridVars(newDeployment(), &operatorv1beta1.DataPlaneHybridOptions{
+			Role: operatorv1beta1.DataPlaneHybridRoleControlPlane,
+		}, "hybrid-cert", "")
+		require.Equal(t, "control_plane", envValue(deployment, "KONG_ROLE"))
+		require.Equal(t, "/var/hybrid-cluster-certificate/tls.crt", envValue(deployment, "KONG_CLUSTER_CERT"))
+		require.Equal(t, "0.0.0.0:8005", envValue(deployment, "KONG_CLUSTER_LISTEN"))
+		require.Len(t, deployment.Spec.Template.Spec.Volumes, 1)
+		require.Equal(t, "hybrid-cert", deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName)
+		require.Equal(t, consts.HybridClusterCertificateVolumeMountPath, deployment.Spec.Template.Spec.Containers[0].VolumeMounts[0].MountPath)
+		require.Equal(t, []int32{consts.DataPlaneClusterPort, consts.DataPlaneClusterTelemetryPort},
+			lo.Map(deployment.Spec.Template.Spec.Containers[0].Ports, func(p corev1.ContainerPort, _ int) int32 { return p.ContainerPort }),
+		)
+
+		// Applying the vars again doesn't duplicate the ports.
+		deployment = setHybridVars(deployment, &operatorv1beta1.DataPlaneHybridOptions{
+			Role: operatorv1beta1.DataPlaneHybridRoleControlPlane,
+		}, "hybrid-cert", "")
+		require.Len(t, deployment.Spec.Template.Spec.Containers[0].Ports, 2)
+	})
+
+	t.Run("data plane", func(t *testing.T) {
+		deployment := setHybridVars(newDeployment(), &operatorv1beta1.DataPlaneHybridOptions{
+			Role:            operatorv1beta1.DataPlaneHybridRoleDataPlane,
+			ControlPlaneRef: &operatorv1beta1.DataPlaneHybridControlPlaneRef{Name: "cp"},
+		}, "hybrid-cert", "dataplane-cluster-cp-abcde.default.svc")
+		require.Equal(t, "data_plane", envValue(deployment, "KONG_ROLE"))
+		require.Equal(t, "dataplane-cluster-cp-abcde.default.svc:8005", envValue(deployment, "KONG_CLUSTER_CONTROL_PLANE"))
+		require.Equal(t, "", envValue(deployment, "KONG_CLUSTER_LISTEN"))
+		require.Empty(t, deployment.Spec.Template.Spec.Containers[0].Ports)
+	})
+}
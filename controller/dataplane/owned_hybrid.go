@@ -0,0 +1,290 @@
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	dataplanepkg "github.com/kong/gateway-operator/controller/pkg/dataplane"
+	"github.com/kong/gateway-operator/controller/pkg/op"
+	"github.com/kong/gateway-operator/controller/pkg/secrets"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	k8sreduce "github.com/kong/gateway-operator/pkg/utils/kubernetes/reduce"
+	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+)
+
+// -----------------------------------------------------------------------------
+// DataPlane - Hybrid mode
+// -----------------------------------------------------------------------------
+
+// ensureHybridModeForDataPlane ensures the resources needed by the DataPlane's
+// hybrid mode role exist, and that they are deleted when the DataPlane doesn't
+// run in hybrid mode:
+//   - the cluster Service of a DataPlane with the control_plane role,
+//   - the hybrid cluster certificate issued from the provided cluster CA.
+//
+// For the data_plane role, it also returns the hostname of the referenced
+// control plane's cluster Service. When it cannot be resolved yet, no
+// certificate is returned and the DataPlane's HybridControlPlaneResolved
+// condition provides feedback about the reason.
+func ensureHybridModeForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	clusterCASecretNN types.NamespacedName,
+) (res op.Result, certSecret *corev1.Secret, controlPlaneHost string, err error) {
+	res, clusterService, err := ensureHybridClusterServiceForDataPlane(ctx, cl, dataplane)
+	if err != nil || res != op.Noop {
+		return res, nil, "", err
+	}
+
+	hybrid := dataplane.Spec.Hybrid
+	if hybrid == nil || hybrid.Role != operatorv1beta1.DataPlaneHybridRoleDataPlane {
+		if k8sutils.RemoveCondition(consts.DataPlaneConditionTypeHybridControlPlaneResolved, dataplane) {
+			if _, err := patchDataPlaneStatus(ctx, cl, logger, dataplane); err != nil {
+				return op.Noop, nil, "", fmt.Errorf("failed removing DataPlane HybridControlPlaneResolved condition: %w", err)
+			}
+		}
+	}
+	if hybrid == nil {
+		res, err := ensureNoHybridCertificateForDataPlane(ctx, cl, dataplane)
+		return res, nil, "", err
+	}
+
+	var subject string
+	switch hybrid.Role {
+	case operatorv1beta1.DataPlaneHybridRoleControlPlane:
+		subject = serviceHostname(clusterService)
+	case operatorv1beta1.DataPlaneHybridRoleDataPlane:
+		controlPlaneHost, err = resolveHybridControlPlaneHost(ctx, cl, logger, dataplane)
+		if err != nil || controlPlaneHost == "" {
+			return op.Noop, nil, "", err
+		}
+		subject = fmt.Sprintf("%s.%s", dataplane.Name, dataplane.Namespace)
+	default:
+		return op.Noop, nil, "", fmt.Errorf("unsupported hybrid mode role %s", hybrid.Role)
+	}
+
+	usages := []certificatesv1.KeyUsage{
+		certificatesv1.UsageKeyEncipherment,
+		certificatesv1.UsageDigitalSignature,
+		certificatesv1.UsageServerAuth,
+		certificatesv1.UsageClientAuth,
+	}
+	res, certSecret, err = secrets.EnsureCertificate(ctx,
+		dataplane,
+		subject,
+		clusterCASecretNN,
+		usages,
+		cl,
+		getManagedLabelForHybridCertificate(),
+	)
+	if err != nil {
+		return op.Noop, nil, "", fmt.Errorf("failed ensuring hybrid cluster certificate for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+	return res, certSecret, controlPlaneHost, nil
+}
+
+// ensureHybridClusterServiceForDataPlane ensures the cluster Service exposing the
+// hybrid mode cluster and telemetry endpoints exists when the DataPlane has the
+// control_plane role. Otherwise, the cluster Services are deleted.
+func ensureHybridClusterServiceForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+) (op.Result, *corev1.Service, error) {
+	dpNn := client.ObjectKeyFromObject(dataplane)
+	services, err := listHybridClusterServicesForDataPlane(ctx, cl, dataplane)
+	if err != nil {
+		return op.Noop, nil, fmt.Errorf("failed listing cluster Services for DataPlane %s: %w", dpNn, err)
+	}
+
+	if !isHybridControlPlane(dataplane) {
+		if len(services) == 0 {
+			return op.Noop, nil, nil
+		}
+		for _, svc := range services {
+			if err := cl.Delete(ctx, &svc); client.IgnoreNotFound(err) != nil {
+				return op.Noop, nil, fmt.Errorf("failed deleting cluster Service %s for DataPlane %s: %w", svc.Name, dpNn, err)
+			}
+		}
+		return op.Deleted, nil, nil
+	}
+
+	if len(services) > 1 {
+		if err := k8sreduce.ReduceServices(ctx, cl, services); err != nil {
+			return op.Noop, nil, err
+		}
+		return op.Noop, nil, errors.New("number of DataPlane cluster Services reduced")
+	}
+
+	generatedService, err := k8sresources.GenerateNewClusterServiceForDataPlane(dataplane,
+		k8sresources.LabelSelectorFromDataPlaneStatusSelectorServiceOpt(dataplane),
+	)
+	if err != nil {
+		return op.Noop, nil, fmt.Errorf("failed generating cluster Service for DataPlane %s: %w", dpNn, err)
+	}
+
+	if len(services) == 0 {
+		if err := cl.Create(ctx, generatedService); err != nil {
+			return op.Noop, nil, fmt.Errorf("failed creating cluster Service for DataPlane %s: %w", dpNn, err)
+		}
+		return op.Created, generatedService, nil
+	}
+
+	var updated bool
+	existingService := &services[0]
+	updated, existingService.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existingService.ObjectMeta, generatedService.ObjectMeta)
+	if !cmp.Equal(existingService.Spec.Selector, generatedService.Spec.Selector) {
+		existingService.Spec.Selector = generatedService.Spec.Selector
+		updated = true
+	}
+	if !cmp.Equal(existingService.Spec.Ports, generatedService.Spec.Ports) {
+		existingService.Spec.Ports = generatedService.Spec.Ports
+		updated = true
+	}
+	patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingService, generatedService,
+		operatorv1beta1.ResourcePatchTargetKindService, dataplane.Spec.Patches)
+	if err != nil {
+		return op.Noop, nil, err
+	}
+	if updated || patched {
+		if err := cl.Update(ctx, existingService); err != nil {
+			return op.Noop, nil, fmt.Errorf("failed updating cluster Service %s for DataPlane %s: %w", existingService.Name, dpNn, err)
+		}
+		return op.Updated, existingService, nil
+	}
+	return op.Noop, existingService, nil
+}
+
+// resolveHybridControlPlaneHost returns the hostname of the cluster Service of
+// the control plane DataPlane referenced by a DataPlane with the data_plane role.
+// An empty hostname is returned when it cannot be resolved. The DataPlane's
+// HybridControlPlaneResolved condition reflects the outcome.
+func resolveHybridControlPlaneHost(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+) (string, error) {
+	ref := dataplane.Spec.Hybrid.ControlPlaneRef
+	if ref == nil {
+		return "", fmt.Errorf("DataPlane %s/%s with the %s role doesn't reference a control plane",
+			dataplane.Namespace, dataplane.Name, operatorv1beta1.DataPlaneHybridRoleDataPlane,
+		)
+	}
+
+	var (
+		host    string
+		reason  consts.ConditionReason
+		message string
+	)
+	controlPlane := &operatorv1beta1.DataPlane{}
+	err := cl.Get(ctx, types.NamespacedName{Namespace: dataplane.Namespace, Name: ref.Name}, controlPlane)
+	switch {
+	case k8serrors.IsNotFound(err):
+		reason = consts.DataPlaneConditionReasonHybridControlPlaneNotFound
+		message = fmt.Sprintf("control plane DataPlane %s not found", ref.Name)
+	case err != nil:
+		return "", fmt.Errorf("failed getting control plane DataPlane %s: %w", ref.Name, err)
+	case !isHybridControlPlane(controlPlane):
+		reason = consts.DataPlaneConditionReasonHybridControlPlaneInvalidRole
+		message = fmt.Sprintf("DataPlane %s doesn't have the %s role", ref.Name, operatorv1beta1.DataPlaneHybridRoleControlPlane)
+	default:
+		services, err := listHybridClusterServicesForDataPlane(ctx, cl, controlPlane)
+		if err != nil {
+			return "", fmt.Errorf("failed listing cluster Services for control plane DataPlane %s: %w", ref.Name, err)
+		}
+		if len(services) != 1 {
+			reason = consts.DataPlaneConditionReasonHybridClusterServiceNotReady
+			message = fmt.Sprintf("cluster Service of control plane DataPlane %s is not ready", ref.Name)
+			break
+		}
+		host = serviceHostname(&services[0])
+		reason = consts.DataPlaneConditionReasonHybridControlPlaneResolved
+		message = fmt.Sprintf("connecting to control plane DataPlane %s through %s", ref.Name, host)
+	}
+
+	status := metav1.ConditionFalse
+	if host != "" {
+		status = metav1.ConditionTrue
+	}
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(consts.DataPlaneConditionTypeHybridControlPlaneResolved, status, reason, message, dataplane.Generation),
+		dataplane,
+	)
+	if _, err := patchDataPlaneStatus(ctx, cl, logger, dataplane); err != nil {
+		return "", fmt.Errorf("failed patching DataPlane HybridControlPlaneResolved condition: %w", err)
+	}
+	return host, nil
+}
+
+// ensureNoHybridCertificateForDataPlane deletes the hybrid cluster certificates
+// of a DataPlane which doesn't run in hybrid mode.
+func ensureNoHybridCertificateForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+) (op.Result, error) {
+	matchingLabels := k8sresources.GetManagedLabelForOwner(dataplane)
+	for k, v := range getManagedLabelForHybridCertificate() {
+		matchingLabels[k] = v
+	}
+	certSecrets, err := k8sutils.ListSecretsForOwner(ctx, cl, dataplane.UID, matchingLabels)
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed listing hybrid cluster certificates for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+	if len(certSecrets) == 0 {
+		return op.Noop, nil
+	}
+	for i := range certSecrets {
+		if err := dataplanepkg.OwnedObjectPreDeleteHook(ctx, cl, &certSecrets[i]); err != nil {
+			return op.Noop, err
+		}
+		if err := cl.Delete(ctx, &certSecrets[i]); client.IgnoreNotFound(err) != nil {
+			return op.Noop, fmt.Errorf("failed deleting hybrid cluster certificate %s for DataPlane %s/%s: %w", certSecrets[i].Name, dataplane.Namespace, dataplane.Name, err)
+		}
+	}
+	return op.Deleted, nil
+}
+
+// listHybridClusterServicesForDataPlane lists the cluster Services of the DataPlane.
+func listHybridClusterServicesForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+) ([]corev1.Service, error) {
+	matchingLabels := k8sresources.GetManagedLabelForOwner(dataplane)
+	matchingLabels[consts.DataPlaneServiceTypeLabel] = string(consts.DataPlaneClusterServiceLabelValue)
+	return k8sutils.ListServicesForOwner(ctx, cl, dataplane.Namespace, dataplane.UID, matchingLabels)
+}
+
+// getManagedLabelForHybridCertificate returns the labels identifying the hybrid
+// cluster certificate Secret among the Secrets of a DataPlane.
+func getManagedLabelForHybridCertificate() client.MatchingLabels {
+	return client.MatchingLabels{
+		consts.DataPlaneHybridCertificateLabel: "true",
+	}
+}
+
+// isHybridControlPlane returns true if the DataPlane has the control_plane hybrid mode role.
+func isHybridControlPlane(dataplane *operatorv1beta1.DataPlane) bool {
+	return dataplane.Spec.Hybrid != nil && dataplane.Spec.Hybrid.Role == operatorv1beta1.DataPlaneHybridRoleControlPlane
+}
+
+// serviceHostname returns the in-cluster hostname of the Service.
+func serviceHostname(svc *corev1.Service) string {
+	return fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace)
+}
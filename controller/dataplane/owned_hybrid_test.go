@@ -0,0 +1,176 @@
+package dataplane
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/pkg/builder"
+	"github.com/kong/gateway-operator/controller/pkg/op"
+	"github.com/kong/gateway-operator/modules/manager/scheme"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	"github.com/kong/gateway-operator/test/helpers"
+)
+
+func TestEnsureHybridModeForDataPlane(t *testing.T) {
+	ctx := context.Background()
+
+	ca := helpers.CreateCA(t)
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kong-system",
+			Name:      "mtls-secret",
+		},
+		Data: map[string][]byte{
+			"tls.crt": ca.CertPEM.Bytes(),
+			"tls.key": ca.KeyPEM.Bytes(),
+		},
+	}
+	caSecretNN := client.ObjectKeyFromObject(caSecret)
+
+	newDataPlane := func(name string, uid types.UID, hybrid *operatorv1beta1.DataPlaneHybridOptions) *operatorv1beta1.DataPlane {
+		dp := builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			UID:       uid,
+		}).Build()
+		dp.Spec.Hybrid = hybrid
+		return dp
+	}
+	cp := newDataPlane("cp", "cp-uid", &operatorv1beta1.DataPlaneHybridOptions{
+		Role: operatorv1beta1.DataPlaneHybridRoleControlPlane,
+	})
+	cp.Spec.Database = &operatorv1beta1.DataPlaneDatabaseOptions{
+		Postgres: operatorv1beta1.DataPlanePostgresOptions{
+			Host:                 "postgres.db.svc",
+			CredentialsSecretRef: operatorv1beta1.DataPlanePostgresCredentialsSecretRef{Name: "kong-pg-credentials"},
+		},
+	}
+	cp.Status.Selector = "cp-selector"
+	dp := newDataPlane("dp", "dp-uid", &operatorv1beta1.DataPlaneHybridOptions{
+		Role:            operatorv1beta1.DataPlaneHybridRoleDataPlane,
+		ControlPlaneRef: &operatorv1beta1.DataPlaneHybridControlPlaneRef{Name: "cp"},
+	})
+	traditional := newDataPlane("traditional", "traditional-uid", nil)
+
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(caSecret, cp, dp, traditional).
+		WithStatusSubresource(cp, dp, traditional).
+		Build()
+
+	ensure := func(t *testing.T, dataplane *operatorv1beta1.DataPlane) (op.Result, *corev1.Secret, string) {
+		res, secret, host, err := ensureHybridModeForDataPlane(ctx, cl, logr.Discard(), dataplane, caSecretNN)
+		require.NoError(t, err)
+		return res, secret, host
+	}
+	requireCondition := func(t *testing.T, dataplane *operatorv1beta1.DataPlane, status metav1.ConditionStatus, reason consts.ConditionReason) {
+		current := &operatorv1beta1.DataPlane{}
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dataplane), current))
+		c, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeHybridControlPlaneResolved, current)
+		require.True(t, ok)
+		require.Equal(t, status, c.Status)
+		require.Equal(t, string(reason), c.Reason)
+	}
+	update := func(t *testing.T, dataplane *operatorv1beta1.DataPlane, mutate func(*operatorv1beta1.DataPlane)) {
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dataplane), dataplane))
+		mutate(dataplane)
+		require.NoError(t, cl.Update(ctx, dataplane))
+	}
+	requireCertificateSubject := func(t *testing.T, secret *corev1.Secret, subject string) {
+		block, _ := pem.Decode(secret.Data["tls.crt"])
+		require.NotNil(t, block)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		require.Equal(t, subject, cert.Subject.CommonName)
+		require.Equal(t, "true", secret.Labels[consts.DataPlaneHybridCertificateLabel])
+	}
+
+	t.Log("data plane waits for the control plane cluster Service")
+	res, secret, host := ensure(t, dp)
+	require.Equal(t, op.Noop, res)
+	require.Nil(t, secret)
+	require.Empty(t, host)
+	requireCondition(t, dp, metav1.ConditionFalse, consts.DataPlaneConditionReasonHybridClusterServiceNotReady)
+
+	t.Log("control plane gets a cluster Service and a certificate for its hostname")
+	res, _, _ = ensure(t, cp)
+	require.Equal(t, op.Created, res)
+	services, err := listHybridClusterServicesForDataPlane(ctx, cl, cp)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	require.Len(t, services[0].Spec.Ports, 2)
+	require.Equal(t, map[string]string{
+		"app":                        "cp",
+		consts.OperatorLabelSelector: "cp-selector",
+	}, services[0].Spec.Selector, "cluster Service should only select Pods of the live Deployment")
+	clusterHost := serviceHostname(&services[0])
+
+	res, secret, host = ensure(t, cp)
+	require.Equal(t, op.Created, res)
+	require.Empty(t, host)
+	requireCertificateSubject(t, secret, clusterHost)
+	res, _, _ = ensure(t, cp)
+	require.Equal(t, op.Noop, res)
+
+	t.Log("data plane resolves the control plane and gets a certificate")
+	res, secret, host = ensure(t, dp)
+	require.Equal(t, op.Created, res)
+	require.Equal(t, clusterHost, host)
+	requireCertificateSubject(t, secret, "dp.default")
+	requireCondition(t, dp, metav1.ConditionTrue, consts.DataPlaneConditionReasonHybridControlPlaneResolved)
+	res, secret, host = ensure(t, dp)
+	require.Equal(t, op.Noop, res)
+	require.NotNil(t, secret)
+	require.Equal(t, clusterHost, host)
+
+	t.Log("data plane referencing a DataPlane without the control plane role")
+	update(t, dp, func(dp *operatorv1beta1.DataPlane) { dp.Spec.Hybrid.ControlPlaneRef.Name = traditional.Name })
+	res, secret, _ = ensure(t, dp)
+	require.Equal(t, op.Noop, res)
+	require.Nil(t, secret)
+	requireCondition(t, dp, metav1.ConditionFalse, consts.DataPlaneConditionReasonHybridControlPlaneInvalidRole)
+
+	t.Log("data plane referencing a missing DataPlane")
+	update(t, dp, func(dp *operatorv1beta1.DataPlane) { dp.Spec.Hybrid.ControlPlaneRef.Name = "missing" })
+	res, secret, _ = ensure(t, dp)
+	require.Equal(t, op.Noop, res)
+	require.Nil(t, secret)
+	requireCondition(t, dp, metav1.ConditionFalse, consts.DataPlaneConditionReasonHybridControlPlaneNotFound)
+
+	t.Log("hybrid mode resources are deleted when hybrid mode is disabled")
+	update(t, dp, func(dp *operatorv1beta1.DataPlane) { dp.Spec.Hybrid = nil })
+	res, _, _ = ensure(t, dp)
+	require.Equal(t, op.Deleted, res)
+	res, _, _ = ensure(t, dp)
+	require.Equal(t, op.Noop, res)
+	current := &operatorv1beta1.DataPlane{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+	_, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeHybridControlPlaneResolved, current)
+	require.False(t, ok)
+
+	update(t, cp, func(cp *operatorv1beta1.DataPlane) { cp.Spec.Hybrid = nil })
+	res, _, _ = ensure(t, cp)
+	require.Equal(t, op.Deleted, res)
+	services, err = listHybridClusterServicesForDataPlane(ctx, cl, cp)
+	require.NoError(t, err)
+	require.Empty(t, services)
+	res, _, _ = ensure(t, cp)
+	require.Equal(t, op.Deleted, res)
+	secrets, err := k8sutils.ListSecretsForOwner(ctx, cl, cp.UID, getManagedLabelForHybridCertificate())
+	require.NoError(t, err)
+	require.Empty(t, secrets)
+	res, _, _ = ensure(t, cp)
+	require.Equal(t, op.Noop, res)
+}
@@ -0,0 +1,239 @@
+package dataplane
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+)
+
+// -----------------------------------------------------------------------------
+// DataPlane - Database migrations
+// -----------------------------------------------------------------------------
+
+// ensureDatabaseMigrationsForDataPlane ensures that the provided phases of the
+// database migrations have been run, in order, using the proxy container of the
+// given DataPlane Deployment. Each phase is run by a Job which is only created
+// once the previous phase completed. It returns true when all the phases have completed.
+// The DataPlane's DatabaseMigrated condition reflects the progress of the migrations.
+func ensureDatabaseMigrationsForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	deployment *appsv1.Deployment,
+	phases ...k8sresources.DataPlaneMigrationPhase,
+) (bool, error) {
+	container := k8sutils.GetPodContainerByName(&deployment.Spec.Template.Spec, consts.DataPlaneProxyContainerName)
+	if container == nil {
+		return false, fmt.Errorf("couldn't find proxy container in DataPlane %s Deployment", dataplane.Name)
+	}
+	image := container.Image
+
+	for _, phase := range phases {
+		job, err := getMigrationJobForDataPlane(ctx, cl, dataplane, image, phase)
+		if err != nil {
+			return false, err
+		}
+
+		if job == nil {
+			job, err = k8sresources.GenerateNewMigrationJobForDataPlane(dataplane, deployment, phase)
+			if err != nil {
+				return false, err
+			}
+			if err := cl.Create(ctx, job); err != nil {
+				return false, fmt.Errorf("failed creating %s migrations Job for DataPlane %s: %w", phase, dataplane.Name, err)
+			}
+			log.Debug(logger, "database migrations Job created", dataplane, "phase", phase, "image", image)
+		}
+
+		switch {
+		case isJobConditionTrue(job, batchv1.JobFailed):
+			return false, markDataPlaneDatabaseMigrated(ctx, cl, logger, dataplane,
+				metav1.ConditionFalse, consts.DataPlaneConditionReasonMigrationsFailed,
+				fmt.Sprintf("%s migrations for %s failed, delete Job %s to retry", phase, image, job.Name),
+			)
+		case !isJobConditionTrue(job, batchv1.JobComplete):
+			return false, markDataPlaneDatabaseMigrated(ctx, cl, logger, dataplane,
+				metav1.ConditionFalse, consts.DataPlaneConditionReasonMigrationsInProgress,
+				fmt.Sprintf("running %s migrations for %s", phase, image),
+			)
+		}
+	}
+
+	return true, nil
+}
+
+// ensureDatabaseMigrationsFinishedForDataPlane runs the finish phase of the database
+// migrations once the provided Deployment has been fully rolled out, i.e. once no
+// Pods of the previous Kong Gateway version are running anymore. When all the
+// migrations completed, the Jobs run for other images are deleted and
+// the DataPlane's DatabaseMigrated condition is set to true.
+func ensureDatabaseMigrationsFinishedForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	deployment *appsv1.Deployment,
+) error {
+	container := k8sutils.GetPodContainerByName(&deployment.Spec.Template.Spec, consts.DataPlaneProxyContainerName)
+	if container == nil {
+		return fmt.Errorf("couldn't find proxy container in DataPlane Deployment %s", deployment.Name)
+	}
+	image := container.Image
+
+	if !isDeploymentRolledOut(deployment) {
+		return markDataPlaneDatabaseMigrated(ctx, cl, logger, dataplane,
+			metav1.ConditionFalse, consts.DataPlaneConditionReasonMigrationsInProgress,
+			fmt.Sprintf("waiting for Deployment %s to be rolled out to run finish migrations for %s", deployment.Name, image),
+		)
+	}
+
+	finished, err := ensureDatabaseMigrationsForDataPlane(ctx, cl, logger, dataplane, deployment,
+		k8sresources.DataPlaneMigrationPhaseFinish,
+	)
+	if err != nil || !finished {
+		return err
+	}
+
+	if err := deleteMigrationJobsForDataPlane(ctx, cl, dataplane, func(job *batchv1.Job) bool {
+		return job.Annotations[consts.AnnotationDataPlaneMigrationImage] != image
+	}); err != nil {
+		return err
+	}
+
+	return markDataPlaneDatabaseMigrated(ctx, cl, logger, dataplane,
+		metav1.ConditionTrue, consts.DataPlaneConditionReasonMigrationsCompleted,
+		fmt.Sprintf("migrations for %s completed", image),
+	)
+}
+
+// ensureNoDatabaseMigrationsForDataPlane deletes the database migrations Jobs
+// and the DatabaseMigrated condition of a DataPlane which doesn't use a database.
+func ensureNoDatabaseMigrationsForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+) error {
+	if err := deleteMigrationJobsForDataPlane(ctx, cl, dataplane, func(*batchv1.Job) bool { return true }); err != nil {
+		return err
+	}
+	if k8sutils.RemoveCondition(consts.DataPlaneConditionTypeDatabaseMigrated, dataplane) {
+		if _, err := patchDataPlaneStatus(ctx, cl, logger, dataplane); err != nil {
+			return fmt.Errorf("failed removing DataPlane DatabaseMigrated condition: %w", err)
+		}
+	}
+	return nil
+}
+
+// getMigrationJobForDataPlane returns the newest Job running the provided phase
+// of the database migrations with the given image, or nil if there's none.
+func getMigrationJobForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+	image string,
+	phase k8sresources.DataPlaneMigrationPhase,
+) (*batchv1.Job, error) {
+	jobs, err := k8sutils.ListJobsForOwner(ctx, cl, dataplane.Namespace, dataplane.UID,
+		client.MatchingLabels{
+			consts.DataPlaneMigrationPhaseLabel: string(phase),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing %s migrations Jobs for DataPlane %s: %w", phase, dataplane.Name, err)
+	}
+
+	var newest *batchv1.Job
+	for i := range jobs {
+		if jobs[i].Annotations[consts.AnnotationDataPlaneMigrationImage] != image {
+			continue
+		}
+		if newest == nil || newest.CreationTimestamp.Before(&jobs[i].CreationTimestamp) {
+			newest = &jobs[i]
+		}
+	}
+	return newest, nil
+}
+
+// deleteMigrationJobsForDataPlane deletes the DataPlane's database migrations Jobs
+// matching the provided predicate together with their Pods.
+func deleteMigrationJobsForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+	predicate func(*batchv1.Job) bool,
+) error {
+	jobs, err := k8sutils.ListJobsForOwner(ctx, cl, dataplane.Namespace, dataplane.UID,
+		client.HasLabels{consts.DataPlaneMigrationPhaseLabel},
+	)
+	if err != nil {
+		return fmt.Errorf("failed listing migrations Jobs for DataPlane %s: %w", dataplane.Name, err)
+	}
+	for i := range jobs {
+		if !predicate(&jobs[i]) {
+			continue
+		}
+		if err := cl.Delete(ctx, &jobs[i], client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting migrations Job %s for DataPlane %s: %w", jobs[i].Name, dataplane.Name, err)
+		}
+	}
+	return nil
+}
+
+// markDataPlaneDatabaseMigrated sets the DatabaseMigrated condition on the DataPlane
+// and patches its status if the condition changed.
+func markDataPlaneDatabaseMigrated(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	status metav1.ConditionStatus,
+	reason consts.ConditionReason,
+	message string,
+) error {
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(consts.DataPlaneConditionTypeDatabaseMigrated, status, reason, message, dataplane.Generation),
+		dataplane,
+	)
+	if _, err := patchDataPlaneStatus(ctx, cl, logger, dataplane); err != nil {
+		return fmt.Errorf("failed patching DataPlane DatabaseMigrated condition: %w", err)
+	}
+	return nil
+}
+
+// isJobConditionTrue returns true if the Job has the provided condition set to true.
+func isJobConditionTrue(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// isDeploymentRolledOut returns true if all the Pods of the Deployment are running
+// its current Pod template and are available.
+func isDeploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	return status.ObservedGeneration >= deployment.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.Replicas == replicas &&
+		status.AvailableReplicas == replicas
+}
@@ -0,0 +1,193 @@
+package dataplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/pkg/builder"
+	"github.com/kong/gateway-operator/modules/manager/scheme"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+)
+
+func TestEnsureDatabaseMigrationsForDataPlane(t *testing.T) {
+	const (
+		oldImage = "kong:3.8"
+		newImage = "kong:3.9"
+	)
+	ctx := context.Background()
+
+	dp := builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      "dp-1",
+		UID:       "dp-uid",
+	}).Build()
+	dp.Spec.Database = &operatorv1beta1.DataPlaneDatabaseOptions{
+		Postgres: operatorv1beta1.DataPlanePostgresOptions{
+			Host:                 "postgres.db.svc",
+			CredentialsSecretRef: operatorv1beta1.DataPlanePostgresCredentialsSecretRef{Name: "kong-pg-credentials"},
+		},
+	}
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(dp).
+		WithStatusSubresource(dp).
+		Build()
+
+	listJobs := func(t *testing.T) []batchv1.Job {
+		jobs, err := k8sutils.ListJobsForOwner(ctx, cl, dp.Namespace, dp.UID)
+		require.NoError(t, err)
+		return jobs
+	}
+	setJobCondition := func(t *testing.T, phase k8sresources.DataPlaneMigrationPhase, image string, conditionType batchv1.JobConditionType) {
+		job, err := getMigrationJobForDataPlane(ctx, cl, dp, image, phase)
+		require.NoError(t, err)
+		require.NotNil(t, job)
+		job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+			Type:   conditionType,
+			Status: corev1.ConditionTrue,
+		})
+		require.NoError(t, cl.Status().Update(ctx, job))
+	}
+	requireCondition := func(t *testing.T, status metav1.ConditionStatus, reason consts.ConditionReason) {
+		current := &operatorv1beta1.DataPlane{}
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+		c, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeDatabaseMigrated, current)
+		require.True(t, ok)
+		require.Equal(t, status, c.Status)
+		require.Equal(t, string(reason), c.Reason)
+	}
+	deployment := func(image string, rolledOut bool) *appsv1.Deployment {
+		d := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "dataplane-dp-1-abcde", Generation: 2},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: lo.ToPtr(int32(2)),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  consts.DataPlaneProxyContainerName,
+								Image: image,
+								Env: []corev1.EnvVar{
+									{Name: "KONG_DATABASE", Value: "postgres"},
+									{Name: "KONG_PLUGINS", Value: "bundled,myheader"},
+								},
+								VolumeMounts: []corev1.VolumeMount{
+									{Name: "myheader", MountPath: "/opt/kong/plugins/myheader"},
+								},
+							},
+						},
+						Volumes: []corev1.Volume{
+							{
+								Name: "myheader",
+								VolumeSource: corev1.VolumeSource{
+									ConfigMap: &corev1.ConfigMapVolumeSource{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "myheader"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2,
+				Replicas:           3,
+				UpdatedReplicas:    1,
+				AvailableReplicas:  2,
+			},
+		}
+		if rolledOut {
+			d.Status.Replicas = 2
+			d.Status.UpdatedReplicas = 2
+			d.Status.AvailableReplicas = 2
+		}
+		return d
+	}
+	runBeforeRollout := func(t *testing.T, image string) bool {
+		migrated, err := ensureDatabaseMigrationsForDataPlane(ctx, cl, logr.Discard(), dp, deployment(image, false),
+			k8sresources.DataPlaneMigrationPhaseBootstrap,
+			k8sresources.DataPlaneMigrationPhaseUp,
+		)
+		require.NoError(t, err)
+		return migrated
+	}
+
+	t.Log("bootstrap and up migrations run in order before the rollout")
+	require.False(t, runBeforeRollout(t, oldImage))
+	jobs := listJobs(t)
+	require.Len(t, jobs, 1)
+	require.Equal(t, string(k8sresources.DataPlaneMigrationPhaseBootstrap), jobs[0].Labels[consts.DataPlaneMigrationPhaseLabel])
+	proxy := deployment(oldImage, false).Spec.Template.Spec
+	migrations := jobs[0].Spec.Template.Spec.Containers[0]
+	require.Equal(t, []string{"kong", "migrations", "bootstrap"}, migrations.Command)
+	require.Equal(t, oldImage, migrations.Image)
+	require.Equal(t, proxy.Containers[0].Env, migrations.Env)
+	require.Equal(t, proxy.Containers[0].VolumeMounts, migrations.VolumeMounts)
+	require.Equal(t, proxy.Volumes, jobs[0].Spec.Template.Spec.Volumes)
+	require.NotContains(t, jobs[0].Spec.Template.Labels, "app")
+	requireCondition(t, metav1.ConditionFalse, consts.DataPlaneConditionReasonMigrationsInProgress)
+
+	setJobCondition(t, k8sresources.DataPlaneMigrationPhaseBootstrap, oldImage, batchv1.JobComplete)
+	require.False(t, runBeforeRollout(t, oldImage))
+	require.Len(t, listJobs(t), 2)
+	setJobCondition(t, k8sresources.DataPlaneMigrationPhaseUp, oldImage, batchv1.JobComplete)
+	require.True(t, runBeforeRollout(t, oldImage))
+
+	t.Log("finish migrations wait for the rollout")
+	require.NoError(t, ensureDatabaseMigrationsFinishedForDataPlane(ctx, cl, logr.Discard(), dp, deployment(oldImage, false)))
+	require.Len(t, listJobs(t), 2)
+	requireCondition(t, metav1.ConditionFalse, consts.DataPlaneConditionReasonMigrationsInProgress)
+
+	require.NoError(t, ensureDatabaseMigrationsFinishedForDataPlane(ctx, cl, logr.Discard(), dp, deployment(oldImage, true)))
+	require.Len(t, listJobs(t), 3)
+	setJobCondition(t, k8sresources.DataPlaneMigrationPhaseFinish, oldImage, batchv1.JobComplete)
+	require.NoError(t, ensureDatabaseMigrationsFinishedForDataPlane(ctx, cl, logr.Discard(), dp, deployment(oldImage, true)))
+	requireCondition(t, metav1.ConditionTrue, consts.DataPlaneConditionReasonMigrationsCompleted)
+
+	t.Log("failed migrations of a new image are reported")
+	require.False(t, runBeforeRollout(t, newImage))
+	setJobCondition(t, k8sresources.DataPlaneMigrationPhaseBootstrap, newImage, batchv1.JobFailed)
+	require.False(t, runBeforeRollout(t, newImage))
+	requireCondition(t, metav1.ConditionFalse, consts.DataPlaneConditionReasonMigrationsFailed)
+
+	t.Log("jobs of previous images are deleted once the new image migrations completed")
+	job, err := getMigrationJobForDataPlane(ctx, cl, dp, newImage, k8sresources.DataPlaneMigrationPhaseBootstrap)
+	require.NoError(t, err)
+	require.NoError(t, cl.Delete(ctx, job))
+	require.False(t, runBeforeRollout(t, newImage))
+	setJobCondition(t, k8sresources.DataPlaneMigrationPhaseBootstrap, newImage, batchv1.JobComplete)
+	require.False(t, runBeforeRollout(t, newImage))
+	setJobCondition(t, k8sresources.DataPlaneMigrationPhaseUp, newImage, batchv1.JobComplete)
+	require.True(t, runBeforeRollout(t, newImage))
+	require.NoError(t, ensureDatabaseMigrationsFinishedForDataPlane(ctx, cl, logr.Discard(), dp, deployment(newImage, true)))
+	setJobCondition(t, k8sresources.DataPlaneMigrationPhaseFinish, newImage, batchv1.JobComplete)
+	require.NoError(t, ensureDatabaseMigrationsFinishedForDataPlane(ctx, cl, logr.Discard(), dp, deployment(newImage, true)))
+	requireCondition(t, metav1.ConditionTrue, consts.DataPlaneConditionReasonMigrationsCompleted)
+	jobs = listJobs(t)
+	require.Len(t, jobs, 3)
+	for _, job := range jobs {
+		require.Equal(t, newImage, job.Annotations[consts.AnnotationDataPlaneMigrationImage])
+	}
+
+	t.Log("jobs and condition are removed when the database is unset")
+	dp.Spec.Database = nil
+	require.NoError(t, ensureNoDatabaseMigrationsForDataPlane(ctx, cl, logr.Discard(), dp))
+	require.Empty(t, listJobs(t))
+	current := &operatorv1beta1.DataPlane{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+	_, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeDatabaseMigrated, current)
+	require.False(t, ok)
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
+	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
@@ -20,6 +23,8 @@ import (
 
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/controller/pkg/dataplane"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/controller/pkg/monitoring"
 	"github.com/kong/gateway-operator/controller/pkg/op"
 	"github.com/kong/gateway-operator/controller/pkg/patch"
 	"github.com/kong/gateway-operator/controller/pkg/secrets"
@@ -104,6 +109,16 @@ func ensureHPAForDataPlane(
 			updated = true
 		}
 
+		// ensure that the fields set by the user patches are up to date
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingHPA, generatedHPA,
+			operatorv1beta1.ResourcePatchTargetKindHorizontalPodAutoscaler, dataplane.Spec.Patches)
+		if err != nil {
+			return op.Noop, nil, err
+		}
+		if patched {
+			updated = true
+		}
+
 		return patch.ApplyPatchIfNonEmpty(ctx, cl, log, existingHPA, oldExistingHPA, dataplane, updated)
 	}
 
@@ -114,6 +129,171 @@ func ensureHPAForDataPlane(
 	return op.Created, nil, nil
 }
 
+// ensureScaledObjectForDataPlane ensures that a KEDA ScaledObject exists for the
+// given DataPlane when event-driven scaling is configured and that it's deleted
+// otherwise. When the KEDA CRDs are not installed in the cluster, no ScaledObject
+// is created and no error is returned. The EventDrivenScaling condition is set
+// on the DataPlane to report whether the ScaledObject could be provisioned.
+func ensureScaledObjectForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	deploymentName string,
+) (op.Result, error) {
+	crdExists, err := k8sutils.CRDChecker{Client: cl}.CRDExists(k8sresources.ScaledObjectGVR())
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed checking if ScaledObject CRD exists: %w", err)
+	}
+	scaling := dataplane.Spec.Deployment.DeploymentOptions.Scaling
+	if !crdExists {
+		if scaling != nil && scaling.EventDrivenScaling != nil {
+			log.Info(logger, "KEDA CRDs not installed, skipping ScaledObject creation", dataplane)
+		}
+		return op.Noop, ensureEventDrivenScalingStatus(ctx, cl, logger, dataplane, false, nil)
+	}
+
+	matchingLabels := k8sresources.GetManagedLabelForOwner(dataplane)
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(k8sresources.KEDAGroupVersion.WithKind(k8sresources.ScaledObjectKind + "List"))
+	if err := cl.List(ctx, list, client.InNamespace(dataplane.Namespace), matchingLabels); err != nil {
+		return op.Noop, fmt.Errorf("failed listing ScaledObjects for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+	scaledObjects := lo.Filter(list.Items, func(so unstructured.Unstructured, _ int) bool {
+		return k8sutils.IsOwnedByRefUID(&so, dataplane.UID)
+	})
+
+	if scaling == nil || scaling.EventDrivenScaling == nil {
+		if err := ensureEventDrivenScalingStatus(ctx, cl, logger, dataplane, true, nil); err != nil {
+			return op.Noop, err
+		}
+		if len(scaledObjects) == 0 {
+			return op.Noop, nil
+		}
+		if err := k8sreduce.ReduceScaledObjects(ctx, cl, scaledObjects, k8sreduce.FilterNone); err != nil {
+			return op.Noop, fmt.Errorf("failed deleting ScaledObjects for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+		}
+		return op.Deleted, nil
+	}
+
+	if len(scaledObjects) > 1 {
+		if err := k8sreduce.ReduceScaledObjects(ctx, cl, scaledObjects, k8sreduce.FilterScaledObjects); err != nil {
+			return op.Noop, fmt.Errorf("failed reducing ScaledObjects for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+		}
+		return op.Deleted, nil
+	}
+
+	// The condition reports the outcome of the ScaledObject's creation or update
+	// so it's only set once the operation is performed.
+	res, err := ensureScaledObject(ctx, cl, dataplane, deploymentName, scaledObjects)
+	if errStatus := ensureEventDrivenScalingStatus(ctx, cl, logger, dataplane, true, err); errStatus != nil {
+		return op.Noop, errors.Join(err, errStatus)
+	}
+	return res, err
+}
+
+// ensureScaledObject creates the KEDA ScaledObject for the given DataPlane
+// or updates the existing one, if any.
+func ensureScaledObject(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+	deploymentName string,
+	scaledObjects []unstructured.Unstructured,
+) (op.Result, error) {
+	generated, err := k8sresources.GenerateScaledObjectForDataPlane(dataplane, deploymentName)
+	if err != nil {
+		return op.Noop, err
+	}
+
+	if len(scaledObjects) == 1 {
+		var updated bool
+		existing := &scaledObjects[0]
+		if !maps.Equal(existing.GetLabels(), generated.GetLabels()) {
+			existing.SetLabels(generated.GetLabels())
+			updated = true
+		}
+		if !k8sresources.ScaledObjectSpecEqual(existing, generated) {
+			existing.Object["spec"] = generated.Object["spec"]
+			updated = true
+		}
+		if !updated {
+			return op.Noop, nil
+		}
+		if err := cl.Update(ctx, existing); err != nil {
+			return op.Noop, fmt.Errorf("failed updating ScaledObject %s: %w", existing.GetName(), err)
+		}
+		return op.Updated, nil
+	}
+
+	if err := cl.Create(ctx, generated); err != nil {
+		return op.Noop, fmt.Errorf("failed creating ScaledObject for DataPlane %s: %w", dataplane.Name, err)
+	}
+	return op.Created, nil
+}
+
+// ensureEventDrivenScalingStatus sets the EventDrivenScaling condition on the
+// DataPlane when event-driven scaling is configured and removes it otherwise.
+// As the HorizontalPodAutoscaler is removed when event-driven scaling is used,
+// the condition makes it visible that the DataPlane isn't scaled when the KEDA
+// CRDs are not installed or when the ScaledObject couldn't be provisioned,
+// as reported by provisionErr.
+func ensureEventDrivenScalingStatus(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	kedaInstalled bool,
+	provisionErr error,
+) error {
+	current := dataplane.DeepCopy()
+	scaling := dataplane.Spec.Deployment.DeploymentOptions.Scaling
+	switch {
+	case scaling == nil || scaling.EventDrivenScaling == nil:
+		k8sutils.RemoveCondition(consts.DataPlaneConditionTypeEventDrivenScaling, dataplane)
+	case !kedaInstalled:
+		k8sutils.SetCondition(
+			k8sutils.NewConditionWithGeneration(
+				consts.DataPlaneConditionTypeEventDrivenScaling,
+				metav1.ConditionFalse,
+				consts.DataPlaneConditionReasonKEDANotInstalled,
+				"KEDA CRDs are not installed, the DataPlane is not scaled automatically",
+				dataplane.Generation,
+			),
+			dataplane,
+		)
+	case provisionErr != nil:
+		k8sutils.SetCondition(
+			k8sutils.NewConditionWithGeneration(
+				consts.DataPlaneConditionTypeEventDrivenScaling,
+				metav1.ConditionFalse,
+				consts.DataPlaneConditionReasonScaledObjectNotProvisioned,
+				fmt.Sprintf("KEDA ScaledObject scaling the DataPlane couldn't be provisioned: %v", provisionErr),
+				dataplane.Generation,
+			),
+			dataplane,
+		)
+	default:
+		k8sutils.SetCondition(
+			k8sutils.NewConditionWithGeneration(
+				consts.DataPlaneConditionTypeEventDrivenScaling,
+				metav1.ConditionTrue,
+				consts.DataPlaneConditionReasonScaledObjectProvisioned,
+				"KEDA ScaledObject scaling the DataPlane is provisioned",
+				dataplane.Generation,
+			),
+			dataplane,
+		)
+	}
+	if !k8sutils.NeedsUpdate(current, dataplane) {
+		return nil
+	}
+	if _, err := patchDataPlaneStatus(ctx, cl, logger, dataplane); err != nil {
+		return fmt.Errorf("failed patching status for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+	return nil
+}
+
 func ensurePodDisruptionBudgetForDataPlane(
 	ctx context.Context,
 	cl client.Client,
@@ -160,6 +340,16 @@ func ensurePodDisruptionBudgetForDataPlane(
 			updated = true
 		}
 
+		// Ensure that the fields set by the user patches are up-to-date.
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingPDB, generatedPDB,
+			operatorv1beta1.ResourcePatchTargetKindPodDisruptionBudget, dataplane.Spec.Patches)
+		if err != nil {
+			return op.Noop, nil, err
+		}
+		if patched {
+			updated = true
+		}
+
 		return patch.ApplyPatchIfNonEmpty(ctx, cl, log, existingPDB, oldExistingPDB, dataplane, updated)
 	}
 
@@ -170,6 +360,97 @@ func ensurePodDisruptionBudgetForDataPlane(
 	return op.Created, generatedPDB, nil
 }
 
+// ensureMonitoringForDataPlane ensures the metrics Service and the ServiceMonitor
+// or PodMonitor scraping the DataPlane's status port exist when the DataPlane
+// has monitoring configured. Otherwise, they are deleted.
+func ensureMonitoringForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	log logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+) (op.Result, error) {
+	dpNn := client.ObjectKeyFromObject(dataplane)
+	matchingLabels := k8sresources.GetManagedLabelForOwner(dataplane)
+	matchingLabels[consts.DataPlaneServiceTypeLabel] = string(consts.DataPlaneMetricsServiceLabelValue)
+	services, err := k8sutils.ListServicesForOwner(ctx, cl, dataplane.Namespace, dataplane.UID, matchingLabels)
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed listing metrics Services for DataPlane %s: %w", dpNn, err)
+	}
+
+	monitorMatchingLabels := k8sresources.GetManagedLabelForOwner(dataplane)
+	monitorMatchingLabels["app"] = dataplane.Name
+
+	if dataplane.Spec.Monitoring == nil {
+		// The metrics Service is created along with the monitor so there is nothing
+		// to clean up without it. This spares listing the monitors on every reconciliation.
+		if len(services) == 0 {
+			return op.Noop, nil
+		}
+		if _, err := monitoring.DeleteMonitors(ctx, cl, dataplane, monitorMatchingLabels); err != nil {
+			return op.Noop, fmt.Errorf("failed deleting monitors for DataPlane %s: %w", dpNn, err)
+		}
+		for _, svc := range services {
+			if err := cl.Delete(ctx, &svc); client.IgnoreNotFound(err) != nil {
+				return op.Noop, fmt.Errorf("failed deleting metrics Service %s for DataPlane %s: %w", svc.Name, dpNn, err)
+			}
+		}
+		return op.Deleted, nil
+	}
+
+	if len(services) > 1 {
+		if err := k8sreduce.ReduceServices(ctx, cl, services); err != nil {
+			return op.Noop, err
+		}
+		return op.Noop, errors.New("number of DataPlane metrics Services reduced")
+	}
+
+	generatedService, err := k8sresources.GenerateNewMetricsServiceForDataPlane(dataplane,
+		k8sresources.LabelSelectorFromDataPlaneStatusSelectorServiceOpt(dataplane),
+	)
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed generating metrics Service for DataPlane %s: %w", dpNn, err)
+	}
+
+	switch len(services) {
+	case 1:
+		var updated bool
+		existingService := &services[0]
+		updated, existingService.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existingService.ObjectMeta, generatedService.ObjectMeta)
+
+		if !cmp.Equal(existingService.Spec.Selector, generatedService.Spec.Selector) {
+			existingService.Spec.Selector = generatedService.Spec.Selector
+			updated = true
+		}
+		if !cmp.Equal(existingService.Spec.Ports, generatedService.Spec.Ports) {
+			existingService.Spec.Ports = generatedService.Spec.Ports
+			updated = true
+		}
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingService, generatedService,
+			operatorv1beta1.ResourcePatchTargetKindService, dataplane.Spec.Patches)
+		if err != nil {
+			return op.Noop, err
+		}
+
+		if updated || patched {
+			if err := cl.Update(ctx, existingService); err != nil {
+				return op.Noop, fmt.Errorf("failed updating metrics Service %s for DataPlane %s: %w", existingService.Name, dpNn, err)
+			}
+			return op.Updated, nil
+		}
+	default:
+		if err := cl.Create(ctx, generatedService); err != nil {
+			return op.Noop, fmt.Errorf("failed creating metrics Service for DataPlane %s: %w", dpNn, err)
+		}
+		return op.Created, nil
+	}
+
+	return monitoring.EnsureMonitor(
+		ctx, cl, log, dataplane,
+		k8sresources.GenerateMonitorForDataPlane(dataplane, dataplane.Spec.Monitoring),
+		monitorMatchingLabels,
+	)
+}
+
 func matchingLabelsToServiceOpt(ml client.MatchingLabels) k8sresources.ServiceOpt {
 	return func(s *corev1.Service) {
 		if s.Labels == nil {
@@ -283,8 +564,13 @@ func ensureAdminServiceForDataPlane(
 			existingService.Labels = generatedService.Labels
 			updated = true
 		}
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingService, generatedService,
+			operatorv1beta1.ResourcePatchTargetKindService, dataPlane.Spec.Patches)
+		if err != nil {
+			return op.Noop, nil, err
+		}
 
-		if updated {
+		if updated || patched {
 			if err := cl.Update(ctx, existingService); err != nil {
 				return op.Noop, existingService, fmt.Errorf("failed updating DataPlane Service %s: %w", existingService.Name, err)
 			}
@@ -376,53 +662,209 @@ func ensureIngressServiceForDataPlane(
 	if err != nil {
 		return op.Noop, nil, err
 	}
-	addAnnotationsForDataPlaneIngressService(generatedService, *dataPlane)
+	specAnnotations := extractDataPlaneIngressServiceAnnotations(dataPlane)
+	addAnnotationsForDataPlaneIngressService(generatedService, specAnnotations)
 	k8sutils.SetOwnerForObject(generatedService, dataPlane)
 
 	if count == 1 {
-		var updated bool
-		existingService := &services[0]
-		updated, existingService.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existingService.ObjectMeta, generatedService.ObjectMeta,
-			// enforce all the annotations provided through the dataplane API
-			func(existingMeta metav1.ObjectMeta, generatedMeta metav1.ObjectMeta) (bool, metav1.ObjectMeta) {
-				metaToUpdate, updatedAnnotations, err := ensureDataPlaneIngressServiceAnnotationsUpdated(
-					dataPlane, existingMeta.Annotations, generatedMeta.Annotations,
-				)
-				if err != nil {
-					logger.Error(err, "failed to update annotations of existing ingress service for dataplane",
-						"dataplane", fmt.Sprintf("%s/%s", dataPlane.Namespace, dataPlane.Name),
-						"ingress_service", fmt.Sprintf("%s/%s", existingService.Namespace, existingService.Name))
-					return true, existingMeta
-				}
-				existingMeta.Annotations = updatedAnnotations
-				return metaToUpdate, existingMeta
-			})
+		return ensureIngressServiceIsUpdated(ctx, logger, cl, dataPlane, &services[0], generatedService, specAnnotations)
+	}
 
-		if existingService.Spec.Type != generatedService.Spec.Type {
-			existingService.Spec.Type = generatedService.Spec.Type
-			updated = true
+	return op.Created, generatedService, cl.Create(ctx, generatedService)
+}
+
+// ensureAdditionalIngressServicesForDataPlane ensures that an ingress Service exists
+// for each of the additional ingress Services configured in the DataPlane spec,
+// with metadata and spec generated from the respective configuration.
+// Additional ingress Services which are no longer configured are deleted.
+// The returned Services are ordered as in the DataPlane spec.
+func ensureAdditionalIngressServicesForDataPlane(
+	ctx context.Context,
+	logger logr.Logger,
+	cl client.Client,
+	dataPlane *operatorv1beta1.DataPlane,
+	opts ...k8sresources.ServiceOpt,
+) (op.Result, []corev1.Service, error) {
+	matchingLabels := k8sresources.GetManagedLabelForOwner(dataPlane)
+	matchingLabels[consts.DataPlaneServiceTypeLabel] = string(consts.DataPlaneAdditionalIngressServiceLabelValue)
+
+	services, err := k8sutils.ListServicesForOwner(
+		ctx,
+		cl,
+		dataPlane.Namespace,
+		dataPlane.UID,
+		matchingLabels,
+	)
+	if err != nil {
+		return op.Noop, nil, fmt.Errorf("failed listing Services for DataPlane %s/%s: %w", dataPlane.Namespace, dataPlane.Name, err)
+	}
+
+	var serviceOptions []operatorv1beta1.DataPlaneNamedServiceOptions
+	if dataPlane.Spec.Network.Services != nil {
+		serviceOptions = dataPlane.Spec.Network.Services.AdditionalIngresses
+	}
+
+	// Delete the Services which are no longer configured in the DataPlane spec.
+	servicesByName := lo.GroupBy(services, func(svc corev1.Service) string {
+		return svc.Labels[consts.DataPlaneIngressServiceNameLabel]
+	})
+	var deleted bool
+	for name, svcs := range servicesByName {
+		if lo.ContainsBy(serviceOptions, func(o operatorv1beta1.DataPlaneNamedServiceOptions) bool { return o.Name == name }) {
+			continue
 		}
-		if !cmp.Equal(existingService.Spec.Selector, generatedService.Spec.Selector) {
-			existingService.Spec.Selector = generatedService.Spec.Selector
-			updated = true
+		for i := range svcs {
+			if err := deleteDataPlaneOwnedService(ctx, cl, &svcs[i]); err != nil {
+				return op.Noop, nil, err
+			}
 		}
-		if !cmp.Equal(generatedService.Spec.Ports, existingService.Spec.Ports, cmp.FilterPath(func(p cmp.Path) bool {
-			// We need to check all the service values but the NodePort, as this field is assigned by
-			// the K8S controlplane components.
-			return p.Last().String() == ".NodePort"
-		}, cmp.Ignore())) {
-			existingService.Spec.Ports = generatedService.Spec.Ports
-			updated = true
+		deleted = true
+	}
+	if deleted {
+		return op.Deleted, nil, nil
+	}
+
+	result := op.Noop
+	ensured := make([]corev1.Service, 0, len(serviceOptions))
+	for _, serviceOpts := range serviceOptions {
+		existing := servicesByName[serviceOpts.Name]
+		if len(existing) > 1 {
+			if err := k8sreduce.ReduceServices(ctx, cl, existing, dataplane.OwnedObjectPreDeleteHook); err != nil {
+				return op.Noop, nil, err
+			}
+			return op.Noop, nil, fmt.Errorf("number of DataPlane additional ingress services %q reduced", serviceOpts.Name)
 		}
 
-		if updated {
-			if err := cl.Update(ctx, existingService); err != nil {
-				return op.Noop, existingService, fmt.Errorf("failed updating DataPlane Service %s: %w", existingService.Name, err)
+		generatedService, err := k8sresources.GenerateNewAdditionalIngressServiceForDataPlane(dataPlane, serviceOpts, opts...)
+		if err != nil {
+			return op.Noop, nil, err
+		}
+		addAnnotationsForDataPlaneIngressService(generatedService, serviceOpts.Annotations)
+
+		if len(existing) == 0 {
+			if err := cl.Create(ctx, generatedService); err != nil {
+				return op.Noop, nil, fmt.Errorf("failed creating additional ingress Service %q for DataPlane %s: %w", serviceOpts.Name, dataPlane.Name, err)
 			}
-			return op.Updated, existingService, nil
+			result = op.Created
+			ensured = append(ensured, *generatedService)
+			continue
+		}
+
+		res, svc, err := ensureIngressServiceIsUpdated(ctx, logger, cl, dataPlane, &existing[0], generatedService, serviceOpts.Annotations)
+		if err != nil {
+			return op.Noop, nil, err
+		}
+		if res != op.Noop {
+			result = res
+		}
+		if svc != nil {
+			ensured = append(ensured, *svc)
 		}
-		return op.Noop, existingService, nil
 	}
 
-	return op.Created, generatedService, cl.Create(ctx, generatedService)
+	return result, ensured, nil
+}
+
+// ensureIngressServiceIsUpdated updates the existing ingress Service so that it
+// matches the generated one. When the existing Service cannot be updated in place
+// (e.g. its load balancer class has changed), it gets deleted so that it can be
+// recreated in the next reconciliation.
+func ensureIngressServiceIsUpdated(
+	ctx context.Context,
+	logger logr.Logger,
+	cl client.Client,
+	dataPlane *operatorv1beta1.DataPlane,
+	existingService *corev1.Service,
+	generatedService *corev1.Service,
+	specAnnotations map[string]string,
+) (op.Result, *corev1.Service, error) {
+	if ingressServiceNeedsRecreation(existingService, generatedService) {
+		if err := deleteDataPlaneOwnedService(ctx, cl, existingService); err != nil {
+			return op.Noop, nil, err
+		}
+		return op.Deleted, nil, nil
+	}
+
+	var updated bool
+	updated, existingService.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existingService.ObjectMeta, generatedService.ObjectMeta,
+		// enforce all the annotations provided through the dataplane API
+		func(existingMeta metav1.ObjectMeta, generatedMeta metav1.ObjectMeta) (bool, metav1.ObjectMeta) {
+			metaToUpdate, updatedAnnotations, err := ensureDataPlaneIngressServiceAnnotationsUpdated(
+				specAnnotations, existingMeta.Annotations, generatedMeta.Annotations,
+			)
+			if err != nil {
+				logger.Error(err, "failed to update annotations of existing ingress service for dataplane",
+					"dataplane", fmt.Sprintf("%s/%s", dataPlane.Namespace, dataPlane.Name),
+					"ingress_service", fmt.Sprintf("%s/%s", existingService.Namespace, existingService.Name))
+				return true, existingMeta
+			}
+			existingMeta.Annotations = updatedAnnotations
+			return metaToUpdate, existingMeta
+		})
+
+	if existingService.Spec.Type != generatedService.Spec.Type {
+		existingService.Spec.Type = generatedService.Spec.Type
+		updated = true
+	}
+	// Enforce the load balancer class only when it's set in the spec. Otherwise
+	// it's assigned by the cluster (e.g. by a load balancer controller) and left as is.
+	if generatedService.Spec.LoadBalancerClass != nil &&
+		!cmp.Equal(existingService.Spec.LoadBalancerClass, generatedService.Spec.LoadBalancerClass) {
+		existingService.Spec.LoadBalancerClass = generatedService.Spec.LoadBalancerClass
+		updated = true
+	}
+	if existingService.Spec.Type == corev1.ServiceTypeLoadBalancer &&
+		generatedService.Spec.ExternalTrafficPolicy != "" &&
+		existingService.Spec.ExternalTrafficPolicy != generatedService.Spec.ExternalTrafficPolicy {
+		existingService.Spec.ExternalTrafficPolicy = generatedService.Spec.ExternalTrafficPolicy
+		updated = true
+	}
+	if !cmp.Equal(existingService.Spec.Selector, generatedService.Spec.Selector) {
+		existingService.Spec.Selector = generatedService.Spec.Selector
+		updated = true
+	}
+	if !cmp.Equal(generatedService.Spec.Ports, existingService.Spec.Ports, cmp.FilterPath(func(p cmp.Path) bool {
+		// We need to check all the service values but the NodePort, as this field is assigned by
+		// the K8S controlplane components.
+		return p.Last().String() == ".NodePort"
+	}, cmp.Ignore())) {
+		existingService.Spec.Ports = generatedService.Spec.Ports
+		updated = true
+	}
+	patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingService, generatedService,
+		operatorv1beta1.ResourcePatchTargetKindService, dataPlane.Spec.Patches)
+	if err != nil {
+		return op.Noop, nil, err
+	}
+
+	if updated || patched {
+		if err := cl.Update(ctx, existingService); err != nil {
+			return op.Noop, existingService, fmt.Errorf("failed updating DataPlane Service %s: %w", existingService.Name, err)
+		}
+		return op.Updated, existingService, nil
+	}
+	return op.Noop, existingService, nil
+}
+
+// ingressServiceNeedsRecreation returns true when the load balancer class set in
+// the spec differs from the existing Service's one while both are of type LoadBalancer.
+// Kubernetes doesn't allow to change the field in that case.
+// Services without a load balancer class set in the spec never need recreation.
+func ingressServiceNeedsRecreation(existingService, generatedService *corev1.Service) bool {
+	return existingService.Spec.Type == corev1.ServiceTypeLoadBalancer &&
+		generatedService.Spec.Type == corev1.ServiceTypeLoadBalancer &&
+		generatedService.Spec.LoadBalancerClass != nil &&
+		!cmp.Equal(existingService.Spec.LoadBalancerClass, generatedService.Spec.LoadBalancerClass)
+}
+
+// deleteDataPlaneOwnedService removes the DataPlane owned finalizer from the
+// provided Service and deletes it.
+func deleteDataPlaneOwnedService(ctx context.Context, cl client.Client, svc *corev1.Service) error {
+	if err := dataplane.OwnedObjectPreDeleteHook(ctx, cl, svc); err != nil {
+		return err
+	}
+	if err := cl.Delete(ctx, svc); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting DataPlane Service %s: %w", svc.Name, err)
+	}
+	return nil
 }
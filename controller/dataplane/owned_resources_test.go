@@ -2,16 +2,21 @@ package dataplane
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/go-logr/logr"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/controller/pkg/builder"
@@ -33,6 +38,7 @@ func TestEnsureIngressServiceForDataPlane(t *testing.T) {
 		expectedServicePorts     []corev1.ServicePort
 		expectedAnnotations      map[string]string
 		expectedLabels           map[string]string
+		expectedSessionAffinity  corev1.ServiceAffinity
 	}{
 		{
 			name: "should create a new service if service does not exist",
@@ -58,6 +64,20 @@ func TestEnsureIngressServiceForDataPlane(t *testing.T) {
 			expectedServiceType:      corev1.ServiceTypeLoadBalancer,
 			expectedServicePorts:     k8sresources.DefaultDataPlaneIngressServicePorts,
 		},
+		{
+			name: "should keep the load balancer class assigned by the cluster when it's not set in the spec",
+			dataplane: builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "dp-1",
+			}).WithIngressServiceType(corev1.ServiceTypeLoadBalancer).Build(),
+			existingServiceModifier: func(t *testing.T, ctx context.Context, c client.Client, svc *corev1.Service) {
+				svc.Spec.LoadBalancerClass = lo.ToPtr("service.k8s.aws/nlb")
+				require.NoError(t, c.Update(ctx, svc))
+			},
+			expectedCreatedOrUpdated: op.Noop,
+			expectedServiceType:      corev1.ServiceTypeLoadBalancer,
+			expectedServicePorts:     k8sresources.DefaultDataPlaneIngressServicePorts,
+		},
 		{
 			name: "should add annotations to existing service",
 			dataplane: builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
@@ -105,6 +125,52 @@ func TestEnsureIngressServiceForDataPlane(t *testing.T) {
 				consts.AnnotationLastAppliedAnnotations: `{"foo":"bar"}`,
 			},
 		},
+		{
+			name: "should enforce fields set by patches on existing service",
+			dataplane: func() *operatorv1beta1.DataPlane {
+				dp := builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "dp-1",
+				}).WithIngressServiceType(corev1.ServiceTypeLoadBalancer).Build()
+				dp.Spec.Patches = []operatorv1beta1.ResourcePatch{
+					{
+						Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+						Type:   operatorv1beta1.ResourcePatchTypeStrategicMerge,
+						Patch:  "spec:\n  sessionAffinity: ClientIP\n",
+					},
+				}
+				return dp
+			}(),
+			existingServiceModifier: func(t *testing.T, ctx context.Context, c client.Client, svc *corev1.Service) {
+				svc.Spec.SessionAffinity = corev1.ServiceAffinityNone
+				require.NoError(t, c.Update(ctx, svc))
+			},
+			expectedCreatedOrUpdated: op.Updated,
+			expectedServiceType:      corev1.ServiceTypeLoadBalancer,
+			expectedServicePorts:     k8sresources.DefaultDataPlaneIngressServicePorts,
+			expectedSessionAffinity:  corev1.ServiceAffinityClientIP,
+		},
+		{
+			name: "should not update service with fields set by patches up to date",
+			dataplane: func() *operatorv1beta1.DataPlane {
+				dp := builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "dp-1",
+				}).WithIngressServiceType(corev1.ServiceTypeLoadBalancer).Build()
+				dp.Spec.Patches = []operatorv1beta1.ResourcePatch{
+					{
+						Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+						Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+						Patch:  `[{"op": "add", "path": "/spec/sessionAffinity", "value": "ClientIP"}]`,
+					},
+				}
+				return dp
+			}(),
+			expectedCreatedOrUpdated: op.Noop,
+			expectedServiceType:      corev1.ServiceTypeLoadBalancer,
+			expectedServicePorts:     k8sresources.DefaultDataPlaneIngressServicePorts,
+			expectedSessionAffinity:  corev1.ServiceAffinityClientIP,
+		},
 		{
 			name:             "should create service when service does not contain additional labels",
 			additionalLabels: map[string]string{"foo": "bar"},
@@ -188,6 +254,372 @@ func TestEnsureIngressServiceForDataPlane(t *testing.T) {
 				actualValue := svc.Labels[k]
 				require.Equalf(t, v, actualValue, "should have label %s:%s in service", k, v)
 			}
+			// check service session affinity.
+			if tc.expectedSessionAffinity != "" {
+				require.Equal(t, tc.expectedSessionAffinity, svc.Spec.SessionAffinity, "should have the same session affinity")
+			}
+		})
+	}
+}
+
+func TestEnsureAdditionalIngressServicesForDataPlane(t *testing.T) {
+	dataplaneWithAdditionalIngresses := func(additional ...operatorv1beta1.DataPlaneNamedServiceOptions) *operatorv1beta1.DataPlane {
+		dp := builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "dp-1",
+		}).WithIngressServiceType(corev1.ServiceTypeLoadBalancer).Build()
+		dp.Spec.Network.Services.AdditionalIngresses = additional
+		return dp
+	}
+	internal := operatorv1beta1.DataPlaneNamedServiceOptions{
+		Name: "internal",
+		DataPlaneServiceOptions: operatorv1beta1.DataPlaneServiceOptions{
+			ServiceOptions: operatorv1beta1.ServiceOptions{
+				Type: corev1.ServiceTypeClusterIP,
+			},
+		},
+	}
+	public := operatorv1beta1.DataPlaneNamedServiceOptions{
+		Name: "public",
+		DataPlaneServiceOptions: operatorv1beta1.DataPlaneServiceOptions{
+			ServiceOptions: operatorv1beta1.ServiceOptions{
+				Type:              corev1.ServiceTypeLoadBalancer,
+				LoadBalancerClass: lo.ToPtr("service.k8s.aws/nlb"),
+				Annotations:       map[string]string{"foo": "bar"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		dataplane        *operatorv1beta1.DataPlane
+		existingServices []operatorv1beta1.DataPlaneNamedServiceOptions
+		existingModifier func(*corev1.Service)
+		expectedResult   op.Result
+		expectedServices []string
+	}{
+		{
+			name:             "no additional ingress services configured",
+			dataplane:        dataplaneWithAdditionalIngresses(),
+			expectedResult:   op.Noop,
+			expectedServices: []string{},
+		},
+		{
+			name:             "creates services for configured additional ingresses",
+			dataplane:        dataplaneWithAdditionalIngresses(public, internal),
+			expectedResult:   op.Created,
+			expectedServices: []string{"public", "internal"},
+		},
+		{
+			name:             "no changes when services are up to date",
+			dataplane:        dataplaneWithAdditionalIngresses(public, internal),
+			existingServices: []operatorv1beta1.DataPlaneNamedServiceOptions{public, internal},
+			expectedResult:   op.Noop,
+			expectedServices: []string{"public", "internal"},
+		},
+		{
+			name:             "deletes services which are no longer configured",
+			dataplane:        dataplaneWithAdditionalIngresses(internal),
+			existingServices: []operatorv1beta1.DataPlaneNamedServiceOptions{public, internal},
+			expectedResult:   op.Deleted,
+			expectedServices: []string{"internal"},
+		},
+		{
+			name:             "updates the service type",
+			dataplane:        dataplaneWithAdditionalIngresses(internal),
+			existingServices: []operatorv1beta1.DataPlaneNamedServiceOptions{internal},
+			existingModifier: func(svc *corev1.Service) {
+				svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+			},
+			expectedResult:   op.Updated,
+			expectedServices: []string{"internal"},
+		},
+		{
+			name:             "recreates the service when load balancer class changes",
+			dataplane:        dataplaneWithAdditionalIngresses(public),
+			existingServices: []operatorv1beta1.DataPlaneNamedServiceOptions{public},
+			existingModifier: func(svc *corev1.Service) {
+				svc.Spec.LoadBalancerClass = lo.ToPtr("other")
+			},
+			expectedResult:   op.Deleted,
+			expectedServices: []string{},
+		},
+		{
+			name: "keeps the load balancer class assigned by the cluster when it's not set in the spec",
+			dataplane: dataplaneWithAdditionalIngresses(operatorv1beta1.DataPlaneNamedServiceOptions{
+				Name: "public",
+				DataPlaneServiceOptions: operatorv1beta1.DataPlaneServiceOptions{
+					ServiceOptions: operatorv1beta1.ServiceOptions{
+						Type: corev1.ServiceTypeLoadBalancer,
+					},
+				},
+			}),
+			existingServices: []operatorv1beta1.DataPlaneNamedServiceOptions{{
+				Name: "public",
+				DataPlaneServiceOptions: operatorv1beta1.DataPlaneServiceOptions{
+					ServiceOptions: operatorv1beta1.ServiceOptions{
+						Type: corev1.ServiceTypeLoadBalancer,
+					},
+				},
+			}},
+			existingModifier: func(svc *corev1.Service) {
+				svc.Spec.LoadBalancerClass = lo.ToPtr("service.k8s.aws/nlb")
+			},
+			expectedResult:   op.Noop,
+			expectedServices: []string{"public"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			fakeClient := fakectrlruntimeclient.
+				NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				Build()
+			require.NoError(t, fakeClient.Create(ctx, tc.dataplane))
+
+			for _, serviceOpts := range tc.existingServices {
+				existingSvc, err := k8sresources.GenerateNewAdditionalIngressServiceForDataPlane(tc.dataplane, serviceOpts)
+				require.NoError(t, err)
+				addAnnotationsForDataPlaneIngressService(existingSvc, serviceOpts.Annotations)
+				if tc.existingModifier != nil {
+					tc.existingModifier(existingSvc)
+				}
+				require.NoError(t, fakeClient.Create(ctx, existingSvc))
+			}
+
+			res, _, err := ensureAdditionalIngressServicesForDataPlane(ctx, logr.Discard(), fakeClient, tc.dataplane)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, res)
+
+			services, err := k8sutils.ListServicesForOwner(ctx, fakeClient, tc.dataplane.Namespace, tc.dataplane.UID,
+				client.MatchingLabels{
+					consts.DataPlaneServiceTypeLabel: string(consts.DataPlaneAdditionalIngressServiceLabelValue),
+				},
+			)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedServices, lo.Map(services, func(svc corev1.Service, _ int) string {
+				return svc.Labels[consts.DataPlaneIngressServiceNameLabel]
+			}))
+			for _, svc := range services {
+				serviceOpts, ok := lo.Find(tc.dataplane.Spec.Network.Services.AdditionalIngresses,
+					func(o operatorv1beta1.DataPlaneNamedServiceOptions) bool {
+						return o.Name == svc.Labels[consts.DataPlaneIngressServiceNameLabel]
+					},
+				)
+				require.True(t, ok)
+				require.Equal(t, serviceOpts.Type, svc.Spec.Type)
+				for k, v := range serviceOpts.Annotations {
+					require.Equal(t, v, svc.Annotations[k])
+				}
+			}
+		})
+	}
+}
+
+func TestEnsureMonitoringForDataPlane(t *testing.T) {
+	ctx := context.Background()
+	dp := builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      "dp-1",
+		UID:       "dp-uid",
+	}).Build()
+	dp.Status.Selector = "dp-selector"
+	fakeClient := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(dp).
+		Build()
+
+	listMetricsServices := func(t *testing.T) []corev1.Service {
+		services, err := k8sutils.ListServicesForOwner(ctx, fakeClient, dp.Namespace, dp.UID, client.MatchingLabels{
+			consts.DataPlaneServiceTypeLabel: string(consts.DataPlaneMetricsServiceLabelValue),
 		})
+		require.NoError(t, err)
+		return services
 	}
+
+	res, err := ensureMonitoringForDataPlane(ctx, fakeClient, logr.Discard(), dp)
+	require.NoError(t, err)
+	require.Equal(t, op.Noop, res)
+	require.Empty(t, listMetricsServices(t))
+
+	// The metrics Service is created even though the monitoring.coreos.com CRDs
+	// are not installed and the monitor is skipped.
+	dp.Spec.Monitoring = &operatorv1beta1.MonitoringOptions{}
+	res, err = ensureMonitoringForDataPlane(ctx, fakeClient, logr.Discard(), dp)
+	require.NoError(t, err)
+	require.Equal(t, op.Created, res)
+	services := listMetricsServices(t)
+	require.Len(t, services, 1)
+	require.Equal(t, []corev1.ServicePort{
+		{
+			Name:       "metrics",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.DataPlaneMetricsPort,
+			TargetPort: intstr.FromInt(consts.DataPlaneMetricsPort),
+		},
+	}, services[0].Spec.Ports)
+	require.Equal(t, map[string]string{
+		"app":                        dp.Name,
+		consts.OperatorLabelSelector: "dp-selector",
+	}, services[0].Spec.Selector)
+
+	res, err = ensureMonitoringForDataPlane(ctx, fakeClient, logr.Discard(), dp)
+	require.NoError(t, err)
+	require.Equal(t, op.Noop, res)
+
+	dp.Spec.Monitoring = nil
+	res, err = ensureMonitoringForDataPlane(ctx, fakeClient, logr.Discard(), dp)
+	require.NoError(t, err)
+	require.Equal(t, op.Deleted, res)
+	require.Empty(t, listMetricsServices(t))
+}
+
+func TestEnsureScaledObjectForDataPlane(t *testing.T) {
+	ctx := context.Background()
+	dp := builder.NewDataPlaneBuilder().WithObjectMeta(metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      "dp-1",
+		UID:       "dp-uid",
+	}).Build()
+	newClient := func(withKEDA bool) client.Client {
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		if withKEDA {
+			restMapper.Add(k8sresources.KEDAGroupVersion.WithKind(k8sresources.ScaledObjectKind), meta.RESTScopeNamespace)
+		}
+		return fakectrlruntimeclient.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithRESTMapper(restMapper).
+			WithObjects(dp).
+			WithStatusSubresource(dp).
+			Build()
+	}
+	listScaledObjects := func(t *testing.T, cl client.Client) []unstructured.Unstructured {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(k8sresources.KEDAGroupVersion.WithKind(k8sresources.ScaledObjectKind + "List"))
+		require.NoError(t, cl.List(ctx, list, client.InNamespace(dp.Namespace)))
+		return list.Items
+	}
+	eventDriven := &operatorv1beta1.EventDrivenScaling{
+		MinReplicas: lo.ToPtr(int32(1)),
+		MaxReplicas: 5,
+		Triggers: []operatorv1beta1.EventDrivenScalingTrigger{
+			{
+				Type:          operatorv1beta1.EventDrivenScalingTriggerTypeRequestRate,
+				ServerAddress: "http://prometheus.monitoring:9090",
+				Threshold:     "100",
+			},
+		},
+	}
+
+	t.Run("ScaledObject is created, updated and deleted", func(t *testing.T) {
+		cl := newClient(true)
+		dp := dp.DeepCopy()
+
+		res, err := ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+		require.Equal(t, op.Noop, res)
+		require.Empty(t, listScaledObjects(t, cl))
+
+		dp.Spec.Deployment.Scaling = &operatorv1beta1.Scaling{EventDrivenScaling: eventDriven.DeepCopy()}
+		require.NoError(t, cl.Update(ctx, dp))
+		res, err = ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+		require.Equal(t, op.Created, res)
+		require.Len(t, listScaledObjects(t, cl), 1)
+		require.True(t, k8sutils.IsConditionTrue(consts.DataPlaneConditionTypeEventDrivenScaling, dp))
+
+		res, err = ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+		require.Equal(t, op.Noop, res)
+
+		dp.Spec.Deployment.Scaling.EventDrivenScaling.MaxReplicas = 10
+		require.NoError(t, cl.Update(ctx, dp))
+		res, err = ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+		require.Equal(t, op.Updated, res)
+		scaledObjects := listScaledObjects(t, cl)
+		require.Len(t, scaledObjects, 1)
+		maxReplicas, _, err := unstructured.NestedInt64(scaledObjects[0].Object, "spec", "maxReplicaCount")
+		require.NoError(t, err)
+		require.Equal(t, int64(10), maxReplicas)
+
+		dp.Spec.Deployment.Scaling = nil
+		require.NoError(t, cl.Update(ctx, dp))
+		res, err = ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+		require.Equal(t, op.Deleted, res)
+		require.Empty(t, listScaledObjects(t, cl))
+	})
+
+	t.Run("ScaledObject creation failure is reported in the status", func(t *testing.T) {
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		restMapper.Add(k8sresources.KEDAGroupVersion.WithKind(k8sresources.ScaledObjectKind), meta.RESTScopeNamespace)
+		failCreate := true
+		cl := fakectrlruntimeclient.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithRESTMapper(restMapper).
+			WithObjects(dp).
+			WithStatusSubresource(dp).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if failCreate && obj.GetObjectKind().GroupVersionKind().Kind == k8sresources.ScaledObjectKind {
+						return errors.New("create failed")
+					}
+					return cl.Create(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		dp := dp.DeepCopy()
+		dp.Spec.Deployment.Scaling = &operatorv1beta1.Scaling{EventDrivenScaling: eventDriven.DeepCopy()}
+		require.NoError(t, cl.Update(ctx, dp))
+
+		res, err := ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.ErrorContains(t, err, "create failed")
+		require.Equal(t, op.Noop, res)
+		require.Empty(t, listScaledObjects(t, cl))
+
+		current := &operatorv1beta1.DataPlane{}
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+		c, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeEventDrivenScaling, current)
+		require.True(t, ok)
+		require.Equal(t, metav1.ConditionFalse, c.Status)
+		require.Equal(t, string(consts.DataPlaneConditionReasonScaledObjectNotProvisioned), c.Reason)
+		require.Contains(t, c.Message, "create failed")
+
+		failCreate = false
+		res, err = ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+		require.Equal(t, op.Created, res)
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+		require.True(t, k8sutils.IsConditionTrue(consts.DataPlaneConditionTypeEventDrivenScaling, current))
+	})
+
+	t.Run("ScaledObject is skipped when KEDA CRDs are not installed", func(t *testing.T) {
+		cl := newClient(false)
+		dp := dp.DeepCopy()
+		dp.Spec.Deployment.Scaling = &operatorv1beta1.Scaling{EventDrivenScaling: eventDriven.DeepCopy()}
+		require.NoError(t, cl.Update(ctx, dp))
+
+		res, err := ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+		require.Equal(t, op.Noop, res)
+		require.Empty(t, listScaledObjects(t, cl))
+
+		current := &operatorv1beta1.DataPlane{}
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+		c, ok := k8sutils.GetCondition(consts.DataPlaneConditionTypeEventDrivenScaling, current)
+		require.True(t, ok)
+		require.Equal(t, metav1.ConditionFalse, c.Status)
+		require.Equal(t, string(consts.DataPlaneConditionReasonKEDANotInstalled), c.Reason)
+
+		dp.Spec.Deployment.Scaling = nil
+		require.NoError(t, cl.Update(ctx, dp))
+		_, err = ensureScaledObjectForDataPlane(ctx, cl, logr.Discard(), dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(dp), current))
+		_, ok = k8sutils.GetCondition(consts.DataPlaneConditionTypeEventDrivenScaling, current)
+		require.False(t, ok)
+	})
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -41,6 +42,8 @@ func DataPlaneWatchBuilder(mgr ctrl.Manager) *builder.Builder {
 		Owns(&policyv1.PodDisruptionBudget{}).
 		// Watch for changes in ConfigMaps created by the dataplane controller.
 		Owns(&corev1.ConfigMap{}).
+		// Watch for changes in database migrations Jobs created by the dataplane controller.
+		Owns(&batchv1.Job{}).
 		// Watch for changes in ConfigMaps that are mapped to KongPluginInstallation objects.
 		// They may trigger reconciliation of DataPlane resources.
 		WatchesRawSource(
@@ -56,6 +59,15 @@ func DataPlaneWatchBuilder(mgr ctrl.Manager) *builder.Builder {
 				&operatorv1alpha1.KonnectExtension{},
 				handler.TypedEnqueueRequestsFromMapFunc(listDataPlanesReferencingKonnectExtension(mgr.GetClient())),
 			),
+		).
+		// Watch for changes in cluster Services of control plane DataPlanes.
+		// They may trigger reconciliation of the DataPlanes connecting to them.
+		WatchesRawSource(
+			source.Kind(
+				mgr.GetCache(),
+				&corev1.Service{},
+				handler.TypedEnqueueRequestsFromMapFunc(listDataPlanesReferencingHybridControlPlane(mgr.GetClient())),
+			),
 		)
 }
 
@@ -112,3 +124,37 @@ func listDataPlanesReferencingKonnectExtension(
 		})
 	}
 }
+
+func listDataPlanesReferencingHybridControlPlane(
+	c client.Client,
+) handler.TypedMapFunc[*corev1.Service, reconcile.Request] {
+	return func(
+		ctx context.Context, svc *corev1.Service,
+	) []reconcile.Request {
+		logger := ctrllog.FromContext(ctx)
+
+		if svc.Labels[consts.DataPlaneServiceTypeLabel] != string(consts.DataPlaneClusterServiceLabelValue) {
+			return nil
+		}
+		// The cluster Service is labeled with the name of the control plane DataPlane owning it.
+		controlPlaneName := svc.Labels["app"]
+		if controlPlaneName == "" {
+			return nil
+		}
+
+		// Find all DataPlane resources connecting to the control plane DataPlane
+		// owning the cluster Service enqueued for reconciliation.
+		var dataPlaneList operatorv1beta1.DataPlaneList
+		if err := c.List(ctx, &dataPlaneList, client.MatchingFields{
+			index.HybridControlPlaneIndex: svc.Namespace + "/" + controlPlaneName,
+		}); err != nil {
+			logger.Error(err, "Failed to list DataPlanes in watch", "DataPlane", controlPlaneName)
+			return nil
+		}
+		return lo.Map(dataPlaneList.Items, func(dp operatorv1beta1.DataPlane, _ int) reconcile.Request {
+			return reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(&dp),
+			}
+		})
+	}
+}
@@ -71,6 +71,25 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 		Owns(&operatorv1beta1.ControlPlane{}).
 		// watch for changes in networkpolicies created by the gateway controller
 		Owns(&networkingv1.NetworkPolicy{}).
+		// watch for changes in dataplanes, controlplanes and networkpolicies shared
+		// by multiple gateways, these are owned by the gateways' GatewayClass.
+		Watches(
+			&operatorv1beta1.DataPlane{},
+			handler.EnqueueRequestsFromMapFunc(r.listGatewaysForSharedObject)).
+		Watches(
+			&operatorv1beta1.ControlPlane{},
+			handler.EnqueueRequestsFromMapFunc(r.listGatewaysForSharedObject)).
+		Watches(
+			&networkingv1.NetworkPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.listGatewaysForSharedObject)).
+		// watch for spec changes and deletions of gateways which other gateways
+		// depend on: the listeners exposed by a shared dataplane depend on all the
+		// merged gateways and the guardrails limiting the number of gateways per
+		// namespace depend on all the gateways in that namespace.
+		Watches(
+			&gwtypes.Gateway{},
+			handler.EnqueueRequestsFromMapFunc(r.listRelatedGatewaysForGateway),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		// watch for updates to GatewayConfigurations, if any configuration targets a
 		// Gateway that is supported, enqueue that Gateway.
 		Watches(
@@ -94,6 +113,10 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 		Watches(
 			&gatewayv1beta1.HTTPRoute{},
 			handler.EnqueueRequestsFromMapFunc(r.listGatewaysAttachedByHTTPRoute)).
+		// watch GRPCRoutes so that Gateway listener status can be updated.
+		Watches(
+			&gatewayv1.GRPCRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.listGatewaysAttachedByGRPCRoute)).
 		// watch Namespaces so that managed routes have correct status reflected in Gateway's
 		// status in status.listeners.attachedRoutes
 		// This is required to properly support Gateway's listeners.allowedRoutes.namespaces.selector.
@@ -113,6 +136,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if k8sutils.IsReconciliationPaused(&gateway) {
+		return r.reconcilePaused(ctx, logger, &gateway)
+	}
+
 	log.Trace(logger, "managing cleanup for gateway resource", gateway)
 	if shouldReturnEarly, result, err := r.cleanup(ctx, logger, &gateway); err != nil || !result.IsZero() {
 		return result, err
@@ -152,11 +179,60 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	if oldGateway := gateway.DeepCopy(); k8sutils.RemoveCondition(consts.PausedType, gatewayConditionsAndListenersAware(&gateway)) {
+		// Status update will trigger reconciliation.
+		_, err := patch.ApplyGatewayStatusPatchIfNotEmpty(ctx, r.Client, logger, &gateway, oldGateway)
+		return ctrl.Result{}, err
+	}
+
 	if !gwc.IsAccepted() {
 		log.Debug(logger, "gatewayclass not accepted , ignoring", gateway)
 		return ctrl.Result{}, nil
 	}
 
+	log.Trace(logger, "determining configuration", gateway)
+	gatewayConfig, err := r.getOrCreateGatewayConfiguration(ctx, gwc.GatewayClass)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	target := dedicatedProvisioningTarget(&gateway)
+	var mergedConflicts listenerConflicts
+	if isDataPlaneShared(gatewayConfig) {
+		log.Trace(logger, "gateway is merged onto a shared dataplane, ensuring dedicated dataplane and controlplane are deleted", gateway)
+		deleted, err := r.ensureDedicatedResourcesDeleted(ctx, &gateway)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if deleted {
+			log.Debug(logger, "deleted resources provisioned for the gateway before it was merged onto a shared dataplane", gateway)
+			return ctrl.Result{Requeue: true}, nil
+		}
+
+		log.Trace(logger, "listing merged gateways", gateway)
+		mergedGateways, err := listMergedGateways(ctx, r.Client, gwc.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		mergedGateways, err = withoutGuardrailsViolators(ctx, r.Client, mergedGateways, gatewayConfig)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		var mergedListeners []gatewayv1.Listener
+		mergedListeners, mergedConflicts = mergeListeners(mergedGateways)
+		target = sharedProvisioningTarget(gwc.GatewayClass, gatewayConfig, mergedListeners)
+	} else {
+		log.Trace(logger, "gateway has a dedicated dataplane, ensuring shared dataplane and controlplane are deleted", gateway)
+		deleted, err := r.ensureSharedResourcesDeleted(ctx, gwc.GatewayClass)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if deleted {
+			log.Debug(logger, "deleted resources shared by the gatewayclass's gateways before it switched to dedicated dataplanes", gateway)
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
 	oldGateway := gateway.DeepCopy()
 	gwConditionAware := gatewayConditionsAndListenersAware(&gateway)
 	oldGwConditionsAware := gatewayConditionsAndListenersAware(oldGateway)
@@ -164,9 +240,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	log.Trace(logger, "resource is supported, ensuring that it gets marked as accepted", gateway)
 	gwConditionAware.initListenersStatus()
 	gwConditionAware.setConflicted()
+	gwConditionAware.setConflictedWithMergedGateways(mergedConflicts)
 	if err = gwConditionAware.setAcceptedAndAttachedRoutes(ctx, r.Client); err != nil {
 		return ctrl.Result{}, err
 	}
+	violations, err := guardrailsViolations(ctx, r.Client, &gateway, gatewayConfig)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	gwConditionAware.setGuardrailsViolated(violations)
 
 	gwConditionAware.initProgrammedAndListenersStatus()
 	if err := gwConditionAware.setResolvedRefsAndSupportedKinds(ctx, r.Client); err != nil {
@@ -192,16 +274,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
-	log.Trace(logger, "determining configuration", gateway)
-	gatewayConfig, err := r.getOrCreateGatewayConfiguration(ctx, gwc.GatewayClass)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
 	// Provision dataplane creates a dataplane and adds the DataPlaneReady=True
 	// condition to the Gateway status if the dataplane is ready. If not ready
 	// the status DataPlaneReady=False will be set instead.
-	dataplane, provisionErr := r.provisionDataPlane(ctx, logger, &gateway, gatewayConfig)
+	dataplane, provisionErr := r.provisionDataPlane(ctx, logger, &gateway, target, gatewayConfig)
 	// Set the DataPlaneReady Condition to False. This happens only if:
 	// * the new status is false and there was no DataPlaneReady condition in the old gateway, or
 	// * the new status is false and the previous status was true
@@ -309,7 +385,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// Provision controlplane creates a controlplane and adds the ControlPlaneReady condition to the Gateway status
 	// if the controlplane is ready, the ControlPlaneReady status is set to true, otherwise false.
-	controlplane := r.provisionControlPlane(ctx, logger, gwc.GatewayClass, &gateway, gatewayConfig, dataplane, ingressServices[0], adminServices[0])
+	controlplane := r.provisionControlPlane(ctx, logger, gwc.GatewayClass, &gateway, target, gatewayConfig, dataplane, ingressServices[0], adminServices[0])
 
 	// Set the ControlPlaneReady Condition to False. This happens only if:
 	// * the new status is false and there was no ControlPlaneReady condition in the gateway
@@ -347,7 +423,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// DataPlane NetworkPolicies
 	log.Trace(logger, "ensuring DataPlane's NetworkPolicy exists", gateway)
-	createdOrUpdated, err := r.ensureDataPlaneHasNetworkPolicy(ctx, &gateway, dataplane, controlplane)
+	createdOrUpdated, err := r.ensureDataPlaneHasNetworkPolicy(ctx, target, gatewayConfig, dataplane, controlplane)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -357,7 +433,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	log.Trace(logger, "ensuring DataPlane connectivity for Gateway", gateway)
-	gateway.Status.Addresses, err = r.getGatewayAddresses(ctx, dataplane)
+	gateway.Status.Addresses, err = r.getGatewayAddresses(ctx, dataplane, gatewayConfig)
 	if err == nil {
 		k8sutils.SetCondition(k8sutils.NewConditionWithGeneration(GatewayServiceType, metav1.ConditionTrue, consts.ResourceReadyReason, "", gateway.Generation),
 			gatewayConditionsAndListenersAware(&gateway))
@@ -384,20 +460,39 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, nil
 }
 
+// reconcilePaused sets the Paused condition on a Gateway whose reconciliation
+// is paused, without performing any changes to the resources it manages.
+func (r *Reconciler) reconcilePaused(ctx context.Context, logger logr.Logger, gateway *gwtypes.Gateway) (ctrl.Result, error) {
+	if _, err := gatewayclass.Get(ctx, r.Client, string(gateway.Spec.GatewayClassName)); err != nil {
+		if errors.As(err, &operatorerrors.ErrUnsupportedGatewayClass{}) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	log.Debug(logger, "reconciliation paused, skipping", gateway)
+	oldGateway := gateway.DeepCopy()
+	k8sutils.SetPausedCondition(gatewayConditionsAndListenersAware(gateway))
+	_, err := patch.ApplyGatewayStatusPatchIfNotEmpty(ctx, r.Client, logger, gateway, oldGateway)
+	return ctrl.Result{}, err
+}
+
 func (r *Reconciler) provisionDataPlane(
 	ctx context.Context,
 	logger logr.Logger,
 	gateway *gwtypes.Gateway,
+	target provisioningTarget,
 	gatewayConfig *operatorv1beta1.GatewayConfiguration,
 ) (*operatorv1beta1.DataPlane, error) {
 	logger = logger.WithName("dataplaneProvisioning")
 
 	r.setDataPlaneGatewayConfigDefaults(gatewayConfig)
 	log.Trace(logger, "looking for associated dataplanes", gateway)
-	dataplanes, err := gatewayutils.ListDataPlanesForGateway(
+	dataplanes, err := gatewayutils.ListDataPlanesForOwner(
 		ctx,
 		r.Client,
-		gateway,
+		target.namespace,
+		target.owner.GetUID(),
 	)
 	if err != nil {
 		errWrap := fmt.Errorf("failed listing associated dataplanes - error: %w", err)
@@ -423,7 +518,7 @@ func (r *Reconciler) provisionDataPlane(
 		return nil, err
 	}
 	if count == 0 {
-		dataplane, err := r.createDataPlane(ctx, gateway, gatewayConfig)
+		dataplane, err := r.createDataPlane(ctx, target, gatewayConfig)
 		if err != nil {
 			errWrap := fmt.Errorf("dataplane creation failed - error: %w", err)
 			k8sutils.SetCondition(
@@ -450,7 +545,7 @@ func (r *Reconciler) provisionDataPlane(
 	}
 	// Don't require setting defaults for DataPlane when using Gateway CRD.
 	setDataPlaneOptionsDefaults(expectedDataPlaneOptions, r.DefaultDataPlaneImage)
-	err = setDataPlaneIngressServicePorts(expectedDataPlaneOptions, gateway.Spec.Listeners)
+	err = setDataPlaneIngressServicePorts(expectedDataPlaneOptions, target.listeners)
 	if err != nil {
 		errWrap := fmt.Errorf("dataplane creation failed - error: %w", err)
 		k8sutils.SetCondition(
@@ -500,6 +595,7 @@ func (r *Reconciler) provisionControlPlane(
 	logger logr.Logger,
 	gatewayClass *gatewayv1.GatewayClass,
 	gateway *gwtypes.Gateway,
+	target provisioningTarget,
 	gatewayConfig *operatorv1beta1.GatewayConfiguration,
 	dataplane *operatorv1beta1.DataPlane,
 	ingressService corev1.Service,
@@ -508,7 +604,7 @@ func (r *Reconciler) provisionControlPlane(
 	logger = logger.WithName("controlplaneProvisioning")
 
 	log.Trace(logger, "looking for associated controlplanes", gateway)
-	controlplanes, err := gatewayutils.ListControlPlanesForGateway(ctx, r.Client, gateway)
+	controlplanes, err := gatewayutils.ListControlPlanesForOwner(ctx, r.Client, target.namespace, target.owner.GetUID())
 	if err != nil {
 		log.Debug(logger, fmt.Sprintf("failed listing associated controlplanes - error: %v", err), gateway)
 		k8sutils.SetCondition(
@@ -523,8 +619,8 @@ func (r *Reconciler) provisionControlPlane(
 	count := len(controlplanes)
 	switch {
 	case count == 0:
-		r.setControlPlaneGatewayConfigDefaults(gateway, gatewayConfig, dataplane.Name, ingressService.Name, adminService.Name, "")
-		err := r.createControlPlane(ctx, gatewayClass, gateway, gatewayConfig, dataplane.Name)
+		r.setControlPlaneGatewayConfigDefaults(target, gatewayConfig, dataplane.Name, ingressService.Name, adminService.Name, "")
+		err := r.createControlPlane(ctx, gatewayClass, target, gatewayConfig, dataplane.Name)
 		if err != nil {
 			log.Debug(logger, fmt.Sprintf("controlplane creation failed - error: %v", err), gateway)
 			k8sutils.SetCondition(
@@ -550,7 +646,7 @@ func (r *Reconciler) provisionControlPlane(
 
 	// If we continue, there is only one controlplane.
 	controlPlane = controlplanes[0].DeepCopy()
-	r.setControlPlaneGatewayConfigDefaults(gateway, gatewayConfig, dataplane.Name, ingressService.Name, adminService.Name, controlPlane.Name)
+	r.setControlPlaneGatewayConfigDefaults(target, gatewayConfig, dataplane.Name, ingressService.Name, adminService.Name, controlPlane.Name)
 
 	log.Trace(logger, "ensuring controlplane config is up to date", gateway)
 	// compare deployment option of controlplane with controlplane deployment option of gatewayconfiguration.
@@ -674,7 +770,10 @@ func (r *Reconciler) patchStatus(ctx context.Context, gateway, oldGateway *gwtyp
 func dataplaneSpecDeepEqual(spec1, spec2 *operatorv1beta1.DataPlaneOptions) bool {
 	// TODO: Doesn't take .Rollout field into account.
 	if !deploymentOptionsDeepEqual(&spec1.Deployment.DeploymentOptions, &spec2.Deployment.DeploymentOptions) ||
-		!compare.NetworkOptionsDeepEqual(&spec1.Network, &spec2.Network) {
+		!compare.NetworkOptionsDeepEqual(&spec1.Network, &spec2.Network) ||
+		!reflect.DeepEqual(spec1.Monitoring, spec2.Monitoring) ||
+		!reflect.DeepEqual(spec1.Observability, spec2.Observability) ||
+		!reflect.DeepEqual(spec1.KongConfig, spec2.KongConfig) {
 		return false
 	}
 
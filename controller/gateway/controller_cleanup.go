@@ -58,6 +58,18 @@ func (r *Reconciler) cleanup(
 	}
 	log.Trace(logger, "gateway is marked for deletion, waiting for owned resources to be deleted", gateway)
 
+	// Delete the resources shared by the gatewayclass's gateways when this
+	// was the last gateway merged onto them.
+	deleted, err := r.ensureUnusedSharedResourcesDeleted(ctx, gateway)
+	if err != nil {
+		return false, ctrl.Result{}, err
+	}
+	if deleted {
+		log.Debug(logger, "deleted shared dataplane and controlplane no longer used by any gateway", gateway)
+		// Return early from reconciliation, deletion will trigger a new reconcile.
+		return true, ctrl.Result{}, nil
+	}
+
 	// Delete owned controlplanes.
 	// Because controlplanes have finalizers, so we only remove the finalizer
 	// for cleaning up owned controlplanes when they disappeared.
@@ -28,6 +28,11 @@ const (
 	// to express that the Gateway Service is not properly configured.
 	GatewayReasonServiceError consts.ConditionReason = "GatewayServiceError"
 
+	// GatewayReasonGuardrailsViolated must be used with the Accepted condition
+	// to express that the Gateway violates the guardrails set in its
+	// GatewayConfiguration.
+	GatewayReasonGuardrailsViolated consts.ConditionReason = "GuardrailsViolated"
+
 	// ListenerReasonTooManyTLSSecrets must be used with the ResolvedRefs condition
 	// to express that more than one TLS secret has been set in the listener
 	// TLS configuration.
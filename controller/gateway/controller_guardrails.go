@@ -0,0 +1,108 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	gwtypes "github.com/kong/gateway-operator/internal/types"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+)
+
+// -----------------------------------------------------------------------------
+// GatewayReconciler - Guardrails
+// -----------------------------------------------------------------------------
+
+// guardrailsViolations returns the descriptions of the Gateway level guardrails,
+// set in the provided GatewayConfiguration, which the Gateway violates.
+// DataPlane level guardrails are validated on the GatewayConfiguration itself.
+func guardrailsViolations(
+	ctx context.Context,
+	cl client.Client,
+	gateway *gwtypes.Gateway,
+	gatewayConfig *operatorv1beta1.GatewayConfiguration,
+) ([]string, error) {
+	if gatewayConfig == nil || gatewayConfig.Spec.Guardrails == nil {
+		return nil, nil
+	}
+	guardrails := gatewayConfig.Spec.Guardrails
+
+	var violations []string
+
+	if limit := guardrails.MaxGatewaysPerNamespace; limit != nil {
+		var gatewayList gatewayv1.GatewayList
+		if err := cl.List(ctx, &gatewayList, client.InNamespace(gateway.Namespace)); err != nil {
+			return nil, fmt.Errorf("failed listing Gateways in namespace %s: %w", gateway.Namespace, err)
+		}
+		gateways := lo.Filter(gatewayList.Items, func(gw gwtypes.Gateway, _ int) bool {
+			return gw.Spec.GatewayClassName == gateway.Spec.GatewayClassName && gw.DeletionTimestamp.IsZero()
+		})
+		sortGatewaysByCreationTimestamp(gateways)
+		_, idx, found := lo.FindIndexOf(gateways, func(gw gwtypes.Gateway) bool {
+			return gw.UID == gateway.UID
+		})
+		if found && idx >= int(*limit) {
+			violations = append(violations, fmt.Sprintf(
+				"At most %d Gateways of GatewayClass %s are allowed in namespace %s.",
+				*limit, gateway.Spec.GatewayClassName, gateway.Namespace,
+			))
+		}
+	}
+
+	if limit := guardrails.MaxListeners; limit != nil && len(gateway.Spec.Listeners) > int(*limit) {
+		violations = append(violations, fmt.Sprintf(
+			"At most %d listeners are allowed, got %d.", *limit, len(gateway.Spec.Listeners),
+		))
+	}
+
+	return violations, nil
+}
+
+// withoutGuardrailsViolators returns the provided Gateways which do not violate
+// any of the guardrails set in the provided GatewayConfiguration. It is used to
+// keep Gateways which are not accepted from being merged onto a shared DataPlane.
+func withoutGuardrailsViolators(
+	ctx context.Context,
+	cl client.Client,
+	gateways []gwtypes.Gateway,
+	gatewayConfig *operatorv1beta1.GatewayConfiguration,
+) ([]gwtypes.Gateway, error) {
+	if gatewayConfig == nil || gatewayConfig.Spec.Guardrails == nil {
+		return gateways, nil
+	}
+
+	filtered := make([]gwtypes.Gateway, 0, len(gateways))
+	for i := range gateways {
+		violations, err := guardrailsViolations(ctx, cl, &gateways[i], gatewayConfig)
+		if err != nil {
+			return nil, err
+		}
+		if len(violations) == 0 {
+			filtered = append(filtered, gateways[i])
+		}
+	}
+	return filtered, nil
+}
+
+// setGuardrailsViolated sets the Gateway's Accepted condition to False when
+// the Gateway violates any of the guardrails set in its GatewayConfiguration.
+func (g *gatewayConditionsAndListenersAwareT) setGuardrailsViolated(violations []string) {
+	if len(violations) == 0 {
+		return
+	}
+
+	k8sutils.SetCondition(metav1.Condition{
+		Type:               string(gatewayv1.GatewayConditionAccepted),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(GatewayReasonGuardrailsViolated),
+		Message:            strings.Join(violations, " "),
+		ObservedGeneration: g.Generation,
+		LastTransitionTime: metav1.Now(),
+	}, g)
+}
@@ -0,0 +1,207 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	gwtypes "github.com/kong/gateway-operator/internal/types"
+	"github.com/kong/gateway-operator/modules/manager/scheme"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+)
+
+func TestGuardrailsViolations(t *testing.T) {
+	gateway := func(name string, age time.Duration, listeners int) *gwtypes.Gateway {
+		return &gwtypes.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "tenant",
+				UID:               types.UID(name),
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Spec: gatewayv1.GatewaySpec{
+				GatewayClassName: "kong",
+				Listeners:        make([]gatewayv1.Listener, listeners),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		gateway            *gwtypes.Gateway
+		objects            []client.Object
+		gatewayConfigSpec  operatorv1beta1.GatewayConfigurationSpec
+		expectedViolations []string
+	}{
+		{
+			name:    "no guardrails",
+			gateway: gateway("gw", time.Hour, 10),
+		},
+		{
+			name:    "oldest gateways within the per namespace limit are allowed",
+			gateway: gateway("gw-old", 2*time.Hour, 1),
+			objects: []client.Object{
+				gateway("gw-new", time.Hour, 1),
+			},
+			gatewayConfigSpec: operatorv1beta1.GatewayConfigurationSpec{
+				Guardrails: &operatorv1beta1.GatewayConfigGuardrails{
+					MaxGatewaysPerNamespace: lo.ToPtr(int32(1)),
+				},
+			},
+		},
+		{
+			name:    "newest gateways exceeding the per namespace limit are not allowed",
+			gateway: gateway("gw-new", time.Hour, 1),
+			objects: []client.Object{
+				gateway("gw-old", 2*time.Hour, 1),
+			},
+			gatewayConfigSpec: operatorv1beta1.GatewayConfigurationSpec{
+				Guardrails: &operatorv1beta1.GatewayConfigGuardrails{
+					MaxGatewaysPerNamespace: lo.ToPtr(int32(1)),
+				},
+			},
+			expectedViolations: []string{
+				"At most 1 Gateways of GatewayClass kong are allowed in namespace tenant.",
+			},
+		},
+		{
+			name:    "too many listeners",
+			gateway: gateway("gw", time.Hour, 3),
+			gatewayConfigSpec: operatorv1beta1.GatewayConfigurationSpec{
+				Guardrails: &operatorv1beta1.GatewayConfigGuardrails{
+					MaxListeners: lo.ToPtr(int32(2)),
+				},
+			},
+			expectedViolations: []string{
+				"At most 2 listeners are allowed, got 3.",
+			},
+		},
+		{
+			name:    "DataPlane guardrails are not enforced on the Gateway",
+			gateway: gateway("gw", time.Hour, 1),
+			gatewayConfigSpec: operatorv1beta1.GatewayConfigurationSpec{
+				DataPlaneOptions: &operatorv1beta1.GatewayConfigDataPlaneOptions{
+					Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+						DeploymentOptions: operatorv1beta1.DeploymentOptions{
+							Replicas: lo.ToPtr(int32(5)),
+						},
+					},
+				},
+				Guardrails: &operatorv1beta1.GatewayConfigGuardrails{
+					MaxDataPlaneReplicas: lo.ToPtr(int32(3)),
+					AllowedServiceTypes:  []corev1.ServiceType{corev1.ServiceTypeClusterIP},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fakectrlruntimeclient.NewClientBuilder().
+				WithScheme(scheme.Get()).
+				WithObjects(tc.gateway).
+				WithObjects(tc.objects...).
+				Build()
+			gatewayConfig := &operatorv1beta1.GatewayConfiguration{
+				Spec: tc.gatewayConfigSpec,
+			}
+
+			violations, err := guardrailsViolations(context.Background(), cl, tc.gateway, gatewayConfig)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedViolations, violations)
+		})
+	}
+}
+
+func TestSetGuardrailsViolated(t *testing.T) {
+	gw := &gwtypes.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 3,
+		},
+	}
+	gwConditionAware := gatewayConditionsAndListenersAware(gw)
+	k8sutils.SetAcceptedConditionOnGateway(gwConditionAware)
+
+	gwConditionAware.setGuardrailsViolated(nil)
+	cond, ok := k8sutils.GetCondition(consts.ConditionType(gatewayv1.GatewayConditionAccepted), gwConditionAware)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+
+	gwConditionAware.setGuardrailsViolated([]string{"first.", "second."})
+	cond, ok = k8sutils.GetCondition(consts.ConditionType(gatewayv1.GatewayConditionAccepted), gwConditionAware)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(GatewayReasonGuardrailsViolated), cond.Reason)
+	assert.Equal(t, "first. second.", cond.Message)
+	assert.Equal(t, int64(3), cond.ObservedGeneration)
+}
+
+func TestWithoutGuardrailsViolators(t *testing.T) {
+	gateway := func(namespace, name string, age time.Duration, listeners ...gatewayv1.Listener) *gwtypes.Gateway {
+		return &gwtypes.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				UID:               types.UID(namespace + "-" + name),
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Spec: gatewayv1.GatewaySpec{
+				GatewayClassName: "kong",
+				Listeners:        listeners,
+			},
+		}
+	}
+	http := func(port gatewayv1.PortNumber) gatewayv1.Listener {
+		return gatewayv1.Listener{
+			Name:     gatewayv1.SectionName(fmt.Sprintf("http-%d", port)),
+			Protocol: gatewayv1.HTTPProtocolType,
+			Port:     port,
+		}
+	}
+
+	gateways := []gwtypes.Gateway{
+		*gateway("tenant-a", "gw-old", 3*time.Hour, http(80)),
+		*gateway("tenant-a", "gw-new", 2*time.Hour, http(8080)),
+		*gateway("tenant-b", "gw", time.Hour, http(81), http(82), http(83)),
+		*gateway("tenant-c", "gw", time.Minute, http(84)),
+	}
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(lo.Map(gateways, func(gw gwtypes.Gateway, _ int) client.Object { return &gw })...).
+		Build()
+	gatewayConfig := &operatorv1beta1.GatewayConfiguration{
+		Spec: operatorv1beta1.GatewayConfigurationSpec{
+			Guardrails: &operatorv1beta1.GatewayConfigGuardrails{
+				MaxGatewaysPerNamespace: lo.ToPtr(int32(1)),
+				MaxListeners:            lo.ToPtr(int32(2)),
+			},
+		},
+	}
+
+	filtered, err := withoutGuardrailsViolators(context.Background(), cl, gateways, gatewayConfig)
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{"tenant-a/gw-old", "tenant-c/gw"},
+		lo.Map(filtered, func(gw gwtypes.Gateway, _ int) string { return client.ObjectKeyFromObject(&gw).String() }),
+	)
+
+	t.Log("listeners of the violating gateways are not merged")
+	listeners, _ := mergeListeners(filtered)
+	assert.Equal(t,
+		[]gatewayv1.PortNumber{80, 84},
+		lo.Map(listeners, func(l gatewayv1.Listener, _ int) gatewayv1.PortNumber { return l.Port }),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/kong/gateway-operator/controller/pkg/secrets/ref"
 	operatorerrors "github.com/kong/gateway-operator/internal/errors"
 	gwtypes "github.com/kong/gateway-operator/internal/types"
+	dputils "github.com/kong/gateway-operator/internal/utils/dataplane"
 	"github.com/kong/gateway-operator/pkg/consts"
 	gatewayutils "github.com/kong/gateway-operator/pkg/utils/gateway"
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
@@ -39,23 +41,23 @@ import (
 // -----------------------------------------------------------------------------
 
 func (r *Reconciler) createDataPlane(ctx context.Context,
-	gateway *gwtypes.Gateway,
+	target provisioningTarget,
 	gatewayConfig *operatorv1beta1.GatewayConfiguration,
 ) (*operatorv1beta1.DataPlane, error) {
 	dataplane := &operatorv1beta1.DataPlane{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:    gateway.Namespace,
-			GenerateName: k8sutils.TrimGenerateName(fmt.Sprintf("%s-", gateway.Name)),
+			Namespace:    target.namespace,
+			GenerateName: target.generateName(),
 		},
 	}
 	if gatewayConfig.Spec.DataPlaneOptions != nil {
 		dataplane.Spec.DataPlaneOptions = *gatewayConfigDataPlaneOptionsToDataPlaneOptions(gatewayConfig.Namespace, *gatewayConfig.Spec.DataPlaneOptions)
 	}
 	setDataPlaneOptionsDefaults(&dataplane.Spec.DataPlaneOptions, r.DefaultDataPlaneImage)
-	if err := setDataPlaneIngressServicePorts(&dataplane.Spec.DataPlaneOptions, gateway.Spec.Listeners); err != nil {
+	if err := setDataPlaneIngressServicePorts(&dataplane.Spec.DataPlaneOptions, target.listeners); err != nil {
 		return nil, err
 	}
-	k8sutils.SetOwnerForObject(dataplane, gateway)
+	k8sutils.SetOwnerForObject(dataplane, target.owner)
 	gatewayutils.LabelObjectAsGatewayManaged(dataplane)
 	err := r.Client.Create(ctx, dataplane)
 	if err != nil {
@@ -67,14 +69,14 @@ func (r *Reconciler) createDataPlane(ctx context.Context,
 func (r *Reconciler) createControlPlane(
 	ctx context.Context,
 	gatewayClass *gatewayv1.GatewayClass,
-	gateway *gwtypes.Gateway,
+	target provisioningTarget,
 	gatewayConfig *operatorv1beta1.GatewayConfiguration,
 	dataplaneName string,
 ) error {
 	controlplane := &operatorv1beta1.ControlPlane{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:    gateway.Namespace,
-			GenerateName: k8sutils.TrimGenerateName(fmt.Sprintf("%s-", gateway.Name)),
+			Namespace:    target.namespace,
+			GenerateName: target.generateName(),
 		},
 		Spec: operatorv1beta1.ControlPlaneSpec{
 			GatewayClass: (*gatewayv1.ObjectName)(&gatewayClass.Name),
@@ -88,24 +90,33 @@ func (r *Reconciler) createControlPlane(
 	}
 
 	setControlPlaneOptionsDefaults(&controlplane.Spec.ControlPlaneOptions)
-	k8sutils.SetOwnerForObject(controlplane, gateway)
+	k8sutils.SetOwnerForObject(controlplane, target.owner)
 	gatewayutils.LabelObjectAsGatewayManaged(controlplane)
 	return r.Client.Create(ctx, controlplane)
 }
 
+// getGatewayAddresses returns the Gateway addresses based on the DataPlane's
+// ingress Service or, when configured in the GatewayConfiguration, on one of
+// the DataPlane's additional ingress Services.
 func (r *Reconciler) getGatewayAddresses(
 	ctx context.Context,
 	dataplane *operatorv1beta1.DataPlane,
+	gatewayConfig *operatorv1beta1.GatewayConfiguration,
 ) ([]gwtypes.GatewayStatusAddress, error) {
+	matchingLabels := client.MatchingLabels{
+		consts.GatewayOperatorManagedByLabel: consts.DataPlaneManagedLabelValue,
+		consts.DataPlaneServiceTypeLabel:     string(consts.DataPlaneIngressServiceLabelValue),
+	}
+	if name := gatewayAddressesFrom(gatewayConfig); name != "" {
+		matchingLabels[consts.DataPlaneServiceTypeLabel] = string(consts.DataPlaneAdditionalIngressServiceLabelValue)
+		matchingLabels[consts.DataPlaneIngressServiceNameLabel] = name
+	}
 	services, err := k8sutils.ListServicesForOwner(
 		ctx,
 		r.Client,
 		dataplane.Namespace,
 		dataplane.UID,
-		client.MatchingLabels{
-			consts.GatewayOperatorManagedByLabel: consts.DataPlaneManagedLabelValue,
-			consts.DataPlaneServiceTypeLabel:     string(consts.DataPlaneIngressServiceLabelValue),
-		},
+		matchingLabels,
 	)
 	if err != nil {
 		return []gwtypes.GatewayStatusAddress{}, err
@@ -124,6 +135,19 @@ func (r *Reconciler) getGatewayAddresses(
 	return gatewayAddressesFromService(services[0])
 }
 
+// gatewayAddressesFrom returns the name of the additional ingress Service that
+// the Gateway addresses should be derived from or an empty string when the
+// ingress Service should be used.
+func gatewayAddressesFrom(gatewayConfig *operatorv1beta1.GatewayConfiguration) string {
+	if gatewayConfig == nil ||
+		gatewayConfig.Spec.DataPlaneOptions == nil ||
+		gatewayConfig.Spec.DataPlaneOptions.Network.Services == nil ||
+		gatewayConfig.Spec.DataPlaneOptions.Network.Services.GatewayAddressesFrom == nil {
+		return ""
+	}
+	return *gatewayConfig.Spec.DataPlaneOptions.Network.Services.GatewayAddressesFrom
+}
+
 func gatewayConfigDataPlaneOptionsToDataPlaneOptions(
 	gatewayConfigNamespace string, opts operatorv1beta1.GatewayConfigDataPlaneOptions,
 ) *operatorv1beta1.DataPlaneOptions {
@@ -138,6 +162,10 @@ func gatewayConfigDataPlaneOptionsToDataPlaneOptions(
 	dataPlaneOptions := &operatorv1beta1.DataPlaneOptions{
 		Deployment:       opts.Deployment,
 		PluginsToInstall: pluginsToInstall,
+		Monitoring:       opts.Monitoring,
+		Observability:    opts.Observability,
+		KongConfig:       opts.KongConfig,
+		Patches:          opts.Patches,
 	}
 
 	if opts.Network.Services != nil && opts.Network.Services.Ingress != nil {
@@ -148,11 +176,27 @@ func gatewayConfigDataPlaneOptionsToDataPlaneOptions(
 						Type:                  opts.Network.Services.Ingress.Type,
 						Annotations:           opts.Network.Services.Ingress.Annotations,
 						ExternalTrafficPolicy: opts.Network.Services.Ingress.ExternalTrafficPolicy,
+						LoadBalancerClass:     opts.Network.Services.Ingress.LoadBalancerClass,
 					},
 				},
 			},
 		}
 	}
+	if opts.Network.Services != nil && len(opts.Network.Services.AdditionalIngresses) > 0 {
+		if dataPlaneOptions.Network.Services == nil {
+			dataPlaneOptions.Network.Services = &operatorv1beta1.DataPlaneServices{}
+		}
+		dataPlaneOptions.Network.Services.AdditionalIngresses = lo.Map(opts.Network.Services.AdditionalIngresses,
+			func(o operatorv1beta1.GatewayConfigNamedServiceOptions, _ int) operatorv1beta1.DataPlaneNamedServiceOptions {
+				return operatorv1beta1.DataPlaneNamedServiceOptions{
+					Name: o.Name,
+					DataPlaneServiceOptions: operatorv1beta1.DataPlaneServiceOptions{
+						ServiceOptions: o.ServiceOptions,
+					},
+				}
+			},
+		)
+	}
 
 	return dataPlaneOptions
 }
@@ -242,15 +286,28 @@ func (r *Reconciler) getGatewayConfigForGatewayClass(ctx context.Context, gatewa
 
 func (r *Reconciler) ensureDataPlaneHasNetworkPolicy(
 	ctx context.Context,
-	gateway *gwtypes.Gateway,
+	target provisioningTarget,
+	gatewayConfig *operatorv1beta1.GatewayConfiguration,
 	dataplane *operatorv1beta1.DataPlane,
 	controlplane *operatorv1beta1.ControlPlane,
 ) (createdOrUpdate bool, err error) {
-	networkPolicies, err := gatewayutils.ListNetworkPoliciesForGateway(ctx, r.Client, gateway)
+	networkPolicies, err := gatewayutils.ListNetworkPoliciesForOwner(ctx, r.Client, target.namespace, target.owner.GetUID())
 	if err != nil {
 		return false, err
 	}
 
+	policyOpts := gatewayConfigDataPlaneNetworkPolicy(gatewayConfig)
+	if policyOpts != nil && policyOpts.Enabled != nil && !*policyOpts.Enabled {
+		var deleted bool
+		for i := range networkPolicies {
+			if err := r.Client.Delete(ctx, &networkPolicies[i]); client.IgnoreNotFound(err) != nil {
+				return false, fmt.Errorf("failed deleting DataPlane's NetworkPolicy %s: %w", networkPolicies[i].Name, err)
+			}
+			deleted = true
+		}
+		return deleted, nil
+	}
+
 	count := len(networkPolicies)
 	if count > 1 {
 		if err := k8sreduce.ReduceNetworkPolicies(ctx, r.Client, networkPolicies); err != nil {
@@ -259,12 +316,15 @@ func (r *Reconciler) ensureDataPlaneHasNetworkPolicy(
 		return false, errors.New("number of networkPolicies reduced")
 	}
 
-	generatedPolicy, err := generateDataPlaneNetworkPolicy(gateway.Namespace, dataplane, controlplane)
+	generatedPolicy, err := generateDataPlaneNetworkPolicy(target.namespace, dataplane, controlplane, policyOpts)
 	if err != nil {
 		return false, fmt.Errorf("failed generating network policy for DataPlane %s: %w", dataplane.Name, err)
 	}
-	k8sutils.SetOwnerForObject(generatedPolicy, gateway)
+	k8sutils.SetOwnerForObject(generatedPolicy, target.owner)
 	gatewayutils.LabelObjectAsGatewayManaged(generatedPolicy)
+	if err := k8sresources.ApplyResourcePatches(generatedPolicy, operatorv1beta1.ResourcePatchTargetKindNetworkPolicy, dataplane.Spec.Patches); err != nil {
+		return false, fmt.Errorf("failed patching network policy for DataPlane %s: %w", dataplane.Name, err)
+	}
 
 	if count == 1 {
 		var (
@@ -274,7 +334,14 @@ func (r *Reconciler) ensureDataPlaneHasNetworkPolicy(
 		)
 		metaUpdated, existingPolicy.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existingPolicy.ObjectMeta, generatedPolicy.ObjectMeta)
 
-		if k8sresources.EnsureNetworkPolicyIsUpdated(existingPolicy, generatedPolicy) || metaUpdated {
+		specUpdated := k8sresources.EnsureNetworkPolicyIsUpdated(existingPolicy, generatedPolicy)
+		patched, err := k8sresources.EnsureResourcePatchesAreApplied(existingPolicy, generatedPolicy,
+			operatorv1beta1.ResourcePatchTargetKindNetworkPolicy, dataplane.Spec.Patches)
+		if err != nil {
+			return false, fmt.Errorf("failed patching network policy for DataPlane %s: %w", dataplane.Name, err)
+		}
+
+		if specUpdated || metaUpdated || patched {
 			if err := r.Client.Patch(ctx, existingPolicy, client.MergeFrom(old)); err != nil {
 				return false, fmt.Errorf("failed updating DataPlane's NetworkPolicy %s: %w", existingPolicy.Name, err)
 			}
@@ -286,29 +353,55 @@ func (r *Reconciler) ensureDataPlaneHasNetworkPolicy(
 	return true, r.Client.Create(ctx, generatedPolicy)
 }
 
+// gatewayConfigDataPlaneNetworkPolicy returns the DataPlane's NetworkPolicy
+// options from the provided GatewayConfiguration, if set.
+func gatewayConfigDataPlaneNetworkPolicy(
+	gatewayConfig *operatorv1beta1.GatewayConfiguration,
+) *operatorv1beta1.GatewayConfigDataPlaneNetworkPolicy {
+	if gatewayConfig == nil || gatewayConfig.Spec.DataPlaneOptions == nil {
+		return nil
+	}
+	return gatewayConfig.Spec.DataPlaneOptions.Network.NetworkPolicy
+}
+
 func generateDataPlaneNetworkPolicy(
 	namespace string,
 	dataplane *operatorv1beta1.DataPlane,
 	controlplane *operatorv1beta1.ControlPlane,
+	policyOpts *operatorv1beta1.GatewayConfigDataPlaneNetworkPolicy,
 ) (*networkingv1.NetworkPolicy, error) {
 	var (
 		protocolTCP     = corev1.ProtocolTCP
+		protocolUDP     = corev1.ProtocolUDP
 		adminAPISSLPort = intstr.FromInt(consts.DataPlaneAdminAPIPort)
 		proxyPort       = intstr.FromInt(consts.DataPlaneProxyPort)
 		proxySSLPort    = intstr.FromInt(consts.DataPlaneProxySSLPort)
-		metricsPort     = intstr.FromInt(consts.DataPlaneMetricsPort)
+		dnsPort         = intstr.FromInt(53)
+		statusPorts     = []kongListenPort{{Port: consts.DataPlaneMetricsPort, Protocol: corev1.ProtocolTCP}}
+		streamPorts     []kongListenPort
 	)
 
-	// Check if KONG_PROXY_LISTEN and/or KONG_ADMIN_LISTEN are set in
-	// DataPlaneDeploymentOptions and in that's the case then update NetworkPolicy
-	// ports accordingly to allow communication on those ports.
+	// Check if KONG_PROXY_LISTEN, KONG_ADMIN_LISTEN, KONG_STATUS_LISTEN and/or
+	// KONG_STREAM_LISTEN are set in DataPlaneDeploymentOptions or rendered from
+	// the typed Kong configuration and in that's the case then update
+	// NetworkPolicy ports accordingly to allow communication on those ports.
+	// The typed Kong configuration takes precedence over the env variables,
+	// the same way as in the DataPlane's Deployment.
 	//
 	// Note: for now only direct env variable manipulation is allowed (through
 	// the .Env field in DataPlaneDeploymentOptions). EnvFrom is not taken into
 	// account when updating NetworkPolicy ports.
 	dpOpts := dataplane.Spec.DataPlaneOptions
-	container := k8sutils.GetPodContainerByName(&dpOpts.Deployment.PodTemplateSpec.Spec, consts.DataPlaneProxyContainerName)
-	if proxyListen := k8sutils.EnvValueByName(container.Env, "KONG_PROXY_LISTEN"); proxyListen != "" {
+	var env []corev1.EnvVar
+	if podTemplateSpec := dpOpts.Deployment.PodTemplateSpec; podTemplateSpec != nil {
+		if container := k8sutils.GetPodContainerByName(&podTemplateSpec.Spec, consts.DataPlaneProxyContainerName); container != nil {
+			env = container.Env
+		}
+	}
+	for _, envVar := range dputils.ConfigureKongConfigEnvVars(dpOpts.KongConfig) {
+		env = k8sutils.UpdateEnv(env, envVar.Name, envVar.Value)
+	}
+	if proxyListen := k8sutils.EnvValueByName(env, "KONG_PROXY_LISTEN"); proxyListen != "" {
 		kongListenConfig, err := parseKongListenEnv(proxyListen)
 		if err != nil {
 			return nil, fmt.Errorf("failed parsing KONG_PROXY_LISTEN env: %w", err)
@@ -320,7 +413,7 @@ func generateDataPlaneNetworkPolicy(
 			proxySSLPort = intstr.FromInt(kongListenConfig.SSLEndpoint.Port)
 		}
 	}
-	if adminListen := k8sutils.EnvValueByName(container.Env, "KONG_ADMIN_LISTEN"); adminListen != "" {
+	if adminListen := k8sutils.EnvValueByName(env, "KONG_ADMIN_LISTEN"); adminListen != "" {
 		kongListenConfig, err := parseKongListenEnv(adminListen)
 		if err != nil {
 			return nil, fmt.Errorf("failed parsing KONG_ADMIN_LISTEN env: %w", err)
@@ -329,6 +422,20 @@ func generateDataPlaneNetworkPolicy(
 			adminAPISSLPort = intstr.FromInt(kongListenConfig.SSLEndpoint.Port)
 		}
 	}
+	if statusListen := k8sutils.EnvValueByName(env, "KONG_STATUS_LISTEN"); statusListen != "" {
+		ports, err := parseKongListenPorts(statusListen)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing KONG_STATUS_LISTEN env: %w", err)
+		}
+		statusPorts = ports
+	}
+	if streamListen := k8sutils.EnvValueByName(env, "KONG_STREAM_LISTEN"); streamListen != "" {
+		ports, err := parseKongListenPorts(streamListen)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing KONG_STREAM_LISTEN env: %w", err)
+		}
+		streamPorts = ports
+	}
 
 	limitAdminAPIIngress := networkingv1.NetworkPolicyIngressRule{
 		Ports: []networkingv1.NetworkPolicyPort{
@@ -350,19 +457,17 @@ func generateDataPlaneNetworkPolicy(
 	}
 
 	allowProxyIngress := networkingv1.NetworkPolicyIngressRule{
-		Ports: []networkingv1.NetworkPolicyPort{
+		Ports: append([]networkingv1.NetworkPolicyPort{
 			{Protocol: &protocolTCP, Port: &proxyPort},
 			{Protocol: &protocolTCP, Port: &proxySSLPort},
-		},
+		}, kongListenPortsToNetworkPolicyPorts(streamPorts)...),
 	}
 
 	allowMetricsIngress := networkingv1.NetworkPolicyIngressRule{
-		Ports: []networkingv1.NetworkPolicyPort{
-			{Protocol: &protocolTCP, Port: &metricsPort},
-		},
+		Ports: kongListenPortsToNetworkPolicyPorts(statusPorts),
 	}
 
-	return &networkingv1.NetworkPolicy{
+	policy := &networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    namespace,
 			GenerateName: k8sutils.TrimGenerateName(fmt.Sprintf("%s-limit-admin-api-", dataplane.Name)),
@@ -379,10 +484,59 @@ func generateDataPlaneNetworkPolicy(
 			Ingress: []networkingv1.NetworkPolicyIngressRule{
 				limitAdminAPIIngress,
 				allowProxyIngress,
-				allowMetricsIngress,
 			},
 		},
-	}, nil
+	}
+	// Status listener can be turned off, in which case there are no ports to allow.
+	if len(allowMetricsIngress.Ports) > 0 {
+		policy.Spec.Ingress = append(policy.Spec.Ingress, allowMetricsIngress)
+	}
+
+	if policyOpts == nil {
+		return policy, nil
+	}
+
+	policy.Spec.Ingress = append(policy.Spec.Ingress, policyOpts.Ingress...)
+
+	if egress := policyOpts.Egress; egress != nil {
+		policy.Spec.PolicyTypes = append(policy.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+		policy.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{
+			{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &protocolUDP, Port: &dnsPort},
+					{Protocol: &protocolTCP, Port: &dnsPort},
+				},
+			},
+		}
+		if len(egress.UpstreamNamespaces) > 0 {
+			policy.Spec.Egress = append(policy.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+				To: lo.Map(egress.UpstreamNamespaces, func(ns string, _ int) networkingv1.NetworkPolicyPeer {
+					return networkingv1.NetworkPolicyPeer{
+						// NamespaceDefaultLabelName feature gate must be enabled for this to work
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"kubernetes.io/metadata.name": ns,
+							},
+						},
+					}
+				}),
+			})
+		}
+		policy.Spec.Egress = append(policy.Spec.Egress, egress.Rules...)
+	}
+
+	return policy, nil
+}
+
+// kongListenPortsToNetworkPolicyPorts converts the provided Kong listen ports
+// to NetworkPolicy ports.
+func kongListenPortsToNetworkPolicyPorts(ports []kongListenPort) []networkingv1.NetworkPolicyPort {
+	return lo.Map(ports, func(p kongListenPort, _ int) networkingv1.NetworkPolicyPort {
+		return networkingv1.NetworkPolicyPort{
+			Protocol: lo.ToPtr(p.Protocol),
+			Port:     lo.ToPtr(intstr.FromInt(p.Port)),
+		}
+	})
 }
 
 // ensureOwnedControlPlanesDeleted deletes all controlplanes owned by gateway.
@@ -518,13 +672,10 @@ func (l listenerConditionAwareT) SetConditions(conditions []metav1.Condition) {
 
 // supportedRoutesByProtocol returns a map of maps to relate each protocolType with the
 // set of supported Routes.
-//
-// Note: the inner maps have only one element as for now, but in future they will be improved,
-// as each protocolType can be compatible with many different route types.
 func supportedRoutesByProtocol() map[gatewayv1.ProtocolType]map[gatewayv1.Kind]struct{} {
 	return map[gatewayv1.ProtocolType]map[gatewayv1.Kind]struct{}{
-		gatewayv1.HTTPProtocolType:  {"HTTPRoute": {}},
-		gatewayv1.HTTPSProtocolType: {"HTTPRoute": {}},
+		gatewayv1.HTTPProtocolType:  {"HTTPRoute": {}, "GRPCRoute": {}},
+		gatewayv1.HTTPSProtocolType: {"HTTPRoute": {}, "GRPCRoute": {}},
 
 		// L4 routes not supported yet
 		// gatewayv1.TLSProtocolType:   {"TLSRoute": {}},
@@ -688,35 +839,20 @@ func countAttachedRoutesForGatewayListener(ctx context.Context, g *gwtypes.Gatew
 		}
 	}
 
-	kindsForProtocol, protocolSupported := supportedRoutesByProtocol()[listener.Protocol]
-	switch len(allowedRoutes.Kinds) {
-	case 0:
-		if protocolSupported {
-			for k := range kindsForProtocol {
-				// NOTE: Count other types of routes when they are supported.
-
-				switch k {
-				case "HTTPRoute":
-					httpRoutes, err := gatewayutils.ListHTTPRoutesForGateway(ctx, cl, g, opts...)
-					if err != nil {
-						return 0, fmt.Errorf(
-							"failed to list HTTPRoutes for Gateway %s when counting AttachedRoutes: %w",
-							client.ObjectKeyFromObject(g), err,
-						)
-					}
-					count += countAttachedHTTPRoutes(listener.Name, httpRoutes)
-				default:
-					return 0, fmt.Errorf("unsupported route kind: %T", k)
-				}
-			}
-		}
-	default:
-		if lo.ContainsBy(allowedRoutes.Kinds, func(gvk gatewayv1.RouteGroupKind) bool {
-			if _, ok := kindsForProtocol[gvk.Kind]; !ok {
-				return false
-			}
-			return gvk.Group != nil && *gvk.Group == gatewayv1.Group(gatewayv1.GroupVersion.Group)
-		}) {
+	kindsForProtocol := supportedRoutesByProtocol()[listener.Protocol]
+	kinds := lo.Keys(kindsForProtocol)
+	if len(allowedRoutes.Kinds) > 0 {
+		kinds = lo.Filter(kinds, func(k gatewayv1.Kind, _ int) bool {
+			return lo.ContainsBy(allowedRoutes.Kinds, func(gvk gatewayv1.RouteGroupKind) bool {
+				return gvk.Kind == k &&
+					gvk.Group != nil && *gvk.Group == gatewayv1.Group(gatewayv1.GroupVersion.Group)
+			})
+		})
+	}
+
+	for _, k := range kinds {
+		switch k {
+		case "HTTPRoute":
 			httpRoutes, err := gatewayutils.ListHTTPRoutesForGateway(ctx, cl, g, opts...)
 			if err != nil {
 				return 0, fmt.Errorf(
@@ -724,21 +860,38 @@ func countAttachedRoutesForGatewayListener(ctx context.Context, g *gwtypes.Gatew
 					client.ObjectKeyFromObject(g), err,
 				)
 			}
-
-			count += countAttachedHTTPRoutes(listener.Name, httpRoutes)
+			count += countAttachedRoutes(listener.Name, httpRoutes)
+		case "GRPCRoute":
+			grpcRoutes, err := gatewayutils.ListGRPCRoutesForGateway(ctx, cl, g, opts...)
+			if err != nil {
+				return 0, fmt.Errorf(
+					"failed to list GRPCRoutes for Gateway %s when counting AttachedRoutes: %w",
+					client.ObjectKeyFromObject(g), err,
+				)
+			}
+			count += countAttachedRoutes(listener.Name, grpcRoutes)
+		default:
+			return 0, fmt.Errorf("unsupported route kind: %s", k)
 		}
 	}
 
 	return count, nil
 }
 
-// countAttachedHTTPRoutes counts the number of attached HTTPRoutes for a given listener,
+// countAttachedRoutes counts the number of attached routes for a given listener,
 // taking into account the ParentRefs' sectionName.
-func countAttachedHTTPRoutes(listenerName gatewayv1.SectionName, httpRoutes []gatewayv1.HTTPRoute) int32 {
+func countAttachedRoutes[T gatewayv1.HTTPRoute | gatewayv1.GRPCRoute](listenerName gatewayv1.SectionName, routes []T) int32 {
 	var count int32
 
-	for _, httpRoute := range httpRoutes {
-		if lo.ContainsBy(httpRoute.Spec.ParentRefs, func(parentRef gatewayv1.ParentReference) bool {
+	for _, route := range routes {
+		var parentRefs []gatewayv1.ParentReference
+		switch r := any(route).(type) {
+		case gatewayv1.HTTPRoute:
+			parentRefs = r.Spec.ParentRefs
+		case gatewayv1.GRPCRoute:
+			parentRefs = r.Spec.ParentRefs
+		}
+		if lo.ContainsBy(parentRefs, func(parentRef gatewayv1.ParentReference) bool {
 			return parentRef.SectionName == nil || *parentRef.SectionName == listenerName
 		}) {
 			count++
@@ -848,6 +1001,10 @@ func setDataPlaneIngressServicePorts(opts *operatorv1beta1.DataPlaneOptions, lis
 		}
 		opts.Network.Services.Ingress.Ports = append(opts.Network.Services.Ingress.Ports, port)
 	}
+	// The additional ingress Services expose the same ports as the ingress Service.
+	for i := range opts.Network.Services.AdditionalIngresses {
+		opts.Network.Services.AdditionalIngresses[i].Ports = slices.Clone(opts.Network.Services.Ingress.Ports)
+	}
 	return errs
 }
 
@@ -927,8 +1084,10 @@ func getSupportedKindsWithResolvedRefsCondition(ctx context.Context, c client.Cl
 	}
 
 	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
-		supportedRoutes := supportedRoutesByProtocol()[listener.Protocol]
-		for routeKind := range supportedRoutes {
+		supportedRoutes := lo.Keys(supportedRoutesByProtocol()[listener.Protocol])
+		// Sort the kinds to keep the listener's status stable across reconciliations.
+		slices.Sort(supportedRoutes)
+		for _, routeKind := range supportedRoutes {
 			supportedKinds = append(supportedKinds, gatewayv1.RouteGroupKind{
 				Group: (*gatewayv1.Group)(&gatewayv1.GroupVersion.Group),
 				Kind:  routeKind,
@@ -1030,6 +1189,51 @@ func parseKongListenEnv(str string) (kongListenConfig, error) {
 	return kongListenConfig, nil
 }
 
+// kongListenPort is a single port defined in a kong listen string.
+type kongListenPort struct {
+	Port     int
+	Protocol corev1.Protocol
+}
+
+// parseKongListenPorts parses the provided kong listen string (e.g. KONG_STATUS_LISTEN
+// or KONG_STREAM_LISTEN) and returns all the ports it defines. Entries with
+// the udp flag use the UDP protocol, TCP is used otherwise.
+// The "off" value disables the listener and yields no ports.
+//
+// One can find more information about the kong listen format at:
+// - https://docs.konghq.com/gateway/3.0.x/reference/configuration/#status_listen
+// - https://docs.konghq.com/gateway/3.0.x/reference/configuration/#stream_listen
+func parseKongListenPorts(str string) ([]kongListenPort, error) {
+	if strings.TrimSpace(str) == "off" {
+		return nil, nil
+	}
+
+	var ports []kongListenPort
+	for _, s := range strings.Split(str, ",") {
+		fields := strings.Fields(s)
+		if len(fields) == 0 {
+			continue
+		}
+
+		_, port, err := net.SplitHostPort(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing host %s: %w", fields[0], err)
+		}
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing port %s: %w", port, err)
+		}
+
+		protocol := corev1.ProtocolTCP
+		if lo.Contains(fields[1:], "udp") {
+			protocol = corev1.ProtocolUDP
+		}
+		ports = append(ports, kongListenPort{Port: p, Protocol: protocol})
+	}
+
+	return ports, nil
+}
+
 func gatewayStatusNeedsUpdate(oldGateway, newGateway gatewayConditionsAndListenersAwareT) bool {
 	oldCondAccepted, okOld := k8sutils.GetCondition(consts.ConditionType(gatewayv1.GatewayConditionAccepted), oldGateway)
 	newCondAccepted, _ := k8sutils.GetCondition(consts.ConditionType(gatewayv1.GatewayConditionAccepted), newGateway)
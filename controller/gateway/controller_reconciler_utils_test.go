@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -75,6 +76,191 @@ func TestParseKongProxyListenEnv(t *testing.T) {
 	}
 }
 
+func TestParseKongListenPorts(t *testing.T) {
+	testcases := []struct {
+		Name       string
+		KongListen string
+		Expected   []kongListenPort
+	}{
+		{
+			Name:       "status listen",
+			KongListen: "0.0.0.0:8100",
+			Expected: []kongListenPort{
+				{Port: 8100, Protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			Name:       "status listen with ssl",
+			KongListen: "0.0.0.0:8100, 0.0.0.0:8543 http2 ssl",
+			Expected: []kongListenPort{
+				{Port: 8100, Protocol: corev1.ProtocolTCP},
+				{Port: 8543, Protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			Name:       "stream listen with tcp and udp",
+			KongListen: "0.0.0.0:9000 reuseport backlog=16384, 0.0.0.0:9001 udp reuseport",
+			Expected: []kongListenPort{
+				{Port: 9000, Protocol: corev1.ProtocolTCP},
+				{Port: 9001, Protocol: corev1.ProtocolUDP},
+			},
+		},
+		{
+			Name:       "off",
+			KongListen: "off",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := parseKongListenPorts(tc.KongListen)
+			require.NoError(t, err)
+			require.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestGenerateDataPlaneNetworkPolicy(t *testing.T) {
+	dataplane := func(env ...corev1.EnvVar) *operatorv1beta1.DataPlane {
+		return &operatorv1beta1.DataPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dp",
+				Namespace: "default",
+			},
+			Spec: operatorv1beta1.DataPlaneSpec{
+				DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+					Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+						DeploymentOptions: operatorv1beta1.DeploymentOptions{
+							PodTemplateSpec: &corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name: consts.DataPlaneProxyContainerName,
+											Env:  env,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	controlplane := &operatorv1beta1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp",
+			Namespace: "default",
+		},
+	}
+	port := func(protocol corev1.Protocol, p int) networkingv1.NetworkPolicyPort {
+		return networkingv1.NetworkPolicyPort{
+			Protocol: lo.ToPtr(protocol),
+			Port:     lo.ToPtr(intstr.FromInt(p)),
+		}
+	}
+
+	t.Run("status and stream listen ports are allowed", func(t *testing.T) {
+		policy, err := generateDataPlaneNetworkPolicy("default", dataplane(
+			corev1.EnvVar{Name: "KONG_STATUS_LISTEN", Value: "0.0.0.0:8200"},
+			corev1.EnvVar{Name: "KONG_STREAM_LISTEN", Value: "0.0.0.0:9000, 0.0.0.0:9001 udp"},
+		), controlplane, nil)
+		require.NoError(t, err)
+
+		require.Len(t, policy.Spec.Ingress, 3)
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{
+			port(corev1.ProtocolTCP, consts.DataPlaneProxyPort),
+			port(corev1.ProtocolTCP, consts.DataPlaneProxySSLPort),
+			port(corev1.ProtocolTCP, 9000),
+			port(corev1.ProtocolUDP, 9001),
+		}, policy.Spec.Ingress[1].Ports)
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{
+			port(corev1.ProtocolTCP, 8200),
+		}, policy.Spec.Ingress[2].Ports)
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, policy.Spec.PolicyTypes)
+		assert.Empty(t, policy.Spec.Egress)
+	})
+
+	t.Run("listeners from the typed Kong configuration take precedence over env", func(t *testing.T) {
+		dp := dataplane(
+			corev1.EnvVar{Name: "KONG_PROXY_LISTEN", Value: "0.0.0.0:8001, 0.0.0.0:8444 ssl"},
+			corev1.EnvVar{Name: "KONG_STREAM_LISTEN", Value: "0.0.0.0:9000"},
+		)
+		dp.Spec.KongConfig = &operatorv1beta1.KongConfig{
+			Listeners: &operatorv1beta1.KongListenersConfig{
+				Proxy: []operatorv1beta1.KongListener{
+					{Port: 9080},
+					{Port: 9443, Parameters: []operatorv1beta1.KongListenerParameter{operatorv1beta1.KongListenerParameterSSL}},
+				},
+			},
+		}
+		policy, err := generateDataPlaneNetworkPolicy("default", dp, controlplane, nil)
+		require.NoError(t, err)
+
+		require.Len(t, policy.Spec.Ingress, 3)
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{
+			port(corev1.ProtocolTCP, 9080),
+			port(corev1.ProtocolTCP, 9443),
+			port(corev1.ProtocolTCP, 9000),
+		}, policy.Spec.Ingress[1].Ports)
+	})
+
+	t.Run("status listen turned off", func(t *testing.T) {
+		policy, err := generateDataPlaneNetworkPolicy("default", dataplane(
+			corev1.EnvVar{Name: "KONG_STATUS_LISTEN", Value: "off"},
+		), controlplane, nil)
+		require.NoError(t, err)
+		require.Len(t, policy.Spec.Ingress, 2)
+	})
+
+	t.Run("additional ingress rules and egress to upstream namespaces", func(t *testing.T) {
+		prometheusIngress := networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"kubernetes.io/metadata.name": "monitoring"},
+					},
+				},
+			},
+		}
+		extraEgress := networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{
+				{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}},
+			},
+		}
+		policy, err := generateDataPlaneNetworkPolicy("default", dataplane(), controlplane,
+			&operatorv1beta1.GatewayConfigDataPlaneNetworkPolicy{
+				Ingress: []networkingv1.NetworkPolicyIngressRule{prometheusIngress},
+				Egress: &operatorv1beta1.GatewayConfigDataPlaneNetworkPolicyEgress{
+					UpstreamNamespaces: []string{"apps"},
+					Rules:              []networkingv1.NetworkPolicyEgressRule{extraEgress},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, policy.Spec.Ingress, 4)
+		assert.Equal(t, prometheusIngress, policy.Spec.Ingress[3])
+		assert.Equal(t, []networkingv1.PolicyType{
+			networkingv1.PolicyTypeIngress,
+			networkingv1.PolicyTypeEgress,
+		}, policy.Spec.PolicyTypes)
+		require.Len(t, policy.Spec.Egress, 3)
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{
+			port(corev1.ProtocolUDP, 53),
+			port(corev1.ProtocolTCP, 53),
+		}, policy.Spec.Egress[0].Ports)
+		assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+			{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": "apps"},
+				},
+			},
+		}, policy.Spec.Egress[1].To)
+		assert.Equal(t, extraEgress, policy.Spec.Egress[2])
+	})
+}
+
 func TestGatewayAddressesFromService(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -582,6 +768,39 @@ func TestSetDataPlaneIngressServicePorts(t *testing.T) {
 	}
 }
 
+func TestSetDataPlaneIngressServicePortsForAdditionalIngresses(t *testing.T) {
+	opts := &operatorv1beta1.DataPlaneOptions{
+		Network: operatorv1beta1.DataPlaneNetworkOptions{
+			Services: &operatorv1beta1.DataPlaneServices{
+				AdditionalIngresses: []operatorv1beta1.DataPlaneNamedServiceOptions{
+					{Name: "public"},
+					{Name: "internal"},
+				},
+			},
+		},
+	}
+	listeners := []gwtypes.Listener{
+		{
+			Name:     "http",
+			Protocol: gwtypes.HTTPProtocolType,
+			Port:     gatewayv1.PortNumber(80),
+		},
+	}
+
+	require.NoError(t, setDataPlaneIngressServicePorts(opts, listeners))
+	expectedPorts := []operatorv1beta1.DataPlaneServicePort{
+		{
+			Name:       "http",
+			Port:       80,
+			TargetPort: intstr.FromInt(consts.DataPlaneProxyPort),
+		},
+	}
+	require.Equal(t, expectedPorts, opts.Network.Services.Ingress.Ports)
+	for _, additional := range opts.Network.Services.AdditionalIngresses {
+		require.Equal(t, expectedPorts, additional.Ports)
+	}
+}
+
 func TestGatewayStatusNeedsUpdate(t *testing.T) {
 	customizeGateway := func(gateway gatewayv1.Gateway, opts ...func(*gatewayv1.Gateway)) *gatewayv1.Gateway {
 		newGateway := gateway.DeepCopy()
@@ -747,6 +966,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				Protocol: gwtypes.HTTPProtocolType,
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -774,6 +997,33 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				ObservedGeneration: generation,
 			},
 		},
+		{
+			name: "no tls, HTTP protocol, GRPC routes",
+			listener: gwtypes.Listener{
+				Protocol: gwtypes.HTTPProtocolType,
+				AllowedRoutes: &gwtypes.AllowedRoutes{
+					Kinds: []gwtypes.RouteGroupKind{
+						{
+							Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+							Kind:  "GRPCRoute",
+						},
+					},
+				},
+			},
+			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
+			},
+			expectedResolvedRefsCondition: metav1.Condition{
+				Type:               string(gatewayv1.ListenerConditionResolvedRefs),
+				Status:             metav1.ConditionTrue,
+				Reason:             string(gatewayv1.ListenerReasonResolvedRefs),
+				Message:            "Listeners' references are accepted.",
+				ObservedGeneration: generation,
+			},
+		},
 		{
 			name: "no tls, HTTP protocol, HTTP and UDP routes",
 			listener: gwtypes.Listener{
@@ -832,6 +1082,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				},
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -872,6 +1126,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				},
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -903,6 +1161,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				},
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -931,6 +1193,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				},
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -961,6 +1227,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				},
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -1001,6 +1271,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				},
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -1065,6 +1339,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				},
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -1106,6 +1384,10 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 				},
 			},
 			expectedSupportedKinds: []gwtypes.RouteGroupKind{
+				{
+					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+					Kind:  "GRPCRoute",
+				},
 				{
 					Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
 					Kind:  "HTTPRoute",
@@ -1238,6 +1520,142 @@ func TestCountAttachedRoutesForGatewayListener(t *testing.T) {
 			ExpectedRoutes: []int32{1},
 			ExpectedError:  []error{nil},
 		},
+		{
+			Name: "1 HTTPRoute and 1 GRPCRoute in the same namespace as the Gateway",
+			Gateway: gwtypes.Gateway{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gatewayv1.GroupVersion.String(),
+					Kind:       "Gateway",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-gw",
+					Namespace: "test-namespace",
+				},
+				Spec: gwtypes.GatewaySpec{
+					Listeners: []gwtypes.Listener{
+						{
+							Name:     gatewayv1.SectionName("http"),
+							Protocol: gwtypes.HTTPProtocolType,
+							AllowedRoutes: &gwtypes.AllowedRoutes{
+								Namespaces: &gwtypes.RouteNamespaces{
+									From: lo.ToPtr(gwtypes.NamespacesFromSame),
+								},
+							},
+						},
+					},
+				},
+			},
+			Objects: []client.Object{
+				&gwtypes.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "route-1",
+						Namespace: "test-namespace",
+					},
+					Spec: gwtypes.HTTPRouteSpec{
+						CommonRouteSpec: gwtypes.CommonRouteSpec{
+							ParentRefs: []gwtypes.ParentReference{
+								{
+									Name:  gwtypes.ObjectName("test-gw"),
+									Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+									Kind:  lo.ToPtr(gwtypes.Kind("Gateway")),
+								},
+							},
+						},
+					},
+				},
+				&gwtypes.GRPCRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "grpc-route-1",
+						Namespace: "test-namespace",
+					},
+					Spec: gwtypes.GRPCRouteSpec{
+						CommonRouteSpec: gwtypes.CommonRouteSpec{
+							ParentRefs: []gwtypes.ParentReference{
+								{
+									Name:        gwtypes.ObjectName("test-gw"),
+									Group:       (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+									Kind:        lo.ToPtr(gwtypes.Kind("Gateway")),
+									SectionName: lo.ToPtr(gwtypes.SectionName("http")),
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectedRoutes: []int32{2},
+			ExpectedError:  []error{nil},
+		},
+		{
+			Name: "1 HTTPRoute and 1 GRPCRoute in the same namespace as the Gateway, only HTTPRoutes allowed",
+			Gateway: gwtypes.Gateway{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gatewayv1.GroupVersion.String(),
+					Kind:       "Gateway",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-gw",
+					Namespace: "test-namespace",
+				},
+				Spec: gwtypes.GatewaySpec{
+					Listeners: []gwtypes.Listener{
+						{
+							Name:     gatewayv1.SectionName("http"),
+							Protocol: gwtypes.HTTPProtocolType,
+							AllowedRoutes: &gwtypes.AllowedRoutes{
+								Namespaces: &gwtypes.RouteNamespaces{
+									From: lo.ToPtr(gwtypes.NamespacesFromSame),
+								},
+								Kinds: []gwtypes.RouteGroupKind{
+									{
+										Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+										Kind:  "HTTPRoute",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Objects: []client.Object{
+				&gwtypes.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "route-1",
+						Namespace: "test-namespace",
+					},
+					Spec: gwtypes.HTTPRouteSpec{
+						CommonRouteSpec: gwtypes.CommonRouteSpec{
+							ParentRefs: []gwtypes.ParentReference{
+								{
+									Name:  gwtypes.ObjectName("test-gw"),
+									Group: (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+									Kind:  lo.ToPtr(gwtypes.Kind("Gateway")),
+								},
+							},
+						},
+					},
+				},
+				&gwtypes.GRPCRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "grpc-route-1",
+						Namespace: "test-namespace",
+					},
+					Spec: gwtypes.GRPCRouteSpec{
+						CommonRouteSpec: gwtypes.CommonRouteSpec{
+							ParentRefs: []gwtypes.ParentReference{
+								{
+									Name:        gwtypes.ObjectName("test-gw"),
+									Group:       (*gwtypes.Group)(&gatewayv1.GroupVersion.Group),
+									Kind:        lo.ToPtr(gwtypes.Kind("Gateway")),
+									SectionName: lo.ToPtr(gwtypes.SectionName("http")),
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectedRoutes: []int32{1},
+			ExpectedError:  []error{nil},
+		},
 		{
 			Name: "1 HTTPRoute in a different namespace than the Gateway",
 			Gateway: gwtypes.Gateway{
@@ -0,0 +1,346 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	operatorerrors "github.com/kong/gateway-operator/internal/errors"
+	gwtypes "github.com/kong/gateway-operator/internal/types"
+	"github.com/kong/gateway-operator/internal/utils/gatewayclass"
+	"github.com/kong/gateway-operator/pkg/consts"
+	gatewayutils "github.com/kong/gateway-operator/pkg/utils/gateway"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+)
+
+// -----------------------------------------------------------------------------
+// GatewayReconciler - Shared DataPlanes
+// -----------------------------------------------------------------------------
+
+// provisioningTarget describes where and on whose behalf the DataPlane,
+// ControlPlane and NetworkPolicy serving a Gateway are provisioned.
+type provisioningTarget struct {
+	// owner is the object owning the provisioned resources: the Gateway itself
+	// or, when the DataPlane is shared, the GatewayClass of the merged Gateways.
+	owner client.Object
+	// namespace is the namespace the provisioned resources live in.
+	namespace string
+	// listeners are the listeners exposed through the DataPlane's ingress Service.
+	listeners []gatewayv1.Listener
+}
+
+// ownedByGateway returns the name of the Gateway owning the provisioned
+// resources or an empty string when these are shared by multiple Gateways.
+func (t provisioningTarget) ownedByGateway() string {
+	if _, ok := t.owner.(*gwtypes.Gateway); ok {
+		return t.owner.GetName()
+	}
+	return ""
+}
+
+// generateName returns the prefix used for names of the provisioned resources.
+func (t provisioningTarget) generateName() string {
+	return k8sutils.TrimGenerateName(fmt.Sprintf("%s-", t.owner.GetName()))
+}
+
+// dedicatedProvisioningTarget returns the provisioningTarget for a Gateway
+// which gets a DataPlane and ControlPlane pair of its own.
+func dedicatedProvisioningTarget(gateway *gwtypes.Gateway) provisioningTarget {
+	return provisioningTarget{
+		owner:     gateway,
+		namespace: gateway.Namespace,
+		listeners: gateway.Spec.Listeners,
+	}
+}
+
+// sharedProvisioningTarget returns the provisioningTarget for Gateways merged
+// onto a DataPlane and ControlPlane pair shared by all the Gateways of a GatewayClass.
+// The provided listeners are the merged Gateways' listeners, as returned by mergeListeners.
+func sharedProvisioningTarget(
+	gatewayClass *gatewayv1.GatewayClass,
+	gatewayConfig *operatorv1beta1.GatewayConfiguration,
+	listeners []gatewayv1.Listener,
+) provisioningTarget {
+	return provisioningTarget{
+		owner:     gatewayClass,
+		namespace: gatewayConfig.Namespace,
+		listeners: listeners,
+	}
+}
+
+// ensureDedicatedResourcesDeleted deletes the DataPlanes, ControlPlanes and
+// NetworkPolicies owned by the Gateway itself. These are left over when the
+// Gateway's GatewayClass switches from the Dedicated to the Shared mode.
+// It returns true if any resource was deleted.
+func (r *Reconciler) ensureDedicatedResourcesDeleted(ctx context.Context, gateway *gwtypes.Gateway) (bool, error) {
+	controlPlanesDeleted, err := r.ensureOwnedControlPlanesDeleted(ctx, gateway)
+	if err != nil {
+		return false, err
+	}
+	dataPlanesDeleted, err := r.ensureOwnedDataPlanesDeleted(ctx, gateway)
+	if err != nil {
+		return false, err
+	}
+	networkPoliciesDeleted, err := r.ensureOwnedNetworkPoliciesDeleted(ctx, gateway)
+	if err != nil {
+		return false, err
+	}
+	return controlPlanesDeleted || dataPlanesDeleted || networkPoliciesDeleted, nil
+}
+
+// ensureSharedResourcesDeleted deletes the DataPlanes, ControlPlanes and
+// NetworkPolicies owned by the provided GatewayClass, i.e. the ones shared by
+// its merged Gateways. These are left over when the last merged Gateway is
+// deleted or when the GatewayClass switches back from the Shared to the
+// Dedicated mode.
+// It returns true if any resource was deleted.
+func (r *Reconciler) ensureSharedResourcesDeleted(ctx context.Context, gatewayClass *gatewayv1.GatewayClass) (bool, error) {
+	// Shared resources are provisioned in the GatewayConfiguration's namespace
+	// which may have changed since, hence they are looked up in all namespaces.
+	controlPlanes, err := gatewayutils.ListControlPlanesForOwner(ctx, r.Client, metav1.NamespaceAll, gatewayClass.UID)
+	if err != nil {
+		return false, err
+	}
+	controlPlanesDeleted, err := deleteObjects(ctx, r.Client, controlPlanes)
+	if err != nil {
+		return false, err
+	}
+	dataPlanes, err := gatewayutils.ListDataPlanesForOwner(ctx, r.Client, metav1.NamespaceAll, gatewayClass.UID)
+	if err != nil {
+		return false, err
+	}
+	dataPlanesDeleted, err := deleteObjects(ctx, r.Client, dataPlanes)
+	if err != nil {
+		return false, err
+	}
+	networkPolicies, err := gatewayutils.ListNetworkPoliciesForOwner(ctx, r.Client, metav1.NamespaceAll, gatewayClass.UID)
+	if err != nil {
+		return false, err
+	}
+	networkPoliciesDeleted, err := deleteObjects(ctx, r.Client, networkPolicies)
+	if err != nil {
+		return false, err
+	}
+	return controlPlanesDeleted || dataPlanesDeleted || networkPoliciesDeleted, nil
+}
+
+// ensureUnusedSharedResourcesDeleted deletes the resources shared by the Gateways
+// of the provided Gateway's GatewayClass when no Gateway is merged onto them
+// anymore, e.g. when the provided Gateway, which is being deleted, was the last one.
+// It returns true if any resource was deleted.
+func (r *Reconciler) ensureUnusedSharedResourcesDeleted(ctx context.Context, gateway *gwtypes.Gateway) (bool, error) {
+	gwc, err := gatewayclass.Get(ctx, r.Client, string(gateway.Spec.GatewayClassName))
+	if err != nil {
+		if errors.As(err, &operatorerrors.ErrUnsupportedGatewayClass{}) || k8serrors.IsNotFound(err) {
+			// Resources owned by a deleted GatewayClass are garbage collected.
+			return false, nil
+		}
+		return false, err
+	}
+
+	mergedGateways, err := listMergedGateways(ctx, r.Client, gwc.Name)
+	if err != nil {
+		return false, err
+	}
+	if len(mergedGateways) > 0 {
+		return false, nil
+	}
+	return r.ensureSharedResourcesDeleted(ctx, gwc.GatewayClass)
+}
+
+// deleteObjects deletes the provided objects, skipping the ones already marked
+// for deletion as these may have finalizers waiting for their own cleanup.
+// It returns true if any object was deleted.
+func deleteObjects[T any, PT interface {
+	*T
+	client.Object
+}](ctx context.Context, cl client.Client, objs []T) (bool, error) {
+	var (
+		deleted bool
+		errs    []error
+	)
+	for i := range objs {
+		obj := PT(&objs[i])
+		if !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if err := cl.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, err)
+			continue
+		}
+		deleted = true
+	}
+	return deleted, errors.Join(errs...)
+}
+
+// isDataPlaneShared returns true when the provided GatewayConfiguration
+// requests Gateways to be merged onto a shared DataPlane and ControlPlane pair.
+func isDataPlaneShared(gatewayConfig *operatorv1beta1.GatewayConfiguration) bool {
+	return gatewayConfig != nil &&
+		gatewayConfig.Spec.DataPlaneSharing != nil &&
+		gatewayConfig.Spec.DataPlaneSharing.Mode == operatorv1beta1.DataPlaneSharingModeShared
+}
+
+// listMergedGateways returns the Gateways which are merged onto the DataPlane
+// shared by the provided GatewayClass. Gateways marked for deletion are not
+// taken into account.
+// The result is ordered by creation timestamp (and namespaced name for equal
+// timestamps) which determines the precedence of listeners when resolving conflicts.
+func listMergedGateways(ctx context.Context, cl client.Client, gatewayClassName string) ([]gwtypes.Gateway, error) {
+	var gatewayList gatewayv1.GatewayList
+	if err := cl.List(ctx, &gatewayList); err != nil {
+		return nil, fmt.Errorf("failed listing Gateways for GatewayClass %s: %w", gatewayClassName, err)
+	}
+
+	gateways := lo.Filter(gatewayList.Items, func(gw gwtypes.Gateway, _ int) bool {
+		return string(gw.Spec.GatewayClassName) == gatewayClassName && gw.DeletionTimestamp.IsZero()
+	})
+	sortGatewaysByCreationTimestamp(gateways)
+	return gateways, nil
+}
+
+// sortGatewaysByCreationTimestamp sorts the provided Gateways from the oldest
+// to the newest. Gateways created at the same time are ordered by their
+// namespaced name.
+func sortGatewaysByCreationTimestamp(gateways []gwtypes.Gateway) {
+	sort.SliceStable(gateways, func(i, j int) bool {
+		ti, tj := gateways[i].CreationTimestamp, gateways[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return client.ObjectKeyFromObject(&gateways[i]).String() < client.ObjectKeyFromObject(&gateways[j]).String()
+	})
+}
+
+// listenerConflict describes a conflict of a listener with a listener of
+// another Gateway merged onto the same shared DataPlane.
+type listenerConflict struct {
+	reason  gatewayv1.ListenerConditionReason
+	message string
+}
+
+// listenerConflicts holds the listenerConflicts of the merged Gateways indexed
+// by their Gateway and listener name.
+type listenerConflicts map[types.NamespacedName]map[gatewayv1.SectionName]listenerConflict
+
+// mergeListeners merges the listeners of the provided Gateways into the list of
+// listeners exposed through the shared DataPlane's ingress Service.
+//
+// Gateways are processed in the provided order, hence listeners of earlier
+// Gateways take precedence. A listener conflicts with a listener of another
+// Gateway when both use the same port with a different protocol, or the same
+// port and hostname. Conflicting listeners are not exposed and are returned
+// indexed by their Gateway and listener name.
+//
+// Exposed listeners are named after their protocol and port as names of the
+// merged Gateways' listeners are not unique.
+// Conflicts between listeners of a single Gateway are out of scope here and
+// handled when setting the Gateway's own Conflicted conditions.
+func mergeListeners(gateways []gwtypes.Gateway) ([]gatewayv1.Listener, listenerConflicts) {
+	type portClaim struct {
+		gateway   types.NamespacedName
+		protocol  gatewayv1.ProtocolType
+		hostnames map[gatewayv1.Hostname]types.NamespacedName
+	}
+
+	var (
+		listeners []gatewayv1.Listener
+		conflicts = make(listenerConflicts)
+		claims    = make(map[gatewayv1.PortNumber]*portClaim)
+	)
+	addConflict := func(gw types.NamespacedName, name gatewayv1.SectionName, c listenerConflict) {
+		if conflicts[gw] == nil {
+			conflicts[gw] = make(map[gatewayv1.SectionName]listenerConflict)
+		}
+		conflicts[gw][name] = c
+	}
+
+	for i := range gateways {
+		gwNN := client.ObjectKeyFromObject(&gateways[i])
+		for _, l := range gateways[i].Spec.Listeners {
+			if _, ok := supportedRoutesByProtocol()[l.Protocol]; !ok {
+				// Listeners with unsupported protocols are never exposed.
+				continue
+			}
+
+			hostname := lo.FromPtr(l.Hostname)
+			claim, ok := claims[l.Port]
+			if !ok {
+				claims[l.Port] = &portClaim{
+					gateway:   gwNN,
+					protocol:  l.Protocol,
+					hostnames: map[gatewayv1.Hostname]types.NamespacedName{hostname: gwNN},
+				}
+				listeners = append(listeners, gatewayv1.Listener{
+					Name:     gatewayv1.SectionName(fmt.Sprintf("%s-%d", strings.ToLower(string(l.Protocol)), l.Port)),
+					Port:     l.Port,
+					Protocol: l.Protocol,
+				})
+				continue
+			}
+
+			if claim.protocol != l.Protocol {
+				if claim.gateway != gwNN {
+					addConflict(gwNN, l.Name, listenerConflict{
+						reason: gatewayv1.ListenerReasonProtocolConflict,
+						message: fmt.Sprintf("Port %d is already used with protocol %s by Gateway %s on the shared DataPlane.",
+							l.Port, claim.protocol, claim.gateway),
+					})
+				}
+				continue
+			}
+
+			if owner, ok := claim.hostnames[hostname]; ok && owner != gwNN {
+				addConflict(gwNN, l.Name, listenerConflict{
+					reason: gatewayv1.ListenerReasonHostnameConflict,
+					message: fmt.Sprintf("Hostname %q on port %d is already used by Gateway %s on the shared DataPlane.",
+						hostname, l.Port, owner),
+				})
+				continue
+			}
+			claim.hostnames[hostname] = gwNN
+		}
+	}
+
+	return listeners, conflicts
+}
+
+// setConflictedWithMergedGateways sets the Conflicted condition on the Gateway's
+// listeners which conflict with listeners of other Gateways merged onto the
+// same shared DataPlane. The provided conflicts are the ones returned by mergeListeners.
+// Listeners already marked as conflicted by setConflicted are left untouched.
+func (g *gatewayConditionsAndListenersAwareT) setConflictedWithMergedGateways(conflicts listenerConflicts) {
+	gatewayConflicts, ok := conflicts[client.ObjectKeyFromObject(g.Gateway)]
+	if !ok {
+		return
+	}
+
+	for i, l := range g.Spec.Listeners {
+		conflict, ok := gatewayConflicts[l.Name]
+		if !ok {
+			continue
+		}
+		lStatus := listenerConditionsAware(&g.Status.Listeners[i])
+		if k8sutils.IsConditionTrue(consts.ConditionType(gatewayv1.ListenerConditionConflicted), lStatus) {
+			continue
+		}
+		k8sutils.SetCondition(metav1.Condition{
+			Type:               string(gatewayv1.ListenerConditionConflicted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(conflict.reason),
+			Message:            conflict.message,
+			LastTransitionTime: metav1.Now(),
+			ObservedGeneration: g.Generation,
+		}, lStatus)
+	}
+}
@@ -0,0 +1,379 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	gwtypes "github.com/kong/gateway-operator/internal/types"
+	"github.com/kong/gateway-operator/modules/manager/scheme"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	"github.com/kong/gateway-operator/pkg/vars"
+)
+
+func TestMergeListeners(t *testing.T) {
+	gateway := func(namespace, name string, listeners ...gatewayv1.Listener) gwtypes.Gateway {
+		return gwtypes.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: gatewayv1.GatewaySpec{
+				Listeners: listeners,
+			},
+		}
+	}
+	listener := func(name string, protocol gatewayv1.ProtocolType, port gatewayv1.PortNumber, hostname string) gatewayv1.Listener {
+		l := gatewayv1.Listener{
+			Name:     gatewayv1.SectionName(name),
+			Protocol: protocol,
+			Port:     port,
+		}
+		if hostname != "" {
+			l.Hostname = lo.ToPtr(gatewayv1.Hostname(hostname))
+		}
+		return l
+	}
+
+	testCases := []struct {
+		name              string
+		gateways          []gwtypes.Gateway
+		expectedListeners []gatewayv1.Listener
+		expectedConflicts map[types.NamespacedName]map[gatewayv1.SectionName]gatewayv1.ListenerConditionReason
+	}{
+		{
+			name: "listeners on the same ports with distinct hostnames are merged",
+			gateways: []gwtypes.Gateway{
+				gateway("tenant-a", "gw", listener("http", gatewayv1.HTTPProtocolType, 80, "a.example.com")),
+				gateway("tenant-b", "gw", listener("http", gatewayv1.HTTPProtocolType, 80, "b.example.com"),
+					listener("https", gatewayv1.HTTPSProtocolType, 443, "b.example.com")),
+			},
+			expectedListeners: []gatewayv1.Listener{
+				{Name: "http-80", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+				{Name: "https-443", Protocol: gatewayv1.HTTPSProtocolType, Port: 443},
+			},
+			expectedConflicts: map[types.NamespacedName]map[gatewayv1.SectionName]gatewayv1.ListenerConditionReason{},
+		},
+		{
+			name: "protocol conflict on a port is reported for the later gateway",
+			gateways: []gwtypes.Gateway{
+				gateway("tenant-a", "gw", listener("http", gatewayv1.HTTPProtocolType, 8000, "")),
+				gateway("tenant-b", "gw", listener("https", gatewayv1.HTTPSProtocolType, 8000, ""),
+					listener("http", gatewayv1.HTTPProtocolType, 80, "")),
+			},
+			expectedListeners: []gatewayv1.Listener{
+				{Name: "http-8000", Protocol: gatewayv1.HTTPProtocolType, Port: 8000},
+				{Name: "http-80", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+			},
+			expectedConflicts: map[types.NamespacedName]map[gatewayv1.SectionName]gatewayv1.ListenerConditionReason{
+				{Namespace: "tenant-b", Name: "gw"}: {
+					"https": gatewayv1.ListenerReasonProtocolConflict,
+				},
+			},
+		},
+		{
+			name: "hostname conflict on a port is reported for the later gateway",
+			gateways: []gwtypes.Gateway{
+				gateway("tenant-a", "gw", listener("http", gatewayv1.HTTPProtocolType, 80, "example.com")),
+				gateway("tenant-b", "gw", listener("http", gatewayv1.HTTPProtocolType, 80, "example.com")),
+			},
+			expectedListeners: []gatewayv1.Listener{
+				{Name: "http-80", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+			},
+			expectedConflicts: map[types.NamespacedName]map[gatewayv1.SectionName]gatewayv1.ListenerConditionReason{
+				{Namespace: "tenant-b", Name: "gw"}: {
+					"http": gatewayv1.ListenerReasonHostnameConflict,
+				},
+			},
+		},
+		{
+			name: "listeners with unsupported protocols are skipped",
+			gateways: []gwtypes.Gateway{
+				gateway("tenant-a", "gw", listener("tcp", gatewayv1.TCPProtocolType, 9000, "")),
+			},
+			expectedConflicts: map[types.NamespacedName]map[gatewayv1.SectionName]gatewayv1.ListenerConditionReason{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listeners, conflicts := mergeListeners(tc.gateways)
+			assert.Equal(t, tc.expectedListeners, listeners)
+
+			reasons := make(map[types.NamespacedName]map[gatewayv1.SectionName]gatewayv1.ListenerConditionReason)
+			for gw, gwConflicts := range conflicts {
+				reasons[gw] = lo.MapValues(gwConflicts, func(c listenerConflict, _ gatewayv1.SectionName) gatewayv1.ListenerConditionReason {
+					return c.reason
+				})
+			}
+			assert.Equal(t, tc.expectedConflicts, reasons)
+		})
+	}
+}
+
+func TestSetConflictedWithMergedGateways(t *testing.T) {
+	older := gwtypes.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "tenant-a",
+			Name:              "gw",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{
+				{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+			},
+		},
+	}
+	newer := gwtypes.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "tenant-b",
+			Name:              "gw",
+			Generation:        2,
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{
+				{Name: "tls", Protocol: gatewayv1.HTTPSProtocolType, Port: 80},
+				{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 8080},
+			},
+		},
+	}
+
+	gw := newer.DeepCopy()
+	gwConditionAware := gatewayConditionsAndListenersAware(gw)
+	gwConditionAware.initListenersStatus()
+	gwConditionAware.setConflicted()
+	_, conflicts := mergeListeners([]gwtypes.Gateway{older, newer})
+	gwConditionAware.setConflictedWithMergedGateways(conflicts)
+
+	require.Len(t, gw.Status.Listeners, 2)
+	cond, ok := k8sutils.GetCondition(
+		consts.ConditionType(gatewayv1.ListenerConditionConflicted),
+		listenerConditionsAware(&gw.Status.Listeners[0]),
+	)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(gatewayv1.ListenerReasonProtocolConflict), cond.Reason)
+	assert.Equal(t, int64(2), cond.ObservedGeneration)
+	assert.Contains(t, cond.Message, "tenant-a/gw")
+
+	cond, ok = k8sutils.GetCondition(
+		consts.ConditionType(gatewayv1.ListenerConditionConflicted),
+		listenerConditionsAware(&gw.Status.Listeners[1]),
+	)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+}
+
+func TestEnsureDedicatedResourcesDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	gateway := &gwtypes.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw", UID: "gw-uid"},
+	}
+	gatewayClass := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "kong", UID: "gwc-uid"},
+	}
+	objectMeta := func(name string, owner client.Object, kind string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels:    map[string]string{consts.GatewayOperatorManagedByLabel: consts.GatewayManagedLabelValue},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: kind, Name: owner.GetName(), UID: owner.GetUID()},
+			},
+		}
+	}
+
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(
+			&operatorv1beta1.DataPlane{ObjectMeta: objectMeta("dedicated", gateway, "Gateway")},
+			&operatorv1beta1.ControlPlane{ObjectMeta: objectMeta("dedicated", gateway, "Gateway")},
+			&networkingv1.NetworkPolicy{ObjectMeta: objectMeta("dedicated", gateway, "Gateway")},
+			&operatorv1beta1.DataPlane{ObjectMeta: objectMeta("shared", gatewayClass, "GatewayClass")},
+			&operatorv1beta1.ControlPlane{ObjectMeta: objectMeta("shared", gatewayClass, "GatewayClass")},
+		).
+		Build()
+	r := &Reconciler{Client: cl}
+
+	t.Log("resources owned by the gateway are deleted")
+	deleted, err := r.ensureDedicatedResourcesDeleted(ctx, gateway)
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	var dataplanes operatorv1beta1.DataPlaneList
+	require.NoError(t, cl.List(ctx, &dataplanes))
+	require.Len(t, dataplanes.Items, 1)
+	assert.Equal(t, "shared", dataplanes.Items[0].Name)
+	var controlplanes operatorv1beta1.ControlPlaneList
+	require.NoError(t, cl.List(ctx, &controlplanes))
+	require.Len(t, controlplanes.Items, 1)
+	assert.Equal(t, "shared", controlplanes.Items[0].Name)
+	var networkPolicies networkingv1.NetworkPolicyList
+	require.NoError(t, cl.List(ctx, &networkPolicies))
+	require.Empty(t, networkPolicies.Items)
+
+	t.Log("nothing left to delete")
+	deleted, err = r.ensureDedicatedResourcesDeleted(ctx, gateway)
+	require.NoError(t, err)
+	require.False(t, deleted)
+}
+
+func TestEnsureSharedResourcesDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	gateway := &gwtypes.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw", UID: "gw-uid"},
+	}
+	gatewayClass := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "kong", UID: "gwc-uid"},
+	}
+	objectMeta := func(namespace, name string, owner client.Object, kind string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    map[string]string{consts.GatewayOperatorManagedByLabel: consts.GatewayManagedLabelValue},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: kind, Name: owner.GetName(), UID: owner.GetUID()},
+			},
+		}
+	}
+
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(
+			&operatorv1beta1.DataPlane{ObjectMeta: objectMeta("default", "dedicated", gateway, "Gateway")},
+			&operatorv1beta1.ControlPlane{ObjectMeta: objectMeta("default", "dedicated", gateway, "Gateway")},
+			&operatorv1beta1.DataPlane{ObjectMeta: objectMeta("kong-system", "shared", gatewayClass, "GatewayClass")},
+			&operatorv1beta1.ControlPlane{ObjectMeta: objectMeta("kong-system", "shared", gatewayClass, "GatewayClass")},
+			&networkingv1.NetworkPolicy{ObjectMeta: objectMeta("kong-system", "shared", gatewayClass, "GatewayClass")},
+		).
+		Build()
+	r := &Reconciler{Client: cl}
+
+	t.Log("resources owned by the gatewayclass are deleted after switching back to dedicated mode")
+	deleted, err := r.ensureSharedResourcesDeleted(ctx, gatewayClass)
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	var dataplanes operatorv1beta1.DataPlaneList
+	require.NoError(t, cl.List(ctx, &dataplanes))
+	require.Len(t, dataplanes.Items, 1)
+	assert.Equal(t, "dedicated", dataplanes.Items[0].Name)
+	var controlplanes operatorv1beta1.ControlPlaneList
+	require.NoError(t, cl.List(ctx, &controlplanes))
+	require.Len(t, controlplanes.Items, 1)
+	assert.Equal(t, "dedicated", controlplanes.Items[0].Name)
+	var networkPolicies networkingv1.NetworkPolicyList
+	require.NoError(t, cl.List(ctx, &networkPolicies))
+	require.Empty(t, networkPolicies.Items)
+
+	t.Log("nothing left to delete")
+	deleted, err = r.ensureSharedResourcesDeleted(ctx, gatewayClass)
+	require.NoError(t, err)
+	require.False(t, deleted)
+}
+
+func TestCleanupDeletesUnusedSharedResources(t *testing.T) {
+	ctx := context.Background()
+
+	gatewayClass := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "kong", UID: "gwc-uid"},
+		Spec:       gatewayv1.GatewayClassSpec{ControllerName: gatewayv1.GatewayController(vars.ControllerName())},
+	}
+	newGateway := func(name string, deleted bool) *gwtypes.Gateway {
+		gw := &gwtypes.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "default",
+				Name:       name,
+				UID:        types.UID(name + "-uid"),
+				Finalizers: []string{string(GatewayFinalizerCleanupDataPlanes)},
+			},
+			Spec: gatewayv1.GatewaySpec{GatewayClassName: gatewayv1.ObjectName(gatewayClass.Name)},
+		}
+		if deleted {
+			gw.DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+		}
+		return gw
+	}
+	sharedObjectMeta := metav1.ObjectMeta{
+		Namespace: "kong-system",
+		Name:      "shared",
+		Labels:    map[string]string{consts.GatewayOperatorManagedByLabel: consts.GatewayManagedLabelValue},
+		OwnerReferences: []metav1.OwnerReference{
+			{Kind: "GatewayClass", Name: gatewayClass.Name, UID: gatewayClass.UID},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		otherGateways   []client.Object
+		expectedDeleted bool
+	}{
+		{
+			name:            "the last merged gateway is deleted",
+			expectedDeleted: true,
+		},
+		{
+			name:          "other gateways are still merged onto the shared dataplane",
+			otherGateways: []client.Object{newGateway("other", false)},
+		},
+		{
+			name:            "other merged gateways are deleted too",
+			otherGateways:   []client.Object{newGateway("other", true)},
+			expectedDeleted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := newGateway("gw", true)
+			cl := fakectrlruntimeclient.NewClientBuilder().
+				WithScheme(scheme.Get()).
+				WithObjects(
+					gatewayClass,
+					gateway,
+					&operatorv1beta1.DataPlane{ObjectMeta: sharedObjectMeta},
+					&operatorv1beta1.ControlPlane{ObjectMeta: sharedObjectMeta},
+					&networkingv1.NetworkPolicy{ObjectMeta: sharedObjectMeta},
+				).
+				WithObjects(tc.otherGateways...).
+				Build()
+			r := &Reconciler{Client: cl}
+
+			shouldReturnEarly, _, err := r.cleanup(ctx, logr.Discard(), gateway)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedDeleted, shouldReturnEarly)
+
+			var dataplanes operatorv1beta1.DataPlaneList
+			require.NoError(t, cl.List(ctx, &dataplanes))
+			var controlplanes operatorv1beta1.ControlPlaneList
+			require.NoError(t, cl.List(ctx, &controlplanes))
+			var networkPolicies networkingv1.NetworkPolicyList
+			require.NoError(t, cl.List(ctx, &networkPolicies))
+			if tc.expectedDeleted {
+				require.Empty(t, dataplanes.Items)
+				require.Empty(t, controlplanes.Items)
+				require.Empty(t, networkPolicies.Items)
+			} else {
+				require.Len(t, dataplanes.Items, 1)
+				require.Len(t, controlplanes.Items, 1)
+				require.Len(t, networkPolicies.Items, 1)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
@@ -96,6 +97,10 @@ func (r *Reconciler) listGatewaysForGatewayClass(ctx context.Context, obj client
 		return
 	}
 
+	return r.listGatewaysForGatewayClassName(ctx, gatewayClass.Name)
+}
+
+func (r *Reconciler) listGatewaysForGatewayClassName(ctx context.Context, gatewayClassName string) (recs []reconcile.Request) {
 	gateways := new(gatewayv1.GatewayList)
 	if err := r.Client.List(ctx, gateways); err != nil {
 		ctrllog.FromContext(ctx).Error(err, "could not list gateways in map func")
@@ -103,7 +108,7 @@ func (r *Reconciler) listGatewaysForGatewayClass(ctx context.Context, obj client
 	}
 
 	for _, gateway := range gateways.Items {
-		if gateway.Spec.GatewayClassName == gatewayv1.ObjectName(gatewayClass.Name) {
+		if gateway.Spec.GatewayClassName == gatewayv1.ObjectName(gatewayClassName) {
 			recs = append(recs, reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Namespace: gateway.Namespace,
@@ -116,6 +121,64 @@ func (r *Reconciler) listGatewaysForGatewayClass(ctx context.Context, obj client
 	return
 }
 
+// listGatewaysForSharedObject is a watch predicate which finds all Gateways
+// merged onto a shared DataPlane when an object owned by their GatewayClass
+// (i.e. the shared DataPlane, ControlPlane or NetworkPolicy) changes.
+func (r *Reconciler) listGatewaysForSharedObject(ctx context.Context, obj client.Object) []reconcile.Request {
+	if obj.GetLabels()[consts.GatewayOperatorManagedByLabel] != consts.GatewayManagedLabelValue {
+		return nil
+	}
+
+	var recs []reconcile.Request
+	for _, owner := range obj.GetOwnerReferences() {
+		if strings.HasPrefix(owner.APIVersion, gatewayv1.GroupName) && owner.Kind == "GatewayClass" {
+			recs = append(recs, r.listGatewaysForGatewayClassName(ctx, owner.Name)...)
+		}
+	}
+	return recs
+}
+
+// listRelatedGatewaysForGateway is a watch predicate which finds all the other
+// Gateways whose reconciliation depends on the provided Gateway: Gateways merged
+// onto the same shared DataPlane and Gateways of the same GatewayClass in the
+// same namespace when the number of Gateways per namespace is limited.
+func (r *Reconciler) listRelatedGatewaysForGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := ctrllog.FromContext(ctx)
+
+	gateway, ok := obj.(*gwtypes.Gateway)
+	if !ok {
+		logger.Error(
+			operatorerrors.ErrUnexpectedObject,
+			"failed to run map funcs",
+			"expected", "Gateway", "found", reflect.TypeOf(obj),
+		)
+		return nil
+	}
+
+	gwc, err := gatewayclass.Get(ctx, r.Client, string(gateway.Spec.GatewayClassName))
+	if err != nil {
+		return nil
+	}
+	gatewayConfig, err := r.getOrCreateGatewayConfiguration(ctx, gwc.GatewayClass)
+	if err != nil {
+		return nil
+	}
+
+	var recs []reconcile.Request
+	switch {
+	case isDataPlaneShared(gatewayConfig):
+		recs = r.listGatewaysForGatewayClassName(ctx, gwc.Name)
+	case gatewayConfig.Spec.Guardrails != nil && gatewayConfig.Spec.Guardrails.MaxGatewaysPerNamespace != nil:
+		recs = lo.Filter(r.listGatewaysForGatewayClassName(ctx, gwc.Name), func(req reconcile.Request, _ int) bool {
+			return req.Namespace == gateway.Namespace
+		})
+	}
+
+	return lo.Reject(recs, func(req reconcile.Request, _ int) bool {
+		return req.NamespacedName == client.ObjectKeyFromObject(gateway)
+	})
+}
+
 func (r *Reconciler) listGatewaysForGatewayConfig(ctx context.Context, obj client.Object) []reconcile.Request {
 	logger := ctrllog.FromContext(ctx)
 
@@ -264,14 +327,44 @@ func (r *Reconciler) listGatewaysAttachedByHTTPRoute(ctx context.Context, obj cl
 		)
 		return nil
 	}
+	return r.listGatewaysForParentRefs(ctx, "HTTPRoute", httpRoute.Name, httpRoute.Spec.ParentRefs)
+}
+
+// listGatewaysAttachedByGRPCRoute is a watch predicate which finds all Gateways mentioned
+// in GRPCRoutes' Parents field.
+func (r *Reconciler) listGatewaysAttachedByGRPCRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := ctrllog.FromContext(ctx)
+
+	grpcRoute, ok := obj.(*gatewayv1.GRPCRoute)
+	if !ok {
+		logger.Error(
+			fmt.Errorf("unexpected object type"),
+			"GRPCRoute watch predicate received unexpected object type",
+			"expected", "*gatewayapi.GRPCRoute", "found", reflect.TypeOf(obj),
+		)
+		return nil
+	}
+	return r.listGatewaysForParentRefs(ctx, "GRPCRoute", grpcRoute.Name, grpcRoute.Spec.ParentRefs)
+}
+
+// listGatewaysForParentRefs returns reconcile requests for all Gateways
+// referenced by the provided route's ParentRefs.
+func (r *Reconciler) listGatewaysForParentRefs(
+	ctx context.Context,
+	routeKind string,
+	routeName string,
+	parentRefs []gatewayv1.ParentReference,
+) []reconcile.Request {
+	logger := ctrllog.FromContext(ctx)
+
 	gateways := &gatewayv1.GatewayList{}
 	if err := r.Client.List(ctx, gateways); err != nil {
-		logger.Error(err, "Failed to list gateways in watch", "HTTPRoute", httpRoute.Name)
+		logger.Error(err, "Failed to list gateways in watch", routeKind, routeName)
 		return nil
 	}
 	var recs []reconcile.Request
 	for _, gateway := range gateways.Items {
-		for _, parentRef := range httpRoute.Spec.ParentRefs {
+		for _, parentRef := range parentRefs {
 			if parentRef.Group != nil && string(*parentRef.Group) == gatewayv1.GroupName &&
 				parentRef.Kind != nil && string(*parentRef.Kind) == "Gateway" &&
 				string(parentRef.Name) == gateway.Name {
@@ -297,7 +390,8 @@ func (r *Reconciler) setDataPlaneGatewayConfigDefaults(gatewayConfig *operatorv1
 	}
 }
 
-func (r *Reconciler) setControlPlaneGatewayConfigDefaults(gateway *gwtypes.Gateway,
+func (r *Reconciler) setControlPlaneGatewayConfigDefaults(
+	target provisioningTarget,
 	gatewayConfig *operatorv1beta1.GatewayConfiguration,
 	dataplaneName,
 	dataplaneIngressServiceName,
@@ -338,10 +432,10 @@ func (r *Reconciler) setControlPlaneGatewayConfigDefaults(gateway *gwtypes.Gatew
 	// satisfy the signature
 	_ = controlplane.SetDefaults(gatewayConfig.Spec.ControlPlaneOptions,
 		controlplane.DefaultsArgs{
-			Namespace:                   gateway.Namespace,
+			Namespace:                   target.namespace,
 			DataPlaneIngressServiceName: dataplaneIngressServiceName,
 			DataPlaneAdminServiceName:   dataplaneAdminServiceName,
-			OwnedByGateway:              gateway.Name,
+			OwnedByGateway:              target.ownedByGateway(),
 			ControlPlaneName:            controlPlaneName,
 			AnonymousReportsEnabled:     controlplane.DeduceAnonymousReportsEnabled(r.DevelopmentMode, gatewayConfig.Spec.ControlPlaneOptions),
 		})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -55,9 +56,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	oldGwc := gwc.DeepCopy()
 	if !gwc.IsAccepted() {
-		oldGwc := gwc.DeepCopy()
-
 		k8sutils.SetCondition(
 			k8sutils.NewConditionWithGeneration(
 				consts.ConditionType(gatewayv1.GatewayClassConditionStatusAccepted),
@@ -68,6 +68,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			),
 			gwc,
 		)
+	}
+	// Advertise the Gateway API features supported by the operator so that
+	// clients can discover them.
+	gwc.Status.SupportedFeatures = gatewayclass.SupportedFeaturesStatus(gatewayclass.SupportedFeatures())
+
+	if !equality.Semantic.DeepEqual(oldGwc.Status, gwc.Status) {
 		if err := r.Status().Patch(ctx, gwc.GatewayClass, client.MergeFrom(oldGwc)); err != nil {
 			if k8serrors.IsConflict(err) {
 				log.Debug(logger, "conflict found when updating GatewayClass, retrying", gwc.GatewayClass)
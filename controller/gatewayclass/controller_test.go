@@ -91,6 +91,35 @@ func TestGatewayClassReconciler_Reconcile(t *testing.T) {
 				err = reconciler.Client.Get(ctx, gatewayClassReq.NamespacedName, gwc.GatewayClass)
 				require.NoError(t, err)
 				require.True(t, gwc.IsAccepted())
+				require.Equal(t,
+					gatewayclass.SupportedFeaturesStatus(gatewayclass.SupportedFeatures()),
+					gwc.Status.SupportedFeatures,
+				)
+			},
+		},
+		{
+			name: "gatewayclass not controlled by the operator does not advertise supported features",
+			gatewayClassReq: reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: "test-gatewayclass",
+				},
+			},
+			gatewayClass: &gatewayv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-gatewayclass",
+				},
+				Spec: gatewayv1.GatewayClassSpec{
+					ControllerName: gatewayv1.GatewayController("mismatch-controller-name"),
+				},
+			},
+			testBody: func(t *testing.T, reconciler Reconciler, gatewayClassReq reconcile.Request, gatewayClass *gatewayv1.GatewayClass) {
+				ctx := context.Background()
+				_, err := reconciler.Reconcile(ctx, gatewayClassReq)
+				require.NoError(t, err)
+				gwc := gatewayclass.NewDecorator()
+				err = reconciler.Client.Get(ctx, gatewayClassReq.NamespacedName, gwc.GatewayClass)
+				require.NoError(t, err)
+				require.Empty(t, gwc.Status.SupportedFeatures)
 			},
 		},
 	}
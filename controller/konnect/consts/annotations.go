@@ -10,3 +10,68 @@ const (
 	// AnnotationTags is the key for the tags annotation.
 	AnnotationTags = AnnotationPrefix + UserTagKey
 )
+
+const (
+	// AnnotationDeletionPolicy is the key for the annotation which sets the
+	// deletion policy of Konnect entities managed by the annotated object.
+	// Allowed values are DeletionPolicyDelete (default) and DeletionPolicyOrphan.
+	//
+	// The policy applies to the annotated object only. Dependent objects
+	// (e.g. KongRoutes of a KongService) have to be annotated as well.
+	AnnotationDeletionPolicy = "konnect.konghq.com/deletion-policy"
+
+	// DeletionPolicyDelete makes the operator delete the entity from Konnect
+	// when the object managing it is deleted.
+	DeletionPolicyDelete = "delete"
+
+	// DeletionPolicyOrphan makes the operator leave the entity in Konnect when
+	// the object managing it is deleted. Kubernetes metadata tags (or labels)
+	// are removed from the entity so that it is no longer associated with the
+	// deleted object.
+	DeletionPolicyOrphan = "orphan"
+)
+
+const (
+	// AnnotationSyncMode is the key for the annotation which sets the mode in
+	// which entities referencing the annotated KonnectGatewayControlPlane are
+	// synchronized with Konnect.
+	// Allowed values are SyncModeEntity (default) and SyncModeBulk.
+	AnnotationSyncMode = "konnect.konghq.com/sync-mode"
+
+	// SyncModeEntity makes the operator synchronize each entity separately,
+	// using dedicated reconcilers and Konnect API calls per entity.
+	SyncModeEntity = "entity"
+
+	// SyncModeBulk makes the operator aggregate the entities referencing the
+	// ControlPlane into a single snapshot, diff it against the state in Konnect
+	// and apply only the changes, including deletions, in batches.
+	// Credentials and DataPlane client certificates keep being synchronized separately.
+	SyncModeBulk = "bulk"
+)
+
+const (
+	// AnnotationTokenSecretKey is the key for the annotation which can be set on
+	// KonnectAPIAuthConfigurations of secretRef type to specify the key of the
+	// referenced Secret which holds the token. It defaults to "token".
+	// It allows external token rotators, which write the token under their own key,
+	// to update the token used by the operator.
+	AnnotationTokenSecretKey = "konnect.konghq.com/token-secret-key"
+
+	// AnnotationTokenExpiresAt is the key for the annotation which holds the
+	// expiry time of the Konnect token, in RFC 3339 format.
+	// It is read from the object holding the token: the KonnectAPIAuthConfiguration
+	// for the token type or the referenced Secret for the secretRef type.
+	AnnotationTokenExpiresAt = "konnect.konghq.com/token-expires-at"
+
+	// AnnotationSystemAccountID is the key for the annotation which holds the
+	// Konnect ID of the system account the token was issued for. Along with
+	// AnnotationSystemAccountTokenID, it allows reading the token's expiry
+	// from the system account access token metadata in Konnect.
+	// It is read from the object holding the token.
+	AnnotationSystemAccountID = "konnect.konghq.com/system-account-id"
+
+	// AnnotationSystemAccountTokenID is the key for the annotation which holds the
+	// Konnect ID of the system account access token.
+	// It is read from the object holding the token.
+	AnnotationSystemAccountTokenID = "konnect.konghq.com/system-account-token-id"
+)
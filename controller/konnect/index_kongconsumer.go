@@ -13,6 +13,8 @@ const (
 	IndexFieldKongConsumerOnKongConsumerGroup = "consumerGroupRef"
 	// IndexFieldKongConsumerOnPlugin is the index field for KongConsumer -> KongPlugin.
 	IndexFieldKongConsumerOnPlugin = "consumerPluginRef"
+	// IndexFieldKongConsumerOnClusterPlugin is the index field for KongConsumer -> KongClusterPlugin.
+	IndexFieldKongConsumerOnClusterPlugin = "consumerClusterPluginRef"
 	// IndexFieldKongConsumerOnKonnectGatewayControlPlane is the index field for KongConsumer -> KonnectGatewayControlPlane.
 	IndexFieldKongConsumerOnKonnectGatewayControlPlane = "consumerKonnectGatewayControlPlaneRef"
 )
@@ -30,6 +32,11 @@ func IndexOptionsForKongConsumer() []ReconciliationIndexOption {
 			IndexField:   IndexFieldKongConsumerOnPlugin,
 			ExtractValue: kongConsumerReferencesKongPluginsViaAnnotation,
 		},
+		{
+			IndexObject:  &configurationv1.KongConsumer{},
+			IndexField:   IndexFieldKongConsumerOnClusterPlugin,
+			ExtractValue: kongConsumerReferencesKongClusterPluginsViaAnnotation,
+		},
 		{
 			IndexObject:  &configurationv1.KongConsumer{},
 			IndexField:   IndexFieldKongConsumerOnKonnectGatewayControlPlane,
@@ -54,6 +61,14 @@ func kongConsumerReferencesKongPluginsViaAnnotation(object client.Object) []stri
 	return annotations.ExtractPluginsWithNamespaces(consumer)
 }
 
+func kongConsumerReferencesKongClusterPluginsViaAnnotation(object client.Object) []string {
+	consumer, ok := object.(*configurationv1.KongConsumer)
+	if !ok {
+		return nil
+	}
+	return annotations.ExtractPlugins(consumer)
+}
+
 func kongConsumerReferencesKonnectGatewayControlPlane(object client.Object) []string {
 	consumer, ok := object.(*configurationv1.KongConsumer)
 	if !ok {
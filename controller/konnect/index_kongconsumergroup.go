@@ -11,6 +11,8 @@ import (
 const (
 	// IndexFieldKongConsumerGroupOnPlugin is the index field for KongConsumerGroup -> KongPlugin.
 	IndexFieldKongConsumerGroupOnPlugin = "consumerGroupPluginRef"
+	// IndexFieldKongConsumerGroupOnClusterPlugin is the index field for KongConsumerGroup -> KongClusterPlugin.
+	IndexFieldKongConsumerGroupOnClusterPlugin = "consumerGroupClusterPluginRef"
 	// IndexFieldKongConsumerGroupOnKonnectGatewayControlPlane is the index field for KongConsumerGroup -> KonnectGatewayControlPlane.
 	IndexFieldKongConsumerGroupOnKonnectGatewayControlPlane = "consumerGroupKonnectGatewayControlPlaneRef"
 )
@@ -23,6 +25,11 @@ func IndexOptionsForKongConsumerGroup() []ReconciliationIndexOption {
 			IndexField:   IndexFieldKongConsumerGroupOnPlugin,
 			ExtractValue: kongConsumerGroupReferencesKongPluginsViaAnnotation,
 		},
+		{
+			IndexObject:  &configurationv1beta1.KongConsumerGroup{},
+			IndexField:   IndexFieldKongConsumerGroupOnClusterPlugin,
+			ExtractValue: kongConsumerGroupReferencesKongClusterPluginsViaAnnotation,
+		},
 		{
 			IndexObject:  &configurationv1beta1.KongConsumerGroup{},
 			IndexField:   IndexFieldKongConsumerGroupOnKonnectGatewayControlPlane,
@@ -39,6 +46,14 @@ func kongConsumerGroupReferencesKongPluginsViaAnnotation(object client.Object) [
 	return annotations.ExtractPluginsWithNamespaces(consumerGroup)
 }
 
+func kongConsumerGroupReferencesKongClusterPluginsViaAnnotation(object client.Object) []string {
+	consumerGroup, ok := object.(*configurationv1beta1.KongConsumerGroup)
+	if !ok {
+		return nil
+	}
+	return annotations.ExtractPlugins(consumerGroup)
+}
+
 func kongConsumerGroupReferencesKonnectGatewayControlPlane(object client.Object) []string {
 	group, ok := object.(*configurationv1beta1.KongConsumerGroup)
 	if !ok {
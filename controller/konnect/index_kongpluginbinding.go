@@ -9,6 +9,8 @@ import (
 const (
 	// IndexFieldKongPluginBindingKongPluginReference is the index field for KongPluginBinding -> KongPlugin.
 	IndexFieldKongPluginBindingKongPluginReference = "kongPluginBindingPluginRef"
+	// IndexFieldKongPluginBindingKongClusterPluginReference is the index field for KongPluginBinding -> KongClusterPlugin.
+	IndexFieldKongPluginBindingKongClusterPluginReference = "kongPluginBindingClusterPluginRef"
 	// IndexFieldKongPluginBindingKongServiceReference is the index field for KongPluginBinding -> KongService.
 	IndexFieldKongPluginBindingKongServiceReference = "kongPluginBindingServiceRef"
 	// IndexFieldKongPluginBindingKongRouteReference is the index field for KongPluginBinding -> KongRoute.
@@ -29,6 +31,11 @@ func IndexOptionsForKongPluginBinding() []ReconciliationIndexOption {
 			IndexField:   IndexFieldKongPluginBindingKongPluginReference,
 			ExtractValue: kongPluginReferencesFromKongPluginBinding,
 		},
+		{
+			IndexObject:  &configurationv1alpha1.KongPluginBinding{},
+			IndexField:   IndexFieldKongPluginBindingKongClusterPluginReference,
+			ExtractValue: kongClusterPluginReferencesFromKongPluginBinding,
+		},
 		{
 			IndexObject:  &configurationv1alpha1.KongPluginBinding{},
 			IndexField:   IndexFieldKongPluginBindingKongServiceReference,
@@ -69,6 +76,18 @@ func kongPluginReferencesFromKongPluginBinding(obj client.Object) []string {
 	return []string{binding.Namespace + "/" + binding.Spec.PluginReference.Name}
 }
 
+// kongClusterPluginReferencesFromKongPluginBinding returns name of referenced KongClusterPlugin in KongPluginBinding spec.
+func kongClusterPluginReferencesFromKongPluginBinding(obj client.Object) []string {
+	binding, ok := obj.(*configurationv1alpha1.KongPluginBinding)
+	if !ok {
+		return nil
+	}
+	if binding.Spec.PluginReference.Kind == nil || *binding.Spec.PluginReference.Kind != "KongClusterPlugin" {
+		return nil
+	}
+	return []string{binding.Spec.PluginReference.Name}
+}
+
 // kongServiceReferencesFromKongPluginBinding returns name of referenced KongService in KongPluginBinding spec.
 func kongServiceReferencesFromKongPluginBinding(obj client.Object) []string {
 	binding, ok := obj.(*configurationv1alpha1.KongPluginBinding)
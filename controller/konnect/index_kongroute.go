@@ -11,6 +11,8 @@ import (
 const (
 	// IndexFieldKongRouteOnReferencedPluginNames is the index field for KongRoute -> KongPlugin.
 	IndexFieldKongRouteOnReferencedPluginNames = "kongRouteKongPluginRef"
+	// IndexFieldKongRouteOnReferencedClusterPluginNames is the index field for KongRoute -> KongClusterPlugin.
+	IndexFieldKongRouteOnReferencedClusterPluginNames = "kongRouteKongClusterPluginRef"
 	// IndexFieldKongRouteOnReferencedKongService is the index field for KongRoute -> KongService.
 	IndexFieldKongRouteOnReferencedKongService = "kongRouteKongServiceRef"
 )
@@ -23,6 +25,11 @@ func IndexOptionsForKongRoute() []ReconciliationIndexOption {
 			IndexField:   IndexFieldKongRouteOnReferencedPluginNames,
 			ExtractValue: kongRouteUsesPlugins,
 		},
+		{
+			IndexObject:  &configurationv1alpha1.KongRoute{},
+			IndexField:   IndexFieldKongRouteOnReferencedClusterPluginNames,
+			ExtractValue: kongRouteUsesClusterPlugins,
+		},
 		{
 			IndexObject:  &configurationv1alpha1.KongRoute{},
 			IndexField:   IndexFieldKongRouteOnReferencedKongService,
@@ -39,6 +46,14 @@ func kongRouteUsesPlugins(object client.Object) []string {
 	return annotations.ExtractPluginsWithNamespaces(route)
 }
 
+func kongRouteUsesClusterPlugins(object client.Object) []string {
+	route, ok := object.(*configurationv1alpha1.KongRoute)
+	if !ok {
+		return nil
+	}
+	return annotations.ExtractPlugins(route)
+}
+
 func kongRouteRefersToKongService(object client.Object) []string {
 	route, ok := object.(*configurationv1alpha1.KongRoute)
 	if !ok {
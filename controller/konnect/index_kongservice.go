@@ -11,6 +11,8 @@ import (
 const (
 	// IndexFieldKongServiceOnReferencedPluginNames is the index field for KongService -> KongPlugin.
 	IndexFieldKongServiceOnReferencedPluginNames = "kongServiceKongPluginRef"
+	// IndexFieldKongServiceOnReferencedClusterPluginNames is the index field for KongService -> KongClusterPlugin.
+	IndexFieldKongServiceOnReferencedClusterPluginNames = "kongServiceKongClusterPluginRef"
 	// IndexFieldKongServiceOnKonnectGatewayControlPlane is the index field for KongService -> KonnectGatewayControlPlane.
 	IndexFieldKongServiceOnKonnectGatewayControlPlane = "kongServiceKonnectGatewayControlPlaneRef"
 )
@@ -23,6 +25,11 @@ func IndexOptionsForKongService() []ReconciliationIndexOption {
 			IndexField:   IndexFieldKongServiceOnReferencedPluginNames,
 			ExtractValue: kongServiceUsesPlugins,
 		},
+		{
+			IndexObject:  &configurationv1alpha1.KongService{},
+			IndexField:   IndexFieldKongServiceOnReferencedClusterPluginNames,
+			ExtractValue: kongServiceUsesClusterPlugins,
+		},
 		{
 			IndexObject:  &configurationv1alpha1.KongService{},
 			IndexField:   IndexFieldKongServiceOnKonnectGatewayControlPlane,
@@ -40,6 +47,15 @@ func kongServiceUsesPlugins(object client.Object) []string {
 	return annotations.ExtractPluginsWithNamespaces(svc)
 }
 
+func kongServiceUsesClusterPlugins(object client.Object) []string {
+	svc, ok := object.(*configurationv1alpha1.KongService)
+	if !ok {
+		return nil
+	}
+
+	return annotations.ExtractPlugins(svc)
+}
+
 func kongServiceReferencesKonnectGatewayControlPlane(object client.Object) []string {
 	svc, ok := object.(*configurationv1alpha1.KongService)
 	if !ok {
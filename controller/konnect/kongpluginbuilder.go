@@ -3,6 +3,7 @@ package konnect
 import (
 	"fmt"
 
+	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -46,6 +47,14 @@ func (b *KongPluginBindingBuilder) WithPluginRef(pluginName string) *KongPluginB
 	return b
 }
 
+// WithClusterPluginRef sets the plugin reference of the KongPluginBinding to the KongClusterPlugin
+// with the provided name.
+func (b *KongPluginBindingBuilder) WithClusterPluginRef(pluginName string) *KongPluginBindingBuilder {
+	b.binding.Spec.PluginReference.Name = pluginName
+	b.binding.Spec.PluginReference.Kind = lo.ToPtr("KongClusterPlugin")
+	return b
+}
+
 // WithControlPlaneRef sets the control plane reference of the KongPluginBinding.
 // NOTE: Users have to ensure that the ControlPlaneRef that's set here
 // is the same across all the KongPluginBinding targets.
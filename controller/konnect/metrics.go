@@ -0,0 +1,171 @@
+package konnect
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+const (
+	// entitiesCollectorListTimeout is the timeout for listing the objects
+	// when collecting the metrics.
+	entitiesCollectorListTimeout = 10 * time.Second
+
+	programmedTrue    = "true"
+	programmedFalse   = "false"
+	programmedUnknown = "unknown"
+)
+
+// RegisterEntitiesMetrics registers the collector of the number of Konnect
+// entities per Programmed state in the controller-runtime metrics registry.
+// The provided reader should be backed by the manager's cache so that
+// scraping the metrics does not generate requests to the API server.
+func RegisterEntitiesMetrics(cl client.Reader) error {
+	err := metrics.Registry.Register(newEntitiesCollector(cl))
+	if errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+		return nil
+	}
+	return err
+}
+
+// entitiesCollector collects the number of Konnect entities per entity type,
+// Konnect ControlPlane and Programmed condition status.
+type entitiesCollector struct {
+	cl    client.Reader
+	desc  *prometheus.Desc
+	lists []entityList
+}
+
+// entityList is a list type of a Konnect entity.
+type entityList struct {
+	entityType string
+	list       func() client.ObjectList
+}
+
+var _ prometheus.Collector = (*entitiesCollector)(nil)
+
+func newEntitiesCollector(cl client.Reader) *entitiesCollector {
+	return &entitiesCollector{
+		cl: cl,
+		desc: prometheus.NewDesc(
+			"gateway_operator_konnect_entities",
+			"Number of Konnect entities per Programmed condition status.",
+			[]string{"entity_type", "control_plane_id", "programmed"},
+			nil,
+		),
+		lists: []entityList{
+			{"KonnectGatewayControlPlane", func() client.ObjectList { return &konnectv1alpha1.KonnectGatewayControlPlaneList{} }},
+			{"KongService", func() client.ObjectList { return &configurationv1alpha1.KongServiceList{} }},
+			{"KongRoute", func() client.ObjectList { return &configurationv1alpha1.KongRouteList{} }},
+			{"KongConsumer", func() client.ObjectList { return &configurationv1.KongConsumerList{} }},
+			{"KongConsumerGroup", func() client.ObjectList { return &configurationv1beta1.KongConsumerGroupList{} }},
+			{"KongPluginBinding", func() client.ObjectList { return &configurationv1alpha1.KongPluginBindingList{} }},
+			{"KongCredentialBasicAuth", func() client.ObjectList { return &configurationv1alpha1.KongCredentialBasicAuthList{} }},
+			{"KongCredentialAPIKey", func() client.ObjectList { return &configurationv1alpha1.KongCredentialAPIKeyList{} }},
+			{"KongCredentialACL", func() client.ObjectList { return &configurationv1alpha1.KongCredentialACLList{} }},
+			{"KongCredentialJWT", func() client.ObjectList { return &configurationv1alpha1.KongCredentialJWTList{} }},
+			{"KongCredentialHMAC", func() client.ObjectList { return &configurationv1alpha1.KongCredentialHMACList{} }},
+			{"KongUpstream", func() client.ObjectList { return &configurationv1alpha1.KongUpstreamList{} }},
+			{"KongTarget", func() client.ObjectList { return &configurationv1alpha1.KongTargetList{} }},
+			{"KongCACertificate", func() client.ObjectList { return &configurationv1alpha1.KongCACertificateList{} }},
+			{"KongCertificate", func() client.ObjectList { return &configurationv1alpha1.KongCertificateList{} }},
+			{"KongSNI", func() client.ObjectList { return &configurationv1alpha1.KongSNIList{} }},
+			{"KongKey", func() client.ObjectList { return &configurationv1alpha1.KongKeyList{} }},
+			{"KongKeySet", func() client.ObjectList { return &configurationv1alpha1.KongKeySetList{} }},
+			{"KongVault", func() client.ObjectList { return &configurationv1alpha1.KongVaultList{} }},
+			{"KongDataPlaneClientCertificate", func() client.ObjectList { return &configurationv1alpha1.KongDataPlaneClientCertificateList{} }},
+		},
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *entitiesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *entitiesCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), entitiesCollectorListTimeout)
+	defer cancel()
+
+	for _, l := range c.lists {
+		counts, err := c.count(ctx, l.list())
+		if err != nil {
+			ctrllog.Log.Error(err, "failed to collect Konnect entities metrics", "entity_type", l.entityType)
+			continue
+		}
+		for k, n := range counts {
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue,
+				float64(n), l.entityType, k.controlPlaneID, k.programmed,
+			)
+		}
+	}
+}
+
+type conditionsAwareObject interface {
+	client.Object
+	k8sutils.ConditionsAware
+}
+
+type entitiesCountKey struct {
+	controlPlaneID string
+	programmed     string
+}
+
+// count returns the number of objects of the provided list type per Konnect
+// ControlPlane and Programmed condition status.
+func (c *entitiesCollector) count(ctx context.Context, list client.ObjectList) (map[entitiesCountKey]int, error) {
+	if err := c.cl.List(ctx, list); err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entitiesCountKey]int)
+	for _, item := range items {
+		obj, ok := item.(conditionsAwareObject)
+		if !ok {
+			continue
+		}
+		counts[entitiesCountKey{
+			controlPlaneID: ops.ControlPlaneIDForMetrics(item),
+			programmed:     programmedStatusForMetrics(obj),
+		}]++
+	}
+	return counts, nil
+}
+
+// programmedStatusForMetrics returns the status of the object's Programmed
+// condition, taking into account whether it's been observed for the object's
+// current generation.
+func programmedStatusForMetrics(obj conditionsAwareObject) string {
+	cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, obj)
+	if !ok || cond.ObservedGeneration != obj.GetGeneration() {
+		return programmedUnknown
+	}
+	switch cond.Status {
+	case metav1.ConditionTrue:
+		return programmedTrue
+	case metav1.ConditionFalse:
+		return programmedFalse
+	default:
+		return programmedUnknown
+	}
+}
@@ -0,0 +1,81 @@
+package konnect
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func TestEntitiesCollector(t *testing.T) {
+	service := func(name, cpID string, generation int64, conditions ...metav1.Condition) *configurationv1alpha1.KongService {
+		return &configurationv1alpha1.KongService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "default",
+				Generation: generation,
+			},
+			Status: configurationv1alpha1.KongServiceStatus{
+				Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneRef{
+					ControlPlaneID: cpID,
+				},
+				Conditions: conditions,
+			},
+		}
+	}
+	programmed := func(status metav1.ConditionStatus) metav1.Condition {
+		return metav1.Condition{
+			Type:               konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			Status:             status,
+			ObservedGeneration: 1,
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, configurationv1.AddToScheme(scheme))
+	require.NoError(t, configurationv1alpha1.AddToScheme(scheme))
+	require.NoError(t, configurationv1beta1.AddToScheme(scheme))
+	require.NoError(t, konnectv1alpha1.AddToScheme(scheme))
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&konnectv1alpha1.KonnectGatewayControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "cp",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Status: konnectv1alpha1.KonnectGatewayControlPlaneStatus{
+					KonnectEntityStatus: konnectv1alpha1.KonnectEntityStatus{
+						ID: "cp-1",
+					},
+					Conditions: []metav1.Condition{programmed(metav1.ConditionTrue)},
+				},
+			},
+			service("svc-1", "cp-1", 1, programmed(metav1.ConditionTrue)),
+			service("svc-2", "cp-1", 1, programmed(metav1.ConditionTrue)),
+			service("svc-3", "cp-1", 1, programmed(metav1.ConditionFalse)),
+			service("svc-outdated", "cp-1", 2, programmed(metav1.ConditionTrue)),
+			service("svc-new", "", 1),
+		).
+		Build()
+
+	require.NoError(t, testutil.CollectAndCompare(newEntitiesCollector(cl), strings.NewReader(`
+# HELP gateway_operator_konnect_entities Number of Konnect entities per Programmed condition status.
+# TYPE gateway_operator_konnect_entities gauge
+gateway_operator_konnect_entities{control_plane_id="cp-1",entity_type="KonnectGatewayControlPlane",programmed="true"} 1
+gateway_operator_konnect_entities{control_plane_id="cp-1",entity_type="KongService",programmed="true"} 2
+gateway_operator_konnect_entities{control_plane_id="cp-1",entity_type="KongService",programmed="false"} 1
+gateway_operator_konnect_entities{control_plane_id="cp-1",entity_type="KongService",programmed="unknown"} 1
+gateway_operator_konnect_entities{control_plane_id="",entity_type="KongService",programmed="unknown"} 1
+`)))
+}
@@ -0,0 +1,598 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kong/gateway-operator/controller/konnect/constraints"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+const (
+	// DefaultBulkSyncBatchSize is the default number of Konnect API operations
+	// performed concurrently in a single batch during a bulk sync.
+	DefaultBulkSyncBatchSize = 20
+
+	// bulkSyncPageSize is the page size used when listing entities in Konnect
+	// to build the snapshot of the ControlPlane's state.
+	bulkSyncPageSize int64 = 1000
+)
+
+// BulkSyncChange describes an entity taking part in a bulk sync of a ControlPlane
+// together with the operation required to bring it to its desired state in Konnect.
+type BulkSyncChange[T constraints.SupportedKonnectEntityType] struct {
+	// Entity is the object which manages the Konnect entity.
+	Entity *T
+	// Op is the operation to perform in Konnect: CreateOp, UpdateOp, DeleteOp
+	// or OrphanOp. It is empty when the entity in Konnect is up to date.
+	Op Op
+	// Err is the error of the operation, set by ApplyBulkSync.
+	Err error
+}
+
+// IsBulkSyncSupported returns true when entities of the given type can be
+// synchronized as part of a ControlPlane bulk sync.
+// Entities of other types are synchronized by their KonnectEntityReconcilers
+// regardless of the ControlPlane's sync mode.
+func IsBulkSyncSupported[T constraints.SupportedKonnectEntityType]() bool {
+	var e T
+	switch any(&e).(type) {
+	case *configurationv1alpha1.KongService,
+		*configurationv1alpha1.KongRoute,
+		*configurationv1alpha1.KongUpstream,
+		*configurationv1alpha1.KongTarget,
+		*configurationv1.KongConsumer,
+		*configurationv1beta1.KongConsumerGroup,
+		*configurationv1alpha1.KongPluginBinding,
+		*configurationv1alpha1.KongCertificate,
+		*configurationv1alpha1.KongCACertificate,
+		*configurationv1alpha1.KongSNI,
+		*configurationv1alpha1.KongVault,
+		*configurationv1alpha1.KongKey,
+		*configurationv1alpha1.KongKeySet:
+		return true
+	default:
+		return false
+	}
+}
+
+// PlanBulkSync lists all the entities of the given type which exist in the
+// Konnect ControlPlane and compares them against the desired state of the provided
+// objects. Entities are matched with objects using the "k8s-uid:<uid>" tag.
+// Matched objects get the Konnect ID of their entity set in the status.
+// Objects whose desired state can't be determined (e.g. KongPluginBindings
+// with targets not created in Konnect yet) are planned for an update which
+// reports the error in their status.
+// It returns the changes which have to be applied to Konnect.
+func PlanBulkSync[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](
+	ctx context.Context,
+	sdk SDKWrapper,
+	cl client.Client,
+	cpID string,
+	ents []*T,
+) ([]BulkSyncChange[T], error) {
+	actual, err := listEntitiesForBulkSync(ctx, sdk, cpID, ents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s entities in Konnect ControlPlane %s: %w",
+			constraints.EntityTypeName[T](), cpID, err,
+		)
+	}
+
+	changes := make([]BulkSyncChange[T], 0, len(ents))
+	for _, e := range ents {
+		ent := TEnt(e)
+		entry, ok := actual[string(ent.GetUID())]
+		if !ok {
+			changes = append(changes, BulkSyncChange[T]{Entity: e, Op: CreateOp})
+			continue
+		}
+
+		ent.GetKonnectStatus().SetKonnectID(entry.id)
+		change := BulkSyncChange[T]{Entity: e, Op: UpdateOp}
+		desired, err := desiredStateForBulkSync(ctx, cl, e)
+		if err != nil {
+			changes = append(changes, change)
+			continue
+		}
+		upToDate, err := isUpToDate(desired, entry.entity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s %s with its Konnect entity: %w",
+				constraints.EntityTypeName[T](), client.ObjectKeyFromObject(ent), err,
+			)
+		}
+		// KongConsumers' ConsumerGroup memberships are not part of their
+		// Konnect entities so changes which are not programmed yet are applied
+		// regardless.
+		if _, ok := any(e).(*configurationv1.KongConsumer); ok && !isProgrammed(ent) {
+			upToDate = false
+		}
+		if upToDate {
+			change.Op = ""
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// ApplyBulkSync applies the provided changes to Konnect.
+// Operations are performed in batches of batchSize concurrent operations.
+// The result of each operation is reported in the Programmed condition of
+// the respective object and its error is stored in the change.
+// Up to date objects are marked as Programmed.
+// It returns the joined errors of all failed operations.
+func ApplyBulkSync[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](
+	ctx context.Context,
+	sdk SDKWrapper,
+	cl client.Client,
+	changes []BulkSyncChange[T],
+	batchSize int,
+) error {
+	if batchSize <= 0 {
+		batchSize = DefaultBulkSyncBatchSize
+	}
+
+	pending := make([]int, 0, len(changes))
+	for i, c := range changes {
+		if c.Op == "" {
+			recordUpToDate[T, TEnt](c.Entity)
+			// Only touch the condition when it changes to prevent status
+			// updates of up to date objects on every sync.
+			if ent := TEnt(c.Entity); !isProgrammed(ent) {
+				SetKonnectEntityProgrammedCondition(ent)
+			}
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	logger := ctrllog.FromContext(ctx)
+	for start := 0; start < len(pending); start += batchSize {
+		var (
+			end = min(start+batchSize, len(pending))
+			wg  sync.WaitGroup
+		)
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			go func(c *BulkSyncChange[T]) {
+				defer wg.Done()
+				var (
+					ent    = TEnt(c.Entity)
+					opCtx  = ctrllog.IntoContext(ctx, logger.WithValues("entity", client.ObjectKeyFromObject(ent)))
+					opTime = time.Now()
+					err    error
+				)
+				// NOTE: Delete and Orphan log their outcome themselves.
+				switch c.Op {
+				case CreateOp:
+					err = create[T, TEnt](opCtx, sdk, cl, c.Entity)
+					logOpComplete[T, TEnt](opCtx, opTime, c.Op, ent, err)
+				case UpdateOp:
+					err = update[T, TEnt](opCtx, sdk, cl, c.Entity)
+					logOpComplete[T, TEnt](opCtx, opTime, c.Op, ent, err)
+				case DeleteOp:
+					err = Delete[T, TEnt](opCtx, sdk, cl, c.Entity)
+				case OrphanOp:
+					err = Orphan[T, TEnt](opCtx, sdk, cl, c.Entity)
+				default:
+					err = fmt.Errorf("unsupported bulk sync operation %q", c.Op)
+				}
+				if err != nil {
+					SetKonnectEntityProgrammedConditionFalse(ent,
+						konnectv1alpha1.KonnectEntityProgrammedReasonKonnectAPIOpFailed, err.Error(),
+					)
+					c.Err = err
+				}
+			}(&changes[pending[i]])
+		}
+		wg.Wait()
+	}
+
+	return errors.Join(lo.Map(changes, func(c BulkSyncChange[T], _ int) error { return c.Err })...)
+}
+
+// isProgrammed returns true when the object has the Programmed condition set
+// to True for its current generation.
+func isProgrammed(ent entityType) bool {
+	cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, ent)
+	return ok &&
+		cond.Status == metav1.ConditionTrue &&
+		cond.Reason == konnectv1alpha1.KonnectEntityProgrammedReasonProgrammed &&
+		cond.ObservedGeneration == ent.GetGeneration()
+}
+
+// bulkSyncEntry is an entity existing in Konnect, as returned by the list operation.
+type bulkSyncEntry struct {
+	id     string
+	entity any
+}
+
+// listEntitiesForBulkSync lists all entities of the given type in the Konnect
+// ControlPlane and returns the ones created for Kubernetes objects, keyed by
+// the objects' UIDs.
+// Entities nested under other entities (i.e. Targets) are listed for the
+// parent entities of the provided objects only.
+func listEntitiesForBulkSync[T constraints.SupportedKonnectEntityType](
+	ctx context.Context,
+	sdk SDKWrapper,
+	cpID string,
+	ents []*T,
+) (map[string]bulkSyncEntry, error) {
+	var e T
+	switch any(&e).(type) {
+	case *configurationv1alpha1.KongService:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Service, *string, error) {
+			resp, err := sdk.GetServicesSDK().ListService(ctx, sdkkonnectops.ListServiceRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongRoute:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Route, *string, error) {
+			resp, err := sdk.GetRoutesSDK().ListRoute(ctx, sdkkonnectops.ListRouteRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongUpstream:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Upstream, *string, error) {
+			resp, err := sdk.GetUpstreamsSDK().ListUpstream(ctx, sdkkonnectops.ListUpstreamRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1.KongConsumer:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Consumer, *string, error) {
+			resp, err := sdk.GetConsumersSDK().ListConsumer(ctx, sdkkonnectops.ListConsumerRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1beta1.KongConsumerGroup:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.ConsumerGroup, *string, error) {
+			resp, err := sdk.GetConsumerGroupsSDK().ListConsumerGroup(ctx, sdkkonnectops.ListConsumerGroupRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongPluginBinding:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Plugin, *string, error) {
+			resp, err := sdk.GetPluginSDK().ListPlugin(ctx, sdkkonnectops.ListPluginRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongCertificate:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Certificate, *string, error) {
+			resp, err := sdk.GetCertificatesSDK().ListCertificate(ctx, sdkkonnectops.ListCertificateRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongCACertificate:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.CACertificate, *string, error) {
+			resp, err := sdk.GetCACertificatesSDK().ListCaCertificate(ctx, sdkkonnectops.ListCaCertificateRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongSNI:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Sni, *string, error) {
+			resp, err := sdk.GetSNIsSDK().ListSni(ctx, sdkkonnectops.ListSniRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongVault:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Vault, *string, error) {
+			resp, err := sdk.GetVaultSDK().ListVault(ctx, sdkkonnectops.ListVaultRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongKey:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Key, *string, error) {
+			resp, err := sdk.GetKeysSDK().ListKey(ctx, sdkkonnectops.ListKeyRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongKeySet:
+		return listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.KeySet, *string, error) {
+			resp, err := sdk.GetKeySetsSDK().ListKeySet(ctx, sdkkonnectops.ListKeySetRequest{
+				ControlPlaneID: cpID,
+				Size:           lo.ToPtr(bulkSyncPageSize),
+				Offset:         offset,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp == nil || resp.Object == nil {
+				return nil, nil, errors.New("got empty response")
+			}
+			return resp.Object.Data, resp.Object.Offset, nil
+		})
+	case *configurationv1alpha1.KongTarget:
+		upstreamIDs := lo.Uniq(lo.FilterMap(ents, func(e *T, _ int) (string, bool) {
+			t := any(e).(*configurationv1alpha1.KongTarget)
+			if t.Status.Konnect == nil || t.Status.Konnect.UpstreamID == "" {
+				return "", false
+			}
+			return t.Status.Konnect.UpstreamID, true
+		}))
+		res := make(map[string]bulkSyncEntry)
+		for _, upstreamID := range upstreamIDs {
+			targets, err := listAllPagesForBulkSync(func(offset *string) ([]sdkkonnectcomp.Target, *string, error) {
+				resp, err := sdk.GetTargetsSDK().ListTargetWithUpstream(ctx, sdkkonnectops.ListTargetWithUpstreamRequest{
+					ControlPlaneID:      cpID,
+					UpstreamIDForTarget: upstreamID,
+					Size:                lo.ToPtr(bulkSyncPageSize),
+					Offset:              offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, errors.New("got empty response")
+				}
+				return resp.Object.Data, resp.Object.Offset, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			for uid, entry := range targets {
+				res[uid] = entry
+			}
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported entity type %T for bulk sync", &e)
+	}
+}
+
+// listAllPagesForBulkSync calls the provided list function until all pages
+// are retrieved and returns entities which carry the "k8s-uid:<uid>" tag,
+// keyed by the UID.
+func listAllPagesForBulkSync[
+	T any,
+	TPtr interface {
+		*T
+		GetID() *string
+		GetTags() []string
+	},
+](list func(offset *string) ([]T, *string, error)) (map[string]bulkSyncEntry, error) {
+	var (
+		res    = make(map[string]bulkSyncEntry)
+		offset *string
+	)
+	for {
+		data, next, err := list(offset)
+		if err != nil {
+			return nil, err
+		}
+		for i := range data {
+			ent := TPtr(&data[i])
+			for _, tag := range ent.GetTags() {
+				if uid, ok := strings.CutPrefix(tag, KubernetesUIDLabelKey+":"); ok {
+					res[uid] = bulkSyncEntry{
+						id:     lo.FromPtr(ent.GetID()),
+						entity: data[i],
+					}
+					break
+				}
+			}
+		}
+		if lo.FromPtr(next) == "" {
+			return res, nil
+		}
+		offset = next
+	}
+}
+
+// desiredStateForBulkSync returns the SDK input of the provided object,
+// i.e. the desired state of its entity in Konnect.
+func desiredStateForBulkSync[T constraints.SupportedKonnectEntityType](
+	ctx context.Context,
+	cl client.Client,
+	e *T,
+) (any, error) {
+	switch ent := any(e).(type) {
+	case *configurationv1alpha1.KongService:
+		return kongServiceToSDKServiceInput(ent), nil
+	case *configurationv1alpha1.KongRoute:
+		return kongRouteToSDKRouteInput(ent), nil
+	case *configurationv1alpha1.KongUpstream:
+		return kongUpstreamToSDKUpstreamInput(ent), nil
+	case *configurationv1alpha1.KongTarget:
+		return kongTargetToTargetWithoutParents(ent), nil
+	case *configurationv1.KongConsumer:
+		return kongConsumerToSDKConsumerInput(ent), nil
+	case *configurationv1beta1.KongConsumerGroup:
+		return kongConsumerGroupToSDKConsumerGroupInput(ent), nil
+	case *configurationv1alpha1.KongPluginBinding:
+		return kongPluginBindingToSDKPluginInput(ctx, cl, ent)
+	case *configurationv1alpha1.KongCertificate:
+		return kongCertificateToCertificateInput(ent), nil
+	case *configurationv1alpha1.KongCACertificate:
+		return kongCACertificateToCACertificateInput(ent), nil
+	case *configurationv1alpha1.KongSNI:
+		return kongSNIToSNIWithoutParents(ent), nil
+	case *configurationv1alpha1.KongVault:
+		return kongVaultToVaultInput(ent)
+	case *configurationv1alpha1.KongKey:
+		return kongKeyToKeyInput(ent), nil
+	case *configurationv1alpha1.KongKeySet:
+		return kongKeySetToKeySetInput(ent), nil
+	default:
+		return nil, fmt.Errorf("unsupported entity type %T for bulk sync", ent)
+	}
+}
+
+// isUpToDate returns true when all the fields set in the desired state have
+// the same values in the actual state of the entity. Fields which are not
+// set in the desired state (e.g. fields populated by Konnect like IDs,
+// timestamps or defaults of plugin configurations) are ignored, also in
+// nested objects.
+func isUpToDate(desired, actual any) (bool, error) {
+	if desired == nil {
+		return false, nil
+	}
+
+	desiredFields, err := toJSONFields(desired)
+	if err != nil {
+		return false, err
+	}
+	actualFields, err := toJSONFields(actual)
+	if err != nil {
+		return false, err
+	}
+
+	return containsDesiredFields(desiredFields, actualFields), nil
+}
+
+// containsDesiredFields returns true when the actual value contains all the
+// non nil fields of the desired value. Values other than objects, e.g. lists,
+// have to be equal.
+func containsDesiredFields(desired, actual any) bool {
+	desiredFields, ok := desired.(map[string]any)
+	if !ok {
+		return reflect.DeepEqual(desired, actual)
+	}
+	actualFields, ok := actual.(map[string]any)
+	if !ok {
+		return false
+	}
+	for k, v := range desiredFields {
+		if v == nil {
+			continue
+		}
+		if !containsDesiredFields(v, actualFields[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+func toJSONFields(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,353 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func newKongServiceForBulkSync(name, host string) *configurationv1alpha1.KongService {
+	return &configurationv1alpha1.KongService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "default",
+			UID:        k8stypes.UID(name + "-uid"),
+			Generation: 1,
+		},
+		Spec: configurationv1alpha1.KongServiceSpec{
+			KongServiceAPISpec: configurationv1alpha1.KongServiceAPISpec{
+				Name: lo.ToPtr(name),
+				Host: host,
+			},
+		},
+		Status: configurationv1alpha1.KongServiceStatus{
+			Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneRef{
+				ControlPlaneID: "cp-id",
+			},
+		},
+	}
+}
+
+// konnectServiceFor returns the Konnect Service as it'd be returned by Konnect
+// for the provided KongService.
+func konnectServiceFor(t *testing.T, svc *configurationv1alpha1.KongService, id string) sdkkonnectcomp.Service {
+	b, err := json.Marshal(kongServiceToSDKServiceInput(svc))
+	require.NoError(t, err)
+	var ret sdkkonnectcomp.Service
+	require.NoError(t, json.Unmarshal(b, &ret))
+	ret.ID = lo.ToPtr(id)
+	ret.CreatedAt = lo.ToPtr(int64(1))
+	return ret
+}
+
+func TestIsBulkSyncSupported(t *testing.T) {
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongService]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongRoute]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongUpstream]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongTarget]())
+	assert.True(t, IsBulkSyncSupported[configurationv1.KongConsumer]())
+	assert.True(t, IsBulkSyncSupported[configurationv1beta1.KongConsumerGroup]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongPluginBinding]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongCertificate]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongCACertificate]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongSNI]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongVault]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongKey]())
+	assert.True(t, IsBulkSyncSupported[configurationv1alpha1.KongKeySet]())
+
+	// Credentials and DataPlane client certificates are synced one by one.
+	assert.False(t, IsBulkSyncSupported[configurationv1alpha1.KongCredentialBasicAuth]())
+	assert.False(t, IsBulkSyncSupported[configurationv1alpha1.KongDataPlaneClientCertificate]())
+	assert.False(t, IsBulkSyncSupported[konnectv1alpha1.KonnectGatewayControlPlane]())
+}
+
+func TestPlanBulkSync(t *testing.T) {
+	ctx := context.Background()
+
+	upToDate := newKongServiceForBulkSync("svc-up-to-date", "example.com")
+	outdated := newKongServiceForBulkSync("svc-outdated", "example.com")
+	missing := newKongServiceForBulkSync("svc-missing", "example.com")
+
+	outdatedInKonnect := konnectServiceFor(t, outdated, "id-outdated")
+	outdatedInKonnect.Host = "old.example.com"
+	notManaged := sdkkonnectcomp.Service{ID: lo.ToPtr("id-not-managed"), Host: "example.com"}
+
+	sdk := NewMockSDKWrapperWithT(t)
+	sdk.ServicesSDK.EXPECT().ListService(ctx, sdkkonnectops.ListServiceRequest{
+		ControlPlaneID: "cp-id",
+		Size:           lo.ToPtr(bulkSyncPageSize),
+	}).Return(&sdkkonnectops.ListServiceResponse{
+		Object: &sdkkonnectops.ListServiceResponseBody{
+			Data:   []sdkkonnectcomp.Service{konnectServiceFor(t, upToDate, "id-up-to-date"), notManaged},
+			Offset: lo.ToPtr("next-page"),
+		},
+	}, nil)
+	sdk.ServicesSDK.EXPECT().ListService(ctx, sdkkonnectops.ListServiceRequest{
+		ControlPlaneID: "cp-id",
+		Size:           lo.ToPtr(bulkSyncPageSize),
+		Offset:         lo.ToPtr("next-page"),
+	}).Return(&sdkkonnectops.ListServiceResponse{
+		Object: &sdkkonnectops.ListServiceResponseBody{
+			Data: []sdkkonnectcomp.Service{outdatedInKonnect},
+		},
+	}, nil)
+
+	changes, err := PlanBulkSync[configurationv1alpha1.KongService](ctx, sdk, nil, "cp-id",
+		[]*configurationv1alpha1.KongService{upToDate, outdated, missing},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []BulkSyncChange[configurationv1alpha1.KongService]{
+		{Entity: upToDate},
+		{Entity: outdated, Op: UpdateOp},
+		{Entity: missing, Op: CreateOp},
+	}, changes)
+	assert.Equal(t, "id-up-to-date", upToDate.GetKonnectID())
+	assert.Equal(t, "id-outdated", outdated.GetKonnectID())
+	assert.Empty(t, missing.GetKonnectID())
+}
+
+func TestPlanBulkSyncTargets(t *testing.T) {
+	ctx := context.Background()
+
+	target := func(name, upstreamID string) *configurationv1alpha1.KongTarget {
+		return &configurationv1alpha1.KongTarget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				UID:       k8stypes.UID(name + "-uid"),
+			},
+			Spec: configurationv1alpha1.KongTargetSpec{
+				KongTargetAPISpec: configurationv1alpha1.KongTargetAPISpec{
+					Target: name + ".example.com",
+					Weight: 100,
+				},
+			},
+			Status: configurationv1alpha1.KongTargetStatus{
+				Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneAndUpstreamRefs{
+					ControlPlaneID: "cp-id",
+					UpstreamID:     upstreamID,
+				},
+			},
+		}
+	}
+	konnectTargetFor := func(tgt *configurationv1alpha1.KongTarget, id string) sdkkonnectcomp.Target {
+		in := kongTargetToTargetWithoutParents(tgt)
+		return sdkkonnectcomp.Target{
+			ID:     lo.ToPtr(id),
+			Target: in.Target,
+			Weight: in.Weight,
+			Tags:   in.Tags,
+		}
+	}
+
+	upToDate := target("up-to-date", "upstream-1")
+	missing := target("missing", "upstream-1")
+	outdated := target("outdated", "upstream-2")
+	outdatedInKonnect := konnectTargetFor(outdated, "id-outdated")
+	outdatedInKonnect.Weight = lo.ToPtr(int64(1))
+
+	// Targets are listed per Upstream.
+	sdk := NewMockSDKWrapperWithT(t)
+	sdk.TargetsSDK.EXPECT().ListTargetWithUpstream(ctx, sdkkonnectops.ListTargetWithUpstreamRequest{
+		ControlPlaneID:      "cp-id",
+		UpstreamIDForTarget: "upstream-1",
+		Size:                lo.ToPtr(bulkSyncPageSize),
+	}).Return(&sdkkonnectops.ListTargetWithUpstreamResponse{
+		Object: &sdkkonnectops.ListTargetWithUpstreamResponseBody{
+			Data: []sdkkonnectcomp.Target{konnectTargetFor(upToDate, "id-up-to-date")},
+		},
+	}, nil)
+	sdk.TargetsSDK.EXPECT().ListTargetWithUpstream(ctx, sdkkonnectops.ListTargetWithUpstreamRequest{
+		ControlPlaneID:      "cp-id",
+		UpstreamIDForTarget: "upstream-2",
+		Size:                lo.ToPtr(bulkSyncPageSize),
+	}).Return(&sdkkonnectops.ListTargetWithUpstreamResponse{
+		Object: &sdkkonnectops.ListTargetWithUpstreamResponseBody{
+			Data: []sdkkonnectcomp.Target{outdatedInKonnect},
+		},
+	}, nil)
+
+	changes, err := PlanBulkSync[configurationv1alpha1.KongTarget](ctx, sdk, nil, "cp-id",
+		[]*configurationv1alpha1.KongTarget{upToDate, missing, outdated},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []BulkSyncChange[configurationv1alpha1.KongTarget]{
+		{Entity: upToDate},
+		{Entity: missing, Op: CreateOp},
+		{Entity: outdated, Op: UpdateOp},
+	}, changes)
+	assert.Equal(t, "id-up-to-date", upToDate.GetKonnectID())
+	assert.Equal(t, "id-outdated", outdated.GetKonnectID())
+}
+
+func TestApplyBulkSync(t *testing.T) {
+	ctx := context.Background()
+
+	upToDate := newKongServiceForBulkSync("svc-up-to-date", "example.com")
+	upToDate.Status.Konnect.SetKonnectID("id-up-to-date")
+	outdated := newKongServiceForBulkSync("svc-outdated", "example.com")
+	outdated.Status.Konnect.SetKonnectID("id-outdated")
+	missing := newKongServiceForBulkSync("svc-missing", "example.com")
+	failing := newKongServiceForBulkSync("svc-failing", "example.com")
+	deleted := newKongServiceForBulkSync("svc-deleted", "example.com")
+	deleted.Status.Konnect.SetKonnectID("id-deleted")
+
+	sdk := NewMockSDKWrapperWithT(t)
+	sdk.ServicesSDK.EXPECT().DeleteService(mock.Anything, "cp-id", "id-deleted").
+		Return(&sdkkonnectops.DeleteServiceResponse{}, nil)
+	sdk.ServicesSDK.EXPECT().UpsertService(mock.Anything,
+		mock.MatchedBy(func(req sdkkonnectops.UpsertServiceRequest) bool {
+			return req.ServiceID == "id-outdated"
+		}),
+	).Return(&sdkkonnectops.UpsertServiceResponse{}, nil)
+	sdk.ServicesSDK.EXPECT().CreateService(mock.Anything, "cp-id",
+		mock.MatchedBy(func(input sdkkonnectcomp.ServiceInput) bool {
+			return lo.FromPtr(input.Name) == "svc-missing"
+		}),
+	).Return(&sdkkonnectops.CreateServiceResponse{
+		Service: &sdkkonnectcomp.Service{ID: lo.ToPtr("id-missing")},
+	}, nil)
+	sdk.ServicesSDK.EXPECT().CreateService(mock.Anything, "cp-id",
+		mock.MatchedBy(func(input sdkkonnectcomp.ServiceInput) bool {
+			return lo.FromPtr(input.Name) == "svc-failing"
+		}),
+	).Return(nil, errors.New("boom"))
+
+	changes := []BulkSyncChange[configurationv1alpha1.KongService]{
+		{Entity: upToDate},
+		{Entity: outdated, Op: UpdateOp},
+		{Entity: missing, Op: CreateOp},
+		{Entity: failing, Op: CreateOp},
+		{Entity: deleted, Op: DeleteOp},
+	}
+	err := ApplyBulkSync[configurationv1alpha1.KongService](ctx, sdk, nil, changes,
+		// Make sure that the operations are spread across multiple batches.
+		2,
+	)
+	require.ErrorContains(t, err, "boom")
+	for i, c := range changes {
+		if c.Entity == failing {
+			assert.ErrorContains(t, c.Err, "boom")
+			continue
+		}
+		assert.NoError(t, c.Err, "change %d should succeed", i)
+	}
+
+	for _, svc := range []*configurationv1alpha1.KongService{upToDate, outdated, missing} {
+		cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, svc)
+		require.True(t, ok, "%s should have the Programmed condition", svc.Name)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status, "%s should be programmed", svc.Name)
+	}
+	assert.Equal(t, "id-missing", missing.GetKonnectID())
+
+	cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, failing)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, cond.Message, "boom")
+}
+
+func TestIsUpToDate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		desired  any
+		actual   any
+		expected bool
+	}{
+		{
+			name: "fields set by Konnect are ignored",
+			desired: sdkkonnectcomp.ServiceInput{
+				Host: "example.com",
+				Tags: []string{"tag1", "tag2"},
+			},
+			actual: sdkkonnectcomp.Service{
+				ID:        lo.ToPtr("id"),
+				CreatedAt: lo.ToPtr(int64(1)),
+				Host:      "example.com",
+				Tags:      []string{"tag1", "tag2"},
+			},
+			expected: true,
+		},
+		{
+			name: "changed field",
+			desired: sdkkonnectcomp.ServiceInput{
+				Host: "example.com",
+			},
+			actual: sdkkonnectcomp.Service{
+				Host: "old.example.com",
+			},
+			expected: false,
+		},
+		{
+			name: "changed tags",
+			desired: sdkkonnectcomp.ServiceInput{
+				Host: "example.com",
+				Tags: []string{"k8s-generation:2"},
+			},
+			actual: sdkkonnectcomp.Service{
+				Host: "example.com",
+				Tags: []string{"k8s-generation:1"},
+			},
+			expected: false,
+		},
+		{
+			name: "nested fields set by Konnect are ignored",
+			desired: sdkkonnectcomp.PluginInput{
+				Name:   "rate-limiting",
+				Config: map[string]any{"minute": 5},
+			},
+			actual: sdkkonnectcomp.Plugin{
+				Name:   "rate-limiting",
+				Config: map[string]any{"minute": 5, "policy": "local"},
+			},
+			expected: true,
+		},
+		{
+			name: "changed nested field",
+			desired: sdkkonnectcomp.PluginInput{
+				Name:   "rate-limiting",
+				Config: map[string]any{"minute": 5},
+			},
+			actual: sdkkonnectcomp.Plugin{
+				Name:   "rate-limiting",
+				Config: map[string]any{"minute": 10, "policy": "local"},
+			},
+			expected: false,
+		},
+		{
+			name: "field missing in Konnect",
+			desired: sdkkonnectcomp.ServiceInput{
+				Host: "example.com",
+				Path: lo.ToPtr("/path"),
+			},
+			actual: sdkkonnectcomp.Service{
+				Host: "example.com",
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			upToDate, err := isUpToDate(tc.desired, tc.actual)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, upToDate)
+		})
+	}
+}
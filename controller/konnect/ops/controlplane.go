@@ -11,5 +11,6 @@ import (
 type ControlPlaneSDK interface {
 	CreateControlPlane(ctx context.Context, req sdkkonnectcomp.CreateControlPlaneRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.CreateControlPlaneResponse, error)
 	DeleteControlPlane(ctx context.Context, id string, opts ...sdkkonnectops.Option) (*sdkkonnectops.DeleteControlPlaneResponse, error)
+	ListControlPlanes(ctx context.Context, request sdkkonnectops.ListControlPlanesRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.ListControlPlanesResponse, error)
 	UpdateControlPlane(ctx context.Context, id string, req sdkkonnectcomp.UpdateControlPlaneRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.UpdateControlPlaneResponse, error)
 }
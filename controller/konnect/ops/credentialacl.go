@@ -10,5 +10,6 @@ import (
 type KongCredentialACLSDK interface {
 	CreateACLWithConsumer(ctx context.Context, req sdkkonnectops.CreateACLWithConsumerRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.CreateACLWithConsumerResponse, error)
 	DeleteACLWithConsumer(ctx context.Context, request sdkkonnectops.DeleteACLWithConsumerRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.DeleteACLWithConsumerResponse, error)
+	ListACL(ctx context.Context, request sdkkonnectops.ListACLRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.ListACLResponse, error)
 	UpsertACLWithConsumer(ctx context.Context, request sdkkonnectops.UpsertACLWithConsumerRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.UpsertACLWithConsumerResponse, error)
 }
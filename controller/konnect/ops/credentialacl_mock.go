@@ -170,6 +170,80 @@ func (_c *MockKongCredentialACLSDK_DeleteACLWithConsumer_Call) RunAndReturn(run
 	return _c
 }
 
+// ListACL provides a mock function with given fields: ctx, request, opts
+func (_m *MockKongCredentialACLSDK) ListACL(ctx context.Context, request operations.ListACLRequest, opts ...operations.Option) (*operations.ListACLResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListACL")
+	}
+
+	var r0 *operations.ListACLResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListACLRequest, ...operations.Option) (*operations.ListACLResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListACLRequest, ...operations.Option) *operations.ListACLResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListACLResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListACLRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKongCredentialACLSDK_ListACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListACL'
+type MockKongCredentialACLSDK_ListACL_Call struct {
+	*mock.Call
+}
+
+// ListACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListACLRequest
+//   - opts ...operations.Option
+func (_e *MockKongCredentialACLSDK_Expecter) ListACL(ctx interface{}, request interface{}, opts ...interface{}) *MockKongCredentialACLSDK_ListACL_Call {
+	return &MockKongCredentialACLSDK_ListACL_Call{Call: _e.mock.On("ListACL",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockKongCredentialACLSDK_ListACL_Call) Run(run func(ctx context.Context, request operations.ListACLRequest, opts ...operations.Option)) *MockKongCredentialACLSDK_ListACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListACLRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockKongCredentialACLSDK_ListACL_Call) Return(_a0 *operations.ListACLResponse, _a1 error) *MockKongCredentialACLSDK_ListACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKongCredentialACLSDK_ListACL_Call) RunAndReturn(run func(context.Context, operations.ListACLRequest, ...operations.Option) (*operations.ListACLResponse, error)) *MockKongCredentialACLSDK_ListACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertACLWithConsumer provides a mock function with given fields: ctx, request, opts
 func (_m *MockKongCredentialACLSDK) UpsertACLWithConsumer(ctx context.Context, request operations.UpsertACLWithConsumerRequest, opts ...operations.Option) (*operations.UpsertACLWithConsumerResponse, error) {
 	_va := make([]interface{}, len(opts))
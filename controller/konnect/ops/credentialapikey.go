@@ -10,5 +10,6 @@ import (
 type KongCredentialAPIKeySDK interface {
 	CreateKeyAuthWithConsumer(ctx context.Context, req sdkkonnectops.CreateKeyAuthWithConsumerRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.CreateKeyAuthWithConsumerResponse, error)
 	DeleteKeyAuthWithConsumer(ctx context.Context, request sdkkonnectops.DeleteKeyAuthWithConsumerRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.DeleteKeyAuthWithConsumerResponse, error)
+	ListKeyAuth(ctx context.Context, request sdkkonnectops.ListKeyAuthRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.ListKeyAuthResponse, error)
 	UpsertKeyAuthWithConsumer(ctx context.Context, request sdkkonnectops.UpsertKeyAuthWithConsumerRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.UpsertKeyAuthWithConsumerResponse, error)
 }
@@ -170,6 +170,80 @@ func (_c *MockKongCredentialAPIKeySDK_DeleteKeyAuthWithConsumer_Call) RunAndRetu
 	return _c
 }
 
+// ListKeyAuth provides a mock function with given fields: ctx, request, opts
+func (_m *MockKongCredentialAPIKeySDK) ListKeyAuth(ctx context.Context, request operations.ListKeyAuthRequest, opts ...operations.Option) (*operations.ListKeyAuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKeyAuth")
+	}
+
+	var r0 *operations.ListKeyAuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListKeyAuthRequest, ...operations.Option) (*operations.ListKeyAuthResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListKeyAuthRequest, ...operations.Option) *operations.ListKeyAuthResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListKeyAuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListKeyAuthRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKongCredentialAPIKeySDK_ListKeyAuth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKeyAuth'
+type MockKongCredentialAPIKeySDK_ListKeyAuth_Call struct {
+	*mock.Call
+}
+
+// ListKeyAuth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListKeyAuthRequest
+//   - opts ...operations.Option
+func (_e *MockKongCredentialAPIKeySDK_Expecter) ListKeyAuth(ctx interface{}, request interface{}, opts ...interface{}) *MockKongCredentialAPIKeySDK_ListKeyAuth_Call {
+	return &MockKongCredentialAPIKeySDK_ListKeyAuth_Call{Call: _e.mock.On("ListKeyAuth",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockKongCredentialAPIKeySDK_ListKeyAuth_Call) Run(run func(ctx context.Context, request operations.ListKeyAuthRequest, opts ...operations.Option)) *MockKongCredentialAPIKeySDK_ListKeyAuth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListKeyAuthRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockKongCredentialAPIKeySDK_ListKeyAuth_Call) Return(_a0 *operations.ListKeyAuthResponse, _a1 error) *MockKongCredentialAPIKeySDK_ListKeyAuth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKongCredentialAPIKeySDK_ListKeyAuth_Call) RunAndReturn(run func(context.Context, operations.ListKeyAuthRequest, ...operations.Option) (*operations.ListKeyAuthResponse, error)) *MockKongCredentialAPIKeySDK_ListKeyAuth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertKeyAuthWithConsumer provides a mock function with given fields: ctx, request, opts
 func (_m *MockKongCredentialAPIKeySDK) UpsertKeyAuthWithConsumer(ctx context.Context, request operations.UpsertKeyAuthWithConsumerRequest, opts ...operations.Option) (*operations.UpsertKeyAuthWithConsumerResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -170,6 +170,80 @@ func (_c *MockKongCredentialBasicAuthSDK_DeleteBasicAuthWithConsumer_Call) RunAn
 	return _c
 }
 
+// ListBasicAuth provides a mock function with given fields: ctx, request, opts
+func (_m *MockKongCredentialBasicAuthSDK) ListBasicAuth(ctx context.Context, request operations.ListBasicAuthRequest, opts ...operations.Option) (*operations.ListBasicAuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBasicAuth")
+	}
+
+	var r0 *operations.ListBasicAuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListBasicAuthRequest, ...operations.Option) (*operations.ListBasicAuthResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListBasicAuthRequest, ...operations.Option) *operations.ListBasicAuthResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListBasicAuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListBasicAuthRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKongCredentialBasicAuthSDK_ListBasicAuth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBasicAuth'
+type MockKongCredentialBasicAuthSDK_ListBasicAuth_Call struct {
+	*mock.Call
+}
+
+// ListBasicAuth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListBasicAuthRequest
+//   - opts ...operations.Option
+func (_e *MockKongCredentialBasicAuthSDK_Expecter) ListBasicAuth(ctx interface{}, request interface{}, opts ...interface{}) *MockKongCredentialBasicAuthSDK_ListBasicAuth_Call {
+	return &MockKongCredentialBasicAuthSDK_ListBasicAuth_Call{Call: _e.mock.On("ListBasicAuth",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockKongCredentialBasicAuthSDK_ListBasicAuth_Call) Run(run func(ctx context.Context, request operations.ListBasicAuthRequest, opts ...operations.Option)) *MockKongCredentialBasicAuthSDK_ListBasicAuth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListBasicAuthRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockKongCredentialBasicAuthSDK_ListBasicAuth_Call) Return(_a0 *operations.ListBasicAuthResponse, _a1 error) *MockKongCredentialBasicAuthSDK_ListBasicAuth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKongCredentialBasicAuthSDK_ListBasicAuth_Call) RunAndReturn(run func(context.Context, operations.ListBasicAuthRequest, ...operations.Option) (*operations.ListBasicAuthResponse, error)) *MockKongCredentialBasicAuthSDK_ListBasicAuth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertBasicAuthWithConsumer provides a mock function with given fields: ctx, request, opts
 func (_m *MockKongCredentialBasicAuthSDK) UpsertBasicAuthWithConsumer(ctx context.Context, request operations.UpsertBasicAuthWithConsumerRequest, opts ...operations.Option) (*operations.UpsertBasicAuthWithConsumerResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -170,6 +170,80 @@ func (_c *MockKongCredentialHMACSDK_DeleteHmacAuthWithConsumer_Call) RunAndRetur
 	return _c
 }
 
+// ListHmacAuth provides a mock function with given fields: ctx, request, opts
+func (_m *MockKongCredentialHMACSDK) ListHmacAuth(ctx context.Context, request operations.ListHmacAuthRequest, opts ...operations.Option) (*operations.ListHmacAuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHmacAuth")
+	}
+
+	var r0 *operations.ListHmacAuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListHmacAuthRequest, ...operations.Option) (*operations.ListHmacAuthResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListHmacAuthRequest, ...operations.Option) *operations.ListHmacAuthResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListHmacAuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListHmacAuthRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKongCredentialHMACSDK_ListHmacAuth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHmacAuth'
+type MockKongCredentialHMACSDK_ListHmacAuth_Call struct {
+	*mock.Call
+}
+
+// ListHmacAuth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListHmacAuthRequest
+//   - opts ...operations.Option
+func (_e *MockKongCredentialHMACSDK_Expecter) ListHmacAuth(ctx interface{}, request interface{}, opts ...interface{}) *MockKongCredentialHMACSDK_ListHmacAuth_Call {
+	return &MockKongCredentialHMACSDK_ListHmacAuth_Call{Call: _e.mock.On("ListHmacAuth",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockKongCredentialHMACSDK_ListHmacAuth_Call) Run(run func(ctx context.Context, request operations.ListHmacAuthRequest, opts ...operations.Option)) *MockKongCredentialHMACSDK_ListHmacAuth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListHmacAuthRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockKongCredentialHMACSDK_ListHmacAuth_Call) Return(_a0 *operations.ListHmacAuthResponse, _a1 error) *MockKongCredentialHMACSDK_ListHmacAuth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKongCredentialHMACSDK_ListHmacAuth_Call) RunAndReturn(run func(context.Context, operations.ListHmacAuthRequest, ...operations.Option) (*operations.ListHmacAuthResponse, error)) *MockKongCredentialHMACSDK_ListHmacAuth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertHmacAuthWithConsumer provides a mock function with given fields: ctx, request, opts
 func (_m *MockKongCredentialHMACSDK) UpsertHmacAuthWithConsumer(ctx context.Context, request operations.UpsertHmacAuthWithConsumerRequest, opts ...operations.Option) (*operations.UpsertHmacAuthWithConsumerResponse, error) {
 	_va := make([]interface{}, len(opts))
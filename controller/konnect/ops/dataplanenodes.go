@@ -0,0 +1,13 @@
+package ops
+
+import (
+	"context"
+
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+)
+
+// DataPlaneNodesSDK is the interface for the Konnect data plane nodes SDK.
+type DataPlaneNodesSDK interface {
+	ListDataplaneNodes(ctx context.Context, request sdkkonnectops.ListDataplaneNodesRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.ListDataplaneNodesResponse, error)
+	GetExpectedConfigHash(ctx context.Context, controlPlaneID string, opts ...sdkkonnectops.Option) (*sdkkonnectops.GetExpectedConfigHashResponse, error)
+}
@@ -0,0 +1,185 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package ops
+
+import (
+	context "context"
+
+	operations "github.com/Kong/sdk-konnect-go/models/operations"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDataPlaneNodesSDK is an autogenerated mock type for the DataPlaneNodesSDK type
+type MockDataPlaneNodesSDK struct {
+	mock.Mock
+}
+
+type MockDataPlaneNodesSDK_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDataPlaneNodesSDK) EXPECT() *MockDataPlaneNodesSDK_Expecter {
+	return &MockDataPlaneNodesSDK_Expecter{mock: &_m.Mock}
+}
+
+// GetExpectedConfigHash provides a mock function with given fields: ctx, controlPlaneID, opts
+func (_m *MockDataPlaneNodesSDK) GetExpectedConfigHash(ctx context.Context, controlPlaneID string, opts ...operations.Option) (*operations.GetExpectedConfigHashResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, controlPlaneID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpectedConfigHash")
+	}
+
+	var r0 *operations.GetExpectedConfigHashResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...operations.Option) (*operations.GetExpectedConfigHashResponse, error)); ok {
+		return rf(ctx, controlPlaneID, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...operations.Option) *operations.GetExpectedConfigHashResponse); ok {
+		r0 = rf(ctx, controlPlaneID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.GetExpectedConfigHashResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...operations.Option) error); ok {
+		r1 = rf(ctx, controlPlaneID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataPlaneNodesSDK_GetExpectedConfigHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpectedConfigHash'
+type MockDataPlaneNodesSDK_GetExpectedConfigHash_Call struct {
+	*mock.Call
+}
+
+// GetExpectedConfigHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - controlPlaneID string
+//   - opts ...operations.Option
+func (_e *MockDataPlaneNodesSDK_Expecter) GetExpectedConfigHash(ctx interface{}, controlPlaneID interface{}, opts ...interface{}) *MockDataPlaneNodesSDK_GetExpectedConfigHash_Call {
+	return &MockDataPlaneNodesSDK_GetExpectedConfigHash_Call{Call: _e.mock.On("GetExpectedConfigHash",
+		append([]interface{}{ctx, controlPlaneID}, opts...)...)}
+}
+
+func (_c *MockDataPlaneNodesSDK_GetExpectedConfigHash_Call) Run(run func(ctx context.Context, controlPlaneID string, opts ...operations.Option)) *MockDataPlaneNodesSDK_GetExpectedConfigHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataPlaneNodesSDK_GetExpectedConfigHash_Call) Return(_a0 *operations.GetExpectedConfigHashResponse, _a1 error) *MockDataPlaneNodesSDK_GetExpectedConfigHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataPlaneNodesSDK_GetExpectedConfigHash_Call) RunAndReturn(run func(context.Context, string, ...operations.Option) (*operations.GetExpectedConfigHashResponse, error)) *MockDataPlaneNodesSDK_GetExpectedConfigHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDataplaneNodes provides a mock function with given fields: ctx, request, opts
+func (_m *MockDataPlaneNodesSDK) ListDataplaneNodes(ctx context.Context, request operations.ListDataplaneNodesRequest, opts ...operations.Option) (*operations.ListDataplaneNodesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDataplaneNodes")
+	}
+
+	var r0 *operations.ListDataplaneNodesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListDataplaneNodesRequest, ...operations.Option) (*operations.ListDataplaneNodesResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListDataplaneNodesRequest, ...operations.Option) *operations.ListDataplaneNodesResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListDataplaneNodesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListDataplaneNodesRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataPlaneNodesSDK_ListDataplaneNodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDataplaneNodes'
+type MockDataPlaneNodesSDK_ListDataplaneNodes_Call struct {
+	*mock.Call
+}
+
+// ListDataplaneNodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListDataplaneNodesRequest
+//   - opts ...operations.Option
+func (_e *MockDataPlaneNodesSDK_Expecter) ListDataplaneNodes(ctx interface{}, request interface{}, opts ...interface{}) *MockDataPlaneNodesSDK_ListDataplaneNodes_Call {
+	return &MockDataPlaneNodesSDK_ListDataplaneNodes_Call{Call: _e.mock.On("ListDataplaneNodes",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockDataPlaneNodesSDK_ListDataplaneNodes_Call) Run(run func(ctx context.Context, request operations.ListDataplaneNodesRequest, opts ...operations.Option)) *MockDataPlaneNodesSDK_ListDataplaneNodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListDataplaneNodesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataPlaneNodesSDK_ListDataplaneNodes_Call) Return(_a0 *operations.ListDataplaneNodesResponse, _a1 error) *MockDataPlaneNodesSDK_ListDataplaneNodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataPlaneNodesSDK_ListDataplaneNodes_Call) RunAndReturn(run func(context.Context, operations.ListDataplaneNodesRequest, ...operations.Option) (*operations.ListDataplaneNodesResponse, error)) *MockDataPlaneNodesSDK_ListDataplaneNodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDataPlaneNodesSDK creates a new instance of MockDataPlaneNodesSDK. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDataPlaneNodesSDK(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDataPlaneNodesSDK {
+	mock := &MockDataPlaneNodesSDK{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
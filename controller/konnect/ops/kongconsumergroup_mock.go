@@ -249,6 +249,80 @@ func (_c *MockConsumerGroupSDK_DeleteConsumerGroup_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ListConsumerGroup provides a mock function with given fields: ctx, request, opts
+func (_m *MockConsumerGroupSDK) ListConsumerGroup(ctx context.Context, request operations.ListConsumerGroupRequest, opts ...operations.Option) (*operations.ListConsumerGroupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConsumerGroup")
+	}
+
+	var r0 *operations.ListConsumerGroupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListConsumerGroupRequest, ...operations.Option) (*operations.ListConsumerGroupResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListConsumerGroupRequest, ...operations.Option) *operations.ListConsumerGroupResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListConsumerGroupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListConsumerGroupRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsumerGroupSDK_ListConsumerGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConsumerGroup'
+type MockConsumerGroupSDK_ListConsumerGroup_Call struct {
+	*mock.Call
+}
+
+// ListConsumerGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListConsumerGroupRequest
+//   - opts ...operations.Option
+func (_e *MockConsumerGroupSDK_Expecter) ListConsumerGroup(ctx interface{}, request interface{}, opts ...interface{}) *MockConsumerGroupSDK_ListConsumerGroup_Call {
+	return &MockConsumerGroupSDK_ListConsumerGroup_Call{Call: _e.mock.On("ListConsumerGroup",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockConsumerGroupSDK_ListConsumerGroup_Call) Run(run func(ctx context.Context, request operations.ListConsumerGroupRequest, opts ...operations.Option)) *MockConsumerGroupSDK_ListConsumerGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListConsumerGroupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockConsumerGroupSDK_ListConsumerGroup_Call) Return(_a0 *operations.ListConsumerGroupResponse, _a1 error) *MockConsumerGroupSDK_ListConsumerGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsumerGroupSDK_ListConsumerGroup_Call) RunAndReturn(run func(context.Context, operations.ListConsumerGroupRequest, ...operations.Option) (*operations.ListConsumerGroupResponse, error)) *MockConsumerGroupSDK_ListConsumerGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListConsumerGroupsForConsumer provides a mock function with given fields: ctx, request, opts
 func (_m *MockConsumerGroupSDK) ListConsumerGroupsForConsumer(ctx context.Context, request operations.ListConsumerGroupsForConsumerRequest, opts ...operations.Option) (*operations.ListConsumerGroupsForConsumerResponse, error) {
 	_va := make([]interface{}, len(opts))
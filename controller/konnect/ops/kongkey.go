@@ -12,4 +12,5 @@ type KeysSDK interface {
 	CreateKey(ctx context.Context, controlPlaneID string, Key sdkkonnectcomp.KeyInput, opts ...sdkkonnectops.Option) (*sdkkonnectops.CreateKeyResponse, error)
 	UpsertKey(ctx context.Context, request sdkkonnectops.UpsertKeyRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.UpsertKeyResponse, error)
 	DeleteKey(ctx context.Context, controlPlaneID string, KeyID string, opts ...sdkkonnectops.Option) (*sdkkonnectops.DeleteKeyResponse, error)
+	ListKey(ctx context.Context, request sdkkonnectops.ListKeyRequest, opts ...sdkkonnectops.Option) (*sdkkonnectops.ListKeyResponse, error)
 }
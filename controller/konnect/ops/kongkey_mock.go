@@ -175,6 +175,80 @@ func (_c *MockKeysSDK_DeleteKey_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// ListKey provides a mock function with given fields: ctx, request, opts
+func (_m *MockKeysSDK) ListKey(ctx context.Context, request operations.ListKeyRequest, opts ...operations.Option) (*operations.ListKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKey")
+	}
+
+	var r0 *operations.ListKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListKeyRequest, ...operations.Option) (*operations.ListKeyResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListKeyRequest, ...operations.Option) *operations.ListKeyResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListKeyRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKeysSDK_ListKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKey'
+type MockKeysSDK_ListKey_Call struct {
+	*mock.Call
+}
+
+// ListKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListKeyRequest
+//   - opts ...operations.Option
+func (_e *MockKeysSDK_Expecter) ListKey(ctx interface{}, request interface{}, opts ...interface{}) *MockKeysSDK_ListKey_Call {
+	return &MockKeysSDK_ListKey_Call{Call: _e.mock.On("ListKey",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockKeysSDK_ListKey_Call) Run(run func(ctx context.Context, request operations.ListKeyRequest, opts ...operations.Option)) *MockKeysSDK_ListKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockKeysSDK_ListKey_Call) Return(_a0 *operations.ListKeyResponse, _a1 error) *MockKeysSDK_ListKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKeysSDK_ListKey_Call) RunAndReturn(run func(context.Context, operations.ListKeyRequest, ...operations.Option) (*operations.ListKeyResponse, error)) *MockKeysSDK_ListKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertKey provides a mock function with given fields: ctx, request, opts
 func (_m *MockKeysSDK) UpsertKey(ctx context.Context, request operations.UpsertKeyRequest, opts ...operations.Option) (*operations.UpsertKeyResponse, error) {
 	_va := make([]interface{}, len(opts))
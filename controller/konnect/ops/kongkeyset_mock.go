@@ -175,6 +175,80 @@ func (_c *MockKeySetsSDK_DeleteKeySet_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ListKeySet provides a mock function with given fields: ctx, request, opts
+func (_m *MockKeySetsSDK) ListKeySet(ctx context.Context, request operations.ListKeySetRequest, opts ...operations.Option) (*operations.ListKeySetResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKeySet")
+	}
+
+	var r0 *operations.ListKeySetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListKeySetRequest, ...operations.Option) (*operations.ListKeySetResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListKeySetRequest, ...operations.Option) *operations.ListKeySetResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListKeySetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListKeySetRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKeySetsSDK_ListKeySet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKeySet'
+type MockKeySetsSDK_ListKeySet_Call struct {
+	*mock.Call
+}
+
+// ListKeySet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListKeySetRequest
+//   - opts ...operations.Option
+func (_e *MockKeySetsSDK_Expecter) ListKeySet(ctx interface{}, request interface{}, opts ...interface{}) *MockKeySetsSDK_ListKeySet_Call {
+	return &MockKeySetsSDK_ListKeySet_Call{Call: _e.mock.On("ListKeySet",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockKeySetsSDK_ListKeySet_Call) Run(run func(ctx context.Context, request operations.ListKeySetRequest, opts ...operations.Option)) *MockKeySetsSDK_ListKeySet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListKeySetRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockKeySetsSDK_ListKeySet_Call) Return(_a0 *operations.ListKeySetResponse, _a1 error) *MockKeySetsSDK_ListKeySet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKeySetsSDK_ListKeySet_Call) RunAndReturn(run func(context.Context, operations.ListKeySetRequest, ...operations.Option) (*operations.ListKeySetResponse, error)) *MockKeySetsSDK_ListKeySet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertKeySet provides a mock function with given fields: ctx, request, opts
 func (_m *MockKeySetsSDK) UpsertKeySet(ctx context.Context, request operations.UpsertKeySetRequest, opts ...operations.Option) (*operations.UpsertKeySetResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -170,6 +170,80 @@ func (_c *MockSNIsSDK_DeleteSniWithCertificate_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ListSni provides a mock function with given fields: ctx, request, opts
+func (_m *MockSNIsSDK) ListSni(ctx context.Context, request operations.ListSniRequest, opts ...operations.Option) (*operations.ListSniResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSni")
+	}
+
+	var r0 *operations.ListSniResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListSniRequest, ...operations.Option) (*operations.ListSniResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListSniRequest, ...operations.Option) *operations.ListSniResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListSniResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListSniRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSNIsSDK_ListSni_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSni'
+type MockSNIsSDK_ListSni_Call struct {
+	*mock.Call
+}
+
+// ListSni is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListSniRequest
+//   - opts ...operations.Option
+func (_e *MockSNIsSDK_Expecter) ListSni(ctx interface{}, request interface{}, opts ...interface{}) *MockSNIsSDK_ListSni_Call {
+	return &MockSNIsSDK_ListSni_Call{Call: _e.mock.On("ListSni",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockSNIsSDK_ListSni_Call) Run(run func(ctx context.Context, request operations.ListSniRequest, opts ...operations.Option)) *MockSNIsSDK_ListSni_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListSniRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSNIsSDK_ListSni_Call) Return(_a0 *operations.ListSniResponse, _a1 error) *MockSNIsSDK_ListSni_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSNIsSDK_ListSni_Call) RunAndReturn(run func(context.Context, operations.ListSniRequest, ...operations.Option) (*operations.ListSniResponse, error)) *MockSNIsSDK_ListSni_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertSniWithCertificate provides a mock function with given fields: ctx, request, opts
 func (_m *MockSNIsSDK) UpsertSniWithCertificate(ctx context.Context, request operations.UpsertSniWithCertificateRequest, opts ...operations.Option) (*operations.UpsertSniWithCertificateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -170,6 +170,80 @@ func (_c *MockTargetsSDK_DeleteTargetWithUpstream_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// ListTargetWithUpstream provides a mock function with given fields: ctx, request, opts
+func (_m *MockTargetsSDK) ListTargetWithUpstream(ctx context.Context, request operations.ListTargetWithUpstreamRequest, opts ...operations.Option) (*operations.ListTargetWithUpstreamResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTargetWithUpstream")
+	}
+
+	var r0 *operations.ListTargetWithUpstreamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListTargetWithUpstreamRequest, ...operations.Option) (*operations.ListTargetWithUpstreamResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListTargetWithUpstreamRequest, ...operations.Option) *operations.ListTargetWithUpstreamResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListTargetWithUpstreamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListTargetWithUpstreamRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTargetsSDK_ListTargetWithUpstream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTargetWithUpstream'
+type MockTargetsSDK_ListTargetWithUpstream_Call struct {
+	*mock.Call
+}
+
+// ListTargetWithUpstream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListTargetWithUpstreamRequest
+//   - opts ...operations.Option
+func (_e *MockTargetsSDK_Expecter) ListTargetWithUpstream(ctx interface{}, request interface{}, opts ...interface{}) *MockTargetsSDK_ListTargetWithUpstream_Call {
+	return &MockTargetsSDK_ListTargetWithUpstream_Call{Call: _e.mock.On("ListTargetWithUpstream",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockTargetsSDK_ListTargetWithUpstream_Call) Run(run func(ctx context.Context, request operations.ListTargetWithUpstreamRequest, opts ...operations.Option)) *MockTargetsSDK_ListTargetWithUpstream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListTargetWithUpstreamRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockTargetsSDK_ListTargetWithUpstream_Call) Return(_a0 *operations.ListTargetWithUpstreamResponse, _a1 error) *MockTargetsSDK_ListTargetWithUpstream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTargetsSDK_ListTargetWithUpstream_Call) RunAndReturn(run func(context.Context, operations.ListTargetWithUpstreamRequest, ...operations.Option) (*operations.ListTargetWithUpstreamResponse, error)) *MockTargetsSDK_ListTargetWithUpstream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertTargetWithUpstream provides a mock function with given fields: ctx, req, opts
 func (_m *MockTargetsSDK) UpsertTargetWithUpstream(ctx context.Context, req operations.UpsertTargetWithUpstreamRequest, opts ...operations.Option) (*operations.UpsertTargetWithUpstreamResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -175,6 +175,80 @@ func (_c *MockVaultSDK_DeleteVault_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListVault provides a mock function with given fields: ctx, request, opts
+func (_m *MockVaultSDK) ListVault(ctx context.Context, request operations.ListVaultRequest, opts ...operations.Option) (*operations.ListVaultResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListVault")
+	}
+
+	var r0 *operations.ListVaultResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListVaultRequest, ...operations.Option) (*operations.ListVaultResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListVaultRequest, ...operations.Option) *operations.ListVaultResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListVaultResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListVaultRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVaultSDK_ListVault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListVault'
+type MockVaultSDK_ListVault_Call struct {
+	*mock.Call
+}
+
+// ListVault is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListVaultRequest
+//   - opts ...operations.Option
+func (_e *MockVaultSDK_Expecter) ListVault(ctx interface{}, request interface{}, opts ...interface{}) *MockVaultSDK_ListVault_Call {
+	return &MockVaultSDK_ListVault_Call{Call: _e.mock.On("ListVault",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockVaultSDK_ListVault_Call) Run(run func(ctx context.Context, request operations.ListVaultRequest, opts ...operations.Option)) *MockVaultSDK_ListVault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListVaultRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockVaultSDK_ListVault_Call) Return(_a0 *operations.ListVaultResponse, _a1 error) *MockVaultSDK_ListVault_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVaultSDK_ListVault_Call) RunAndReturn(run func(context.Context, operations.ListVaultRequest, ...operations.Option) (*operations.ListVaultResponse, error)) *MockVaultSDK_ListVault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertVault provides a mock function with given fields: ctx, request, opts
 func (_m *MockVaultSDK) UpsertVault(ctx context.Context, request operations.UpsertVaultRequest, opts ...operations.Option) (*operations.UpsertVaultResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -0,0 +1,133 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kong/gateway-operator/controller/konnect/constraints"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+// UIDLabelForObject returns the "k8s-uid:<uid>" tag (or label for entities
+// supporting labels instead of tags) which is set on every Konnect entity
+// created for the provided object.
+func UIDLabelForObject(obj client.Object) string {
+	return fmt.Sprintf("%s:%s", KubernetesUIDLabelKey, obj.GetUID())
+}
+
+// getKonnectIDForUID returns the Konnect ID of the entity created in Konnect for
+// the provided object in one of the previous reconciliations, e.g. when the
+// status update storing the Konnect ID has failed after the entity was created.
+// Entities are looked up by the "k8s-uid:<uid>" tag (or label).
+// It returns an empty string when no such entity exists or when the entity type
+// can't be looked up by the UID.
+func getKonnectIDForUID[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](
+	ctx context.Context,
+	sdk SDKWrapper,
+	e *T,
+) (string, error) {
+	// Entities which belong to a ControlPlane can't be looked up without its ID.
+	// Let the create operation report that.
+	if entWithCPRef, ok := any(e).(interface{ GetControlPlaneID() string }); ok &&
+		entWithCPRef.GetControlPlaneID() == "" {
+		return "", nil
+	}
+
+	var (
+		id    string
+		err   error
+		start = time.Now()
+	)
+	switch ent := any(e).(type) {
+	case *konnectv1alpha1.KonnectGatewayControlPlane:
+		id, err = getControlPlaneForUID(ctx, sdk.GetControlPlaneSDK(), ent)
+	case *configurationv1alpha1.KongService:
+		id, err = getKongServiceForUID(ctx, sdk.GetServicesSDK(), ent)
+	case *configurationv1alpha1.KongRoute:
+		id, err = getKongRouteForUID(ctx, sdk.GetRoutesSDK(), ent)
+	case *configurationv1.KongConsumer:
+		id, err = getKongConsumerForUID(ctx, sdk.GetConsumersSDK(), ent)
+	case *configurationv1beta1.KongConsumerGroup:
+		id, err = getKongConsumerGroupForUID(ctx, sdk.GetConsumerGroupsSDK(), ent)
+	case *configurationv1alpha1.KongPluginBinding:
+		id, err = getPluginForUID(ctx, sdk.GetPluginSDK(), ent)
+	case *configurationv1alpha1.KongUpstream:
+		id, err = getKongUpstreamForUID(ctx, sdk.GetUpstreamsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialBasicAuth:
+		id, err = getKongCredentialBasicAuthForUID(ctx, sdk.GetBasicAuthCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialAPIKey:
+		id, err = getKongCredentialAPIKeyForUID(ctx, sdk.GetAPIKeyCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialACL:
+		id, err = getKongCredentialACLForUID(ctx, sdk.GetACLCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialJWT:
+		id, err = getKongCredentialJWTForUID(ctx, sdk.GetJWTCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialHMAC:
+		id, err = getKongCredentialHMACForUID(ctx, sdk.GetHMACCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCACertificate:
+		id, err = getKongCACertificateForUID(ctx, sdk.GetCACertificatesSDK(), ent)
+	case *configurationv1alpha1.KongCertificate:
+		id, err = getKongCertificateForUID(ctx, sdk.GetCertificatesSDK(), ent)
+	case *configurationv1alpha1.KongTarget:
+		id, err = getKongTargetForUID(ctx, sdk.GetTargetsSDK(), ent)
+	case *configurationv1alpha1.KongVault:
+		id, err = getKongVaultForUID(ctx, sdk.GetVaultSDK(), ent)
+	case *configurationv1alpha1.KongKey:
+		id, err = getKongKeyForUID(ctx, sdk.GetKeysSDK(), ent)
+	case *configurationv1alpha1.KongKeySet:
+		id, err = getKongKeySetForUID(ctx, sdk.GetKeySetsSDK(), ent)
+	case *configurationv1alpha1.KongSNI:
+		id, err = getKongSNIForUID(ctx, sdk.GetSNIsSDK(), ent)
+	case *configurationv1alpha1.KongDataPlaneClientCertificate:
+		// DataPlane client certificates can't be tagged hence can't be looked up
+		// by the UID. We rely on the reconciler's create expectations only.
+		return "", nil
+		// ---------------------------------------------------------------------
+		// TODO: add other Konnect types
+	default:
+		return "", fmt.Errorf("unsupported entity type %T", ent)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s %s by UID: %w",
+			constraints.EntityTypeName[T](), client.ObjectKeyFromObject(TEnt(e)), err,
+		)
+	}
+	if id != "" {
+		ctrllog.FromContext(ctx).Info("found existing entity in Konnect, adopting it",
+			"konnect_id", id,
+			"duration", time.Since(start).String(),
+		)
+	}
+	return id, nil
+}
+
+// getMatchingEntryFromListResponseData returns the ID of the first entity from
+// the list response data. Callers are expected to filter the list by the
+// "k8s-uid:<uid>" tag so that all the returned entities belong to the same object.
+// It returns an empty string when the list is empty.
+func getMatchingEntryFromListResponseData[
+	T any,
+	TPtr interface {
+		*T
+		GetID() *string
+	},
+](data []T) string {
+	for i := range data {
+		if id := lo.FromPtr(TPtr(&data[i]).GetID()); id != "" {
+			return id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,145 @@
+package ops
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func TestCreateLooksUpEntityByUID(t *testing.T) {
+	ctx := context.Background()
+	newService := func() *configurationv1alpha1.KongService {
+		return &configurationv1alpha1.KongService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc-1",
+				Namespace: "default",
+				UID:       k8stypes.UID("c5b8b2f6-2a6b-4b7e-9d3e-4f5d6a7b8c9d"),
+			},
+			Spec: configurationv1alpha1.KongServiceSpec{
+				KongServiceAPISpec: configurationv1alpha1.KongServiceAPISpec{
+					Name: lo.ToPtr("svc-1"),
+					Host: "example.com",
+				},
+			},
+			Status: configurationv1alpha1.KongServiceStatus{
+				Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneRef{
+					ControlPlaneID: "123456789",
+				},
+			},
+		}
+	}
+	listRequest := sdkkonnectops.ListServiceRequest{
+		ControlPlaneID: "123456789",
+		Tags:           lo.ToPtr("k8s-uid:c5b8b2f6-2a6b-4b7e-9d3e-4f5d6a7b8c9d"),
+	}
+
+	testCases := []struct {
+		name        string
+		setupMocks  func(*MockSDKWrapper, *configurationv1alpha1.KongService)
+		expectedID  string
+		expectedErr bool
+	}{
+		{
+			name: "entity created in a previous reconciliation is adopted",
+			setupMocks: func(sdk *MockSDKWrapper, _ *configurationv1alpha1.KongService) {
+				sdk.ServicesSDK.EXPECT().ListService(ctx, listRequest).Return(
+					&sdkkonnectops.ListServiceResponse{
+						Object: &sdkkonnectops.ListServiceResponseBody{
+							Data: []sdkkonnectcomp.Service{
+								{ID: lo.ToPtr("existing-id")},
+							},
+						},
+					}, nil,
+				)
+			},
+			expectedID: "existing-id",
+		},
+		{
+			name: "entity is created when no entity matches the UID",
+			setupMocks: func(sdk *MockSDKWrapper, svc *configurationv1alpha1.KongService) {
+				sdk.ServicesSDK.EXPECT().ListService(ctx, listRequest).Return(
+					&sdkkonnectops.ListServiceResponse{
+						Object: &sdkkonnectops.ListServiceResponseBody{},
+					}, nil,
+				)
+				sdk.ServicesSDK.EXPECT().CreateService(ctx, "123456789", kongServiceToSDKServiceInput(svc)).Return(
+					&sdkkonnectops.CreateServiceResponse{
+						Service: &sdkkonnectcomp.Service{
+							ID: lo.ToPtr("new-id"),
+						},
+					}, nil,
+				)
+			},
+			expectedID: "new-id",
+		},
+		{
+			name: "entity is not created when the lookup fails",
+			setupMocks: func(sdk *MockSDKWrapper, _ *configurationv1alpha1.KongService) {
+				sdk.ServicesSDK.EXPECT().ListService(ctx, listRequest).Return(nil, errors.New("failed"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sdk := NewMockSDKWrapperWithT(t)
+			svc := newService()
+			tc.setupMocks(sdk, svc)
+
+			_, err := Create[configurationv1alpha1.KongService](ctx, sdk, nil, svc)
+			if tc.expectedErr {
+				require.Error(t, err)
+				cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, svc)
+				require.True(t, ok, "Programmed condition not set on KongService")
+				assert.Equal(t, metav1.ConditionFalse, cond.Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedID, svc.GetKonnectStatus().GetKonnectID())
+		})
+	}
+}
+
+func TestCreateLooksUpControlPlaneByUIDLabel(t *testing.T) {
+	ctx := context.Background()
+	sdk := NewMockSDKWrapperWithT(t)
+	cp := &konnectv1alpha1.KonnectGatewayControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-1",
+			Namespace: "default",
+			UID:       k8stypes.UID("2f5b4e1a-0c3d-4b8e-8f6a-1d2c3b4a5e6f"),
+		},
+	}
+
+	sdk.ControlPlaneSDK.EXPECT().ListControlPlanes(ctx, sdkkonnectops.ListControlPlanesRequest{
+		Labels: lo.ToPtr("k8s-uid:2f5b4e1a-0c3d-4b8e-8f6a-1d2c3b4a5e6f"),
+	}).Return(
+		&sdkkonnectops.ListControlPlanesResponse{
+			ListControlPlanesResponse: &sdkkonnectcomp.ListControlPlanesResponse{
+				Data: []sdkkonnectcomp.ControlPlane{
+					{ID: lo.ToPtr("existing-cp-id")},
+				},
+			},
+		}, nil,
+	)
+
+	_, err := Create[konnectv1alpha1.KonnectGatewayControlPlane](ctx, sdk, nil, cp)
+	require.NoError(t, err)
+	assert.Equal(t, "existing-cp-id", cp.GetKonnectStatus().GetKonnectID())
+	sdk.ControlPlaneSDK.AssertNotCalled(t, "CreateControlPlane", mock.Anything, mock.Anything)
+}
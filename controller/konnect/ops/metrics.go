@@ -0,0 +1,221 @@
+package ops
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kong/gateway-operator/controller/konnect/constraints"
+
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+const (
+	// throttleReasonClientSide is used when a request is throttled by the
+	// client side rate limiter.
+	throttleReasonClientSide = "client_side"
+	// throttleReasonServerSide is used when a request is throttled because
+	// Konnect has indicated that the rate limit has been exceeded.
+	throttleReasonServerSide = "server_side"
+
+	// statusCodeSuccess is used as the status code label of successful operations.
+	// The SDK does not return the responses' status codes to the callers of ops.
+	statusCodeSuccess = "2xx"
+	// statusCodeUnknown is used as the status code label of operations which
+	// failed without a response from Konnect API, e.g. because of network errors.
+	statusCodeUnknown = "unknown"
+)
+
+var (
+	konnectAPIThrottledRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gateway_operator_konnect_api_throttled_requests_total",
+			Help: "Number of Konnect API requests which were not sent because of rate limiting.",
+		},
+		[]string{"server_url", "reason"},
+	)
+	konnectAPIClientSideThrottleWait = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "gateway_operator_konnect_api_client_side_throttle_wait_seconds",
+			Help:    "Time Konnect API requests waited for the client side rate limiter.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 6),
+		},
+		[]string{"server_url"},
+	)
+
+	konnectEntityOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gateway_operator_konnect_entity_operations_total",
+			Help: "Number of operations performed on Konnect entities.",
+		},
+		[]string{"entity_type", "op", "status_code", "success", "control_plane_id"},
+	)
+	konnectEntityOperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "gateway_operator_konnect_entity_operation_duration_seconds",
+			Help:    "Duration of operations performed on Konnect entities.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"entity_type", "op", "status_code", "success", "control_plane_id"},
+	)
+
+	lastSuccessfulSyncs = newLastSuccessfulSyncCollector(time.Now)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		konnectAPIThrottledRequests,
+		konnectAPIClientSideThrottleWait,
+		konnectEntityOperations,
+		konnectEntityOperationDuration,
+		lastSuccessfulSyncs,
+	)
+}
+
+// recordOpMetrics records the metrics of an operation performed on a Konnect entity.
+func recordOpMetrics[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](start time.Time, op Op, e TEnt, err error) {
+	var (
+		entityType = constraints.EntityTypeName[T]()
+		cpID       = ControlPlaneIDForMetrics(e)
+		labels     = prometheus.Labels{
+			"entity_type":      entityType,
+			"op":               string(op),
+			"status_code":      statusCodeForMetrics(err),
+			"success":          strconv.FormatBool(err == nil),
+			"control_plane_id": cpID,
+		}
+	)
+	konnectEntityOperations.With(labels).Inc()
+	konnectEntityOperationDuration.With(labels).Observe(time.Since(start).Seconds())
+
+	if err == nil && (op == CreateOp || op == UpdateOp) {
+		lastSuccessfulSyncs.record(entityType, cpID)
+	}
+}
+
+// recordUpToDate records that an entity has been found up to date in Konnect,
+// which counts as a successful sync.
+func recordUpToDate[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](e TEnt) {
+	lastSuccessfulSyncs.record(constraints.EntityTypeName[T](), ControlPlaneIDForMetrics(e))
+}
+
+// ControlPlaneIDForMetrics returns the ID of the Konnect ControlPlane the object
+// belongs to, as used in the metrics' control_plane_id label.
+// For KonnectGatewayControlPlanes it returns their own Konnect ID.
+func ControlPlaneIDForMetrics(obj any) string {
+	switch o := obj.(type) {
+	case *konnectv1alpha1.KonnectGatewayControlPlane:
+		return o.GetKonnectStatus().GetKonnectID()
+	case interface{ GetControlPlaneID() string }:
+		return o.GetControlPlaneID()
+	default:
+		return ""
+	}
+}
+
+// statusCodeForMetrics returns the HTTP status code of the Konnect API response
+// which caused the error.
+func statusCodeForMetrics(err error) string {
+	if err == nil {
+		return statusCodeSuccess
+	}
+
+	var (
+		errSDK                 *sdkkonnecterrs.SDKError
+		errBadRequest          *sdkkonnecterrs.BadRequestError
+		errUnauthorized        *sdkkonnecterrs.UnauthorizedError
+		errForbidden           *sdkkonnecterrs.ForbiddenError
+		errNotFound            *sdkkonnecterrs.NotFoundError
+		errConflict            *sdkkonnecterrs.ConflictError
+		errInternalServerError *sdkkonnecterrs.InternalServerError
+		errServiceUnavailable  *sdkkonnecterrs.ServiceUnavailable
+		errRateLimited         RateLimitedError
+	)
+	switch {
+	case errors.As(err, &errSDK):
+		return strconv.Itoa(errSDK.StatusCode)
+	case errors.As(err, &errBadRequest):
+		return strconv.Itoa(http.StatusBadRequest)
+	case errors.As(err, &errUnauthorized):
+		return strconv.Itoa(http.StatusUnauthorized)
+	case errors.As(err, &errForbidden):
+		return strconv.Itoa(http.StatusForbidden)
+	case errors.As(err, &errNotFound):
+		return strconv.Itoa(http.StatusNotFound)
+	case errors.As(err, &errConflict):
+		return strconv.Itoa(http.StatusConflict)
+	case errors.As(err, &errRateLimited):
+		return strconv.Itoa(http.StatusTooManyRequests)
+	case errors.As(err, &errInternalServerError):
+		return strconv.Itoa(http.StatusInternalServerError)
+	case errors.As(err, &errServiceUnavailable):
+		return strconv.Itoa(http.StatusServiceUnavailable)
+	default:
+		return statusCodeUnknown
+	}
+}
+
+// lastSuccessfulSyncCollector collects the age of the last successful sync of
+// Konnect entities, per entity type and Konnect ControlPlane.
+type lastSuccessfulSyncCollector struct {
+	desc *prometheus.Desc
+	now  func() time.Time
+
+	lock  sync.RWMutex
+	syncs map[lastSuccessfulSyncKey]time.Time
+}
+
+type lastSuccessfulSyncKey struct {
+	entityType     string
+	controlPlaneID string
+}
+
+var _ prometheus.Collector = (*lastSuccessfulSyncCollector)(nil)
+
+func newLastSuccessfulSyncCollector(now func() time.Time) *lastSuccessfulSyncCollector {
+	return &lastSuccessfulSyncCollector{
+		desc: prometheus.NewDesc(
+			"gateway_operator_konnect_entity_last_successful_sync_age_seconds",
+			"Time since the last successful sync of a Konnect entity of the given type to the Konnect ControlPlane.",
+			[]string{"entity_type", "control_plane_id"},
+			nil,
+		),
+		now:   now,
+		syncs: make(map[lastSuccessfulSyncKey]time.Time),
+	}
+}
+
+func (c *lastSuccessfulSyncCollector) record(entityType, controlPlaneID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.syncs[lastSuccessfulSyncKey{entityType: entityType, controlPlaneID: controlPlaneID}] = c.now()
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *lastSuccessfulSyncCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *lastSuccessfulSyncCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	now := c.now()
+	for k, t := range c.syncs {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue,
+			now.Sub(t).Seconds(), k.entityType, k.controlPlaneID,
+		)
+	}
+}
@@ -0,0 +1,72 @@
+package ops
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+func TestStatusCodeForMetrics(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "success",
+			expected: "2xx",
+		},
+		{
+			name:     "SDK error",
+			err:      fmt.Errorf("failed to create KongService: %w", &sdkkonnecterrs.SDKError{StatusCode: 502}),
+			expected: "502",
+		},
+		{
+			name:     "typed SDK error",
+			err:      fmt.Errorf("failed to create KongService: %w", &sdkkonnecterrs.ConflictError{}),
+			expected: "409",
+		},
+		{
+			name:     "rate limited",
+			err:      FailedKonnectOpError[configurationv1alpha1.KongService]{Op: CreateOp, Err: RateLimitedError{RetryAfter: time.Second}},
+			expected: "429",
+		},
+		{
+			name:     "network error",
+			err:      errors.New("connection refused"),
+			expected: "unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, statusCodeForMetrics(tc.err))
+		})
+	}
+}
+
+func TestLastSuccessfulSyncCollector(t *testing.T) {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	c := newLastSuccessfulSyncCollector(func() time.Time { return now })
+
+	c.record("KongService", "cp-1")
+	now = now.Add(30 * time.Second)
+	c.record("KongRoute", "cp-1")
+	now = now.Add(10 * time.Second)
+
+	require.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP gateway_operator_konnect_entity_last_successful_sync_age_seconds Time since the last successful sync of a Konnect entity of the given type to the Konnect ControlPlane.
+# TYPE gateway_operator_konnect_entity_last_successful_sync_age_seconds gauge
+gateway_operator_konnect_entity_last_successful_sync_age_seconds{control_plane_id="cp-1",entity_type="KongRoute"} 10
+gateway_operator_konnect_entity_last_successful_sync_age_seconds{control_plane_id="cp-1",entity_type="KongService"} 40
+`)))
+}
@@ -9,6 +9,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	konnectconsts "github.com/kong/gateway-operator/controller/konnect/consts"
+
 	"github.com/kong/kubernetes-configuration/pkg/metadata"
 )
 
@@ -43,17 +45,29 @@ type ObjectWithMetadata interface {
 
 // GenerateTagsForObject generates tags for the given object based on its Kubernetes metadata and annotations.
 // An optional set of tags can be passed to be included in the generated tags (e.g. tags from the spec).
+// Kubernetes metadata tags are not generated for objects being orphaned (see IsOrphaned).
 // It returns a slice of unique, sorted strings for deterministic output.
 func GenerateTagsForObject(obj ObjectWithMetadata, additionalTags ...string) []string {
 	var (
 		annotationTags = metadata.ExtractTags(obj)
-		k8sMetaTags    = generateKubernetesMetadataTags(obj)
-		res            = lo.Uniq(slices.Concat(annotationTags, k8sMetaTags, additionalTags))
+		k8sMetaTags    []string
 	)
+	if !IsOrphaned(obj) {
+		k8sMetaTags = generateKubernetesMetadataTags(obj)
+	}
+	res := lo.Uniq(slices.Concat(annotationTags, k8sMetaTags, additionalTags))
 	sort.Strings(res)
 	return res
 }
 
+// IsOrphaned returns true when the given object is being deleted and its
+// deletion policy (set through the konnect.konghq.com/deletion-policy annotation)
+// is "orphan", meaning that the Konnect entity it manages has to be left in Konnect.
+func IsOrphaned(obj metav1.Object) bool {
+	return !obj.GetDeletionTimestamp().IsZero() &&
+		obj.GetAnnotations()[konnectconsts.AnnotationDeletionPolicy] == konnectconsts.DeletionPolicyOrphan
+}
+
 // generateKubernetesMetadataTags generates a list of tags from a Kubernetes object's metadata. The tags are formatted as
 // "key:value". These can be attached to a Konnect entity that doesn't support labels, but supports tags (e.g. Route, Service,
 // Consumer, etc.).
@@ -79,7 +93,16 @@ func generateKubernetesMetadataTags(obj ObjectWithMetadata) []string {
 
 // WithKubernetesMetadataLabels returns a map of user-provided labels to be assigned to a Konnect entity with the origin
 // Kubernetes object's metadata added. These can be assigned to a Konnect entity that supports labels (e.g. ControlPlane).
+// Kubernetes metadata labels are not added for objects being orphaned (see IsOrphaned).
 func WithKubernetesMetadataLabels(obj ObjectWithMetadata, userSetLabels map[string]string) map[string]string {
+	if IsOrphaned(obj) {
+		labels := make(map[string]string, len(userSetLabels))
+		for k, v := range userSetLabels {
+			labels[k] = v
+		}
+		return labels
+	}
+
 	labels := map[string]string{
 		KubernetesNameLabelKey:       obj.GetName(),
 		KubernetesUIDLabelKey:        string(obj.GetUID()),
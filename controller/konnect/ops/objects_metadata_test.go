@@ -3,6 +3,7 @@ package ops_test
 import (
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -77,6 +78,27 @@ func TestWithKubernetesMetadataLabels(t *testing.T) {
 	}
 }
 
+func TestWithKubernetesMetadataLabelsForOrphanedObject(t *testing.T) {
+	obj := testObjectKind{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "TestObjectKind",
+			APIVersion: "test.objects.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-object",
+			Namespace:         "test-namespace",
+			UID:               "test-uid",
+			DeletionTimestamp: lo.ToPtr(metav1.Now()),
+			Annotations: map[string]string{
+				"konnect.konghq.com/deletion-policy": "orphan",
+			},
+		},
+	}
+
+	labels := ops.WithKubernetesMetadataLabels(&obj, map[string]string{"user-label": "value"})
+	require.Equal(t, map[string]string{"user-label": "value"}, labels)
+}
+
 func TestGenerateTagsForObject(t *testing.T) {
 	namespacedObject := func() testObjectKind {
 		return testObjectKind{
@@ -182,6 +204,42 @@ func TestGenerateTagsForObject(t *testing.T) {
 				"tag3",
 			},
 		},
+		{
+			name: "kubernetes metadata tags are not set for orphaned object",
+			obj: func() testObjectKind {
+				obj := namespacedObject()
+				obj.ObjectMeta.Annotations = map[string]string{
+					"konghq.com/tags":                    "tag1",
+					"konnect.konghq.com/deletion-policy": "orphan",
+				}
+				obj.ObjectMeta.DeletionTimestamp = lo.ToPtr(metav1.Now())
+				return obj
+			}(),
+			additionalTags: []string{"tag2"},
+			expectedTags: []string{
+				"tag1",
+				"tag2",
+			},
+		},
+		{
+			name: "kubernetes metadata tags are set for object with orphan deletion policy which is not being deleted",
+			obj: func() testObjectKind {
+				obj := namespacedObject()
+				obj.ObjectMeta.Annotations = map[string]string{
+					"konnect.konghq.com/deletion-policy": "orphan",
+				}
+				return obj
+			}(),
+			expectedTags: []string{
+				"k8s-generation:2",
+				"k8s-group:test.objects.io",
+				"k8s-kind:TestObjectKind",
+				"k8s-name:test-object",
+				"k8s-namespace:test-namespace",
+				"k8s-uid:test-uid",
+				"k8s-version:v1",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
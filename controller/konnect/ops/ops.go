@@ -11,6 +11,7 @@ import (
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/kong/gateway-operator/controller/konnect/constraints"
+	konnectconsts "github.com/kong/gateway-operator/controller/konnect/consts"
 	"github.com/kong/gateway-operator/controller/pkg/log"
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 
@@ -36,9 +37,15 @@ const (
 	UpdateOp Op = "update"
 	// DeleteOp is the operation type for deleting a Konnect entity.
 	DeleteOp Op = "delete"
+	// OrphanOp is the operation type for orphaning a Konnect entity, i.e.
+	// leaving it in Konnect without the Kubernetes metadata tags.
+	OrphanOp Op = "orphan"
 )
 
 // Create creates a Konnect entity.
+// Before creating the entity, it looks up an entity created for the same object
+// (using its UID) in one of the previous reconciliations. If such entity exists,
+// its Konnect ID is set in the object's status and no new entity is created.
 func Create[
 	T constraints.SupportedKonnectEntityType,
 	TEnt constraints.EntityType[T],
@@ -48,10 +55,29 @@ func Create[
 	cl client.Client,
 	e *T,
 ) (*T, error) {
-	var (
-		err   error
-		start = time.Now()
-	)
+	id, err := getKonnectIDForUID[T, TEnt](ctx, sdk, e)
+	if err != nil {
+		SetKonnectEntityProgrammedConditionFalse(TEnt(e), "FailedToCreate", err.Error())
+		return e, err
+	}
+	if id != "" {
+		TEnt(e).GetKonnectStatus().SetKonnectID(id)
+		return e, nil
+	}
+
+	start := time.Now()
+	err = create[T, TEnt](ctx, sdk, cl, e)
+	logOpComplete[T, TEnt](ctx, start, CreateOp, e, err)
+
+	return e, err
+}
+
+// create performs the create operation of a Konnect entity.
+func create[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](ctx context.Context, sdk SDKWrapper, cl client.Client, e *T) error {
+	var err error
 	switch ent := any(e).(type) {
 	case *konnectv1alpha1.KonnectGatewayControlPlane:
 		err = createControlPlane(ctx, sdk.GetControlPlaneSDK(), sdk.GetControlPlaneGroupSDK(), cl, ent)
@@ -96,12 +122,10 @@ func Create[
 		// ---------------------------------------------------------------------
 		// TODO: add other Konnect types
 	default:
-		return nil, fmt.Errorf("unsupported entity type %T", ent)
+		return fmt.Errorf("unsupported entity type %T", ent)
 	}
 
-	logOpComplete[T, TEnt](ctx, start, CreateOp, e, err)
-
-	return e, err
+	return err
 }
 
 // Delete deletes a Konnect entity.
@@ -233,64 +257,110 @@ func Update[
 		)
 	}
 
-	var err error
+	err := update[T, TEnt](ctx, sdk, cl, e)
+	logOpComplete[T, TEnt](ctx, now, UpdateOp, e, err)
+
+	return ctrl.Result{}, err
+}
+
+// update performs the update operation of a Konnect entity.
+func update[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](ctx context.Context, sdk SDKWrapper, cl client.Client, e *T) error {
 	switch ent := any(e).(type) {
 	case *konnectv1alpha1.KonnectGatewayControlPlane:
-		err = updateControlPlane(ctx, sdk.GetControlPlaneSDK(), sdk.GetControlPlaneGroupSDK(), cl, ent)
+		return updateControlPlane(ctx, sdk.GetControlPlaneSDK(), sdk.GetControlPlaneGroupSDK(), cl, ent)
 	case *configurationv1alpha1.KongService:
-		err = updateService(ctx, sdk.GetServicesSDK(), ent)
+		return updateService(ctx, sdk.GetServicesSDK(), ent)
 	case *configurationv1alpha1.KongRoute:
-		err = updateRoute(ctx, sdk.GetRoutesSDK(), ent)
+		return updateRoute(ctx, sdk.GetRoutesSDK(), ent)
 	case *configurationv1.KongConsumer:
-		err = updateConsumer(ctx, sdk.GetConsumersSDK(), sdk.GetConsumerGroupsSDK(), cl, ent)
+		return updateConsumer(ctx, sdk.GetConsumersSDK(), sdk.GetConsumerGroupsSDK(), cl, ent)
 	case *configurationv1beta1.KongConsumerGroup:
-		err = updateConsumerGroup(ctx, sdk.GetConsumerGroupsSDK(), ent)
+		return updateConsumerGroup(ctx, sdk.GetConsumerGroupsSDK(), ent)
 	case *configurationv1alpha1.KongPluginBinding:
-		err = updatePlugin(ctx, sdk.GetPluginSDK(), cl, ent)
+		return updatePlugin(ctx, sdk.GetPluginSDK(), cl, ent)
 	case *configurationv1alpha1.KongUpstream:
-		err = updateUpstream(ctx, sdk.GetUpstreamsSDK(), ent)
+		return updateUpstream(ctx, sdk.GetUpstreamsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialBasicAuth:
-		err = updateKongCredentialBasicAuth(ctx, sdk.GetBasicAuthCredentialsSDK(), ent)
+		return updateKongCredentialBasicAuth(ctx, sdk.GetBasicAuthCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialAPIKey:
-		err = updateKongCredentialAPIKey(ctx, sdk.GetAPIKeyCredentialsSDK(), ent)
+		return updateKongCredentialAPIKey(ctx, sdk.GetAPIKeyCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialACL:
-		err = updateKongCredentialACL(ctx, sdk.GetACLCredentialsSDK(), ent)
+		return updateKongCredentialACL(ctx, sdk.GetACLCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialJWT:
-		err = updateKongCredentialJWT(ctx, sdk.GetJWTCredentialsSDK(), ent)
+		return updateKongCredentialJWT(ctx, sdk.GetJWTCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialHMAC:
-		err = updateKongCredentialHMAC(ctx, sdk.GetHMACCredentialsSDK(), ent)
+		return updateKongCredentialHMAC(ctx, sdk.GetHMACCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCACertificate:
-		err = updateCACertificate(ctx, sdk.GetCACertificatesSDK(), ent)
+		return updateCACertificate(ctx, sdk.GetCACertificatesSDK(), ent)
 	case *configurationv1alpha1.KongCertificate:
-		err = updateCertificate(ctx, sdk.GetCertificatesSDK(), ent)
+		return updateCertificate(ctx, sdk.GetCertificatesSDK(), ent)
 	case *configurationv1alpha1.KongTarget:
-		err = updateTarget(ctx, sdk.GetTargetsSDK(), ent)
+		return updateTarget(ctx, sdk.GetTargetsSDK(), ent)
 	case *configurationv1alpha1.KongVault:
-		err = updateVault(ctx, sdk.GetVaultSDK(), ent)
+		return updateVault(ctx, sdk.GetVaultSDK(), ent)
 	case *configurationv1alpha1.KongKey:
-		err = updateKey(ctx, sdk.GetKeysSDK(), ent)
+		return updateKey(ctx, sdk.GetKeysSDK(), ent)
 	case *configurationv1alpha1.KongKeySet:
-		err = updateKeySet(ctx, sdk.GetKeySetsSDK(), ent)
+		return updateKeySet(ctx, sdk.GetKeySetsSDK(), ent)
 	case *configurationv1alpha1.KongSNI:
-		err = updateSNI(ctx, sdk.GetSNIsSDK(), ent)
+		return updateSNI(ctx, sdk.GetSNIsSDK(), ent)
 	case *configurationv1alpha1.KongDataPlaneClientCertificate:
-		err = nil // DataPlaneCertificates are immutable.
+		return nil // DataPlaneCertificates are immutable.
 		// ---------------------------------------------------------------------
 		// TODO: add other Konnect types
 
 	default:
-		return ctrl.Result{}, fmt.Errorf("unsupported entity type %T", ent)
+		return fmt.Errorf("unsupported entity type %T", ent)
 	}
+}
 
-	logOpComplete[T, TEnt](ctx, now, UpdateOp, e, err)
+// Orphan leaves a Konnect entity in Konnect, removing the Kubernetes metadata
+// tags (or labels) from it so that it is no longer associated with the object.
+// The object is expected to be orphaned (see IsOrphaned).
+// Entities which do not exist in Konnect anymore are not recreated.
+func Orphan[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](ctx context.Context, sdk SDKWrapper, cl client.Client, e *T) error {
+	ent := TEnt(e)
+	if ent.GetKonnectStatus().GetKonnectID() == "" {
+		return nil
+	}
+	if !IsOrphaned(ent) {
+		return fmt.Errorf(
+			"can't orphan %T %s which is not being deleted with the %s deletion policy",
+			ent, client.ObjectKeyFromObject(ent), konnectconsts.DeletionPolicyOrphan,
+		)
+	}
 
-	return ctrl.Result{}, err
+	// Update operations recreate entities which are missing in Konnect so make
+	// sure the entity still exists (carrying the Kubernetes metadata tags)
+	// before stripping the tags.
+	id, err := getKonnectIDForUID[T, TEnt](ctx, sdk, e)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return nil
+	}
+
+	start := time.Now()
+	err = update[T, TEnt](ctx, sdk, cl, e)
+	logOpComplete[T, TEnt](ctx, start, OrphanOp, e, err)
+
+	return err
 }
 
+// logOpComplete logs the outcome of the operation and records its metrics.
 func logOpComplete[
 	T constraints.SupportedKonnectEntityType,
 	TEnt constraints.EntityType[T],
 ](ctx context.Context, start time.Time, op Op, e TEnt, err error) {
+	recordOpMetrics[T, TEnt](start, op, e, err)
+
 	keysAndValues := []interface{}{
 		"op", op,
 		"duration", time.Since(start).String(),
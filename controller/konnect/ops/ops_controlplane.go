@@ -8,6 +8,7 @@ import (
 
 	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
 	"github.com/samber/lo"
 	"github.com/sourcegraph/conc/iter"
@@ -196,3 +197,25 @@ type membersByID []sdkkonnectcomp.Members
 func (m membersByID) Len() int           { return len(m) }
 func (m membersByID) Less(i, j int) bool { return *m[i].ID < *m[j].ID }
 func (m membersByID) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+
+// getControlPlaneForUID lists ControlPlanes in Konnect with the "k8s-uid:<uid>"
+// label of the provided KonnectGatewayControlPlane and returns the Konnect ID
+// of the matching one.
+// It returns an empty string when there's no such ControlPlane.
+func getControlPlaneForUID(
+	ctx context.Context,
+	sdk ControlPlaneSDK,
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+) (string, error) {
+	resp, err := sdk.ListControlPlanes(ctx, sdkkonnectops.ListControlPlanesRequest{
+		Labels: lo.ToPtr(UIDLabelForObject(cp)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.ListControlPlanesResponse == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cp)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.ListControlPlanesResponse.Data), nil
+}
@@ -152,3 +152,25 @@ func kongCredentialACLToACLWithoutParents(
 		Tags:  GenerateTagsForObject(cred, cred.Spec.Tags...),
 	}
 }
+
+// getKongCredentialACLForUID lists KongCredentialACLs in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongCredentialACL and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongCredentialACLForUID(
+	ctx context.Context,
+	sdk KongCredentialACLSDK,
+	cred *configurationv1alpha1.KongCredentialACL,
+) (string, error) {
+	resp, err := sdk.ListACL(ctx, sdkkonnectops.ListACLRequest{
+		ControlPlaneID: cred.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(cred)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cred)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -131,3 +131,25 @@ func kongCredentialAPIKeyToKeyAuthWithoutParents(
 		Tags: GenerateTagsForObject(cred, cred.Spec.Tags...),
 	}
 }
+
+// getKongCredentialAPIKeyForUID lists KongCredentialAPIKeys in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongCredentialAPIKey and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongCredentialAPIKeyForUID(
+	ctx context.Context,
+	sdk KongCredentialAPIKeySDK,
+	cred *configurationv1alpha1.KongCredentialAPIKey,
+) (string, error) {
+	resp, err := sdk.ListKeyAuth(ctx, sdkkonnectops.ListKeyAuthRequest{
+		ControlPlaneID: cred.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(cred)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cred)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
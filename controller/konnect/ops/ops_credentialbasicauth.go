@@ -131,3 +131,25 @@ func kongCredentialBasicAuthToBasicAuthWithoutParents(
 		Tags:     GenerateTagsForObject(cred, cred.Spec.Tags...),
 	}
 }
+
+// getKongCredentialBasicAuthForUID lists KongCredentialBasicAuths in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongCredentialBasicAuth and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongCredentialBasicAuthForUID(
+	ctx context.Context,
+	sdk KongCredentialBasicAuthSDK,
+	cred *configurationv1alpha1.KongCredentialBasicAuth,
+) (string, error) {
+	resp, err := sdk.ListBasicAuth(ctx, sdkkonnectops.ListBasicAuthRequest{
+		ControlPlaneID: cred.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(cred)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cred)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
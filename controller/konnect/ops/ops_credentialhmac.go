@@ -8,6 +8,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -153,3 +154,25 @@ func kongCredentialHMACToHMACWithoutParents(
 	}
 	return ret
 }
+
+// getKongCredentialHMACForUID lists KongCredentialHMACs in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongCredentialHMAC and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongCredentialHMACForUID(
+	ctx context.Context,
+	sdk KongCredentialHMACSDK,
+	cred *configurationv1alpha1.KongCredentialHMAC,
+) (string, error) {
+	resp, err := sdk.ListHmacAuth(ctx, sdkkonnectops.ListHmacAuthRequest{
+		ControlPlaneID: cred.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(cred)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cred)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -8,6 +8,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -155,3 +156,25 @@ func kongCredentialJWTToJWTWithoutParents(
 	}
 	return ret
 }
+
+// getKongCredentialJWTForUID lists KongCredentialJWTs in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongCredentialJWT and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongCredentialJWTForUID(
+	ctx context.Context,
+	sdk KongCredentialJWTSDK,
+	cred *configurationv1alpha1.KongCredentialJWT,
+) (string, error) {
+	resp, err := sdk.ListJwt(ctx, sdkkonnectops.ListJwtRequest{
+		ControlPlaneID: cred.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(cred)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cred)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -0,0 +1,108 @@
+package ops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/samber/lo"
+)
+
+const (
+	// dataPlaneNodesPageSize is the page size used when listing data plane nodes.
+	dataPlaneNodesPageSize int64 = 100
+
+	// dataPlaneNodeConnectedThreshold is the time since the last ping of a data
+	// plane node after which the node is no longer considered connected.
+	// Data plane nodes ping the ControlPlane every 30 seconds.
+	dataPlaneNodeConnectedThreshold = 90 * time.Second
+)
+
+// DataPlaneNode is a data plane node connected to a Konnect ControlPlane.
+type DataPlaneNode struct {
+	// ID is the Konnect ID of the node.
+	ID string
+	// Hostname is the hostname reported by the node, i.e. the name of its Pod.
+	Hostname string
+	// Version is the Kong Gateway version of the node.
+	Version string
+	// ConfigHash is the hash of the configuration applied by the node.
+	ConfigHash string
+	// InSync is true when the node applied the latest ControlPlane's configuration.
+	InSync bool
+}
+
+// DataPlaneNodesStatus describes the data plane nodes connected to a Konnect ControlPlane.
+type DataPlaneNodesStatus struct {
+	// ExpectedConfigHash is the hash of the latest ControlPlane's configuration.
+	ExpectedConfigHash string
+	// Nodes are the nodes connected to the ControlPlane.
+	Nodes []DataPlaneNode
+}
+
+// OutOfSync returns the connected nodes which did not apply the latest
+// ControlPlane's configuration.
+func (s DataPlaneNodesStatus) OutOfSync() []DataPlaneNode {
+	return lo.Filter(s.Nodes, func(n DataPlaneNode, _ int) bool {
+		return !n.InSync
+	})
+}
+
+// GetDataPlaneNodesStatus lists the data plane nodes of the Konnect ControlPlane
+// with the provided ID and returns the ones connected at the provided time,
+// along with their configuration sync state.
+func GetDataPlaneNodesStatus(
+	ctx context.Context,
+	sdk DataPlaneNodesSDK,
+	cpID string,
+	now time.Time,
+) (DataPlaneNodesStatus, error) {
+	respHash, err := sdk.GetExpectedConfigHash(ctx, cpID)
+	if err != nil {
+		return DataPlaneNodesStatus{}, fmt.Errorf("failed to get expected config hash of ControlPlane %s: %w", cpID, err)
+	}
+	if respHash == nil || respHash.GetExpectedConfigHash == nil {
+		return DataPlaneNodesStatus{}, fmt.Errorf("failed to get expected config hash of ControlPlane %s: got empty response", cpID)
+	}
+	status := DataPlaneNodesStatus{
+		ExpectedConfigHash: lo.FromPtr(respHash.GetExpectedConfigHash.ExpectedHash),
+	}
+
+	var pageAfter *string
+	for {
+		resp, err := sdk.ListDataplaneNodes(ctx, sdkkonnectops.ListDataplaneNodesRequest{
+			ControlPlaneID: cpID,
+			PageSize:       lo.ToPtr(dataPlaneNodesPageSize),
+			PageAfter:      pageAfter,
+		})
+		if err != nil {
+			return DataPlaneNodesStatus{}, fmt.Errorf("failed to list data plane nodes of ControlPlane %s: %w", cpID, err)
+		}
+		if resp == nil || resp.ListNodes == nil {
+			return DataPlaneNodesStatus{}, fmt.Errorf("failed to list data plane nodes of ControlPlane %s: %w", cpID, errors.New("got empty response"))
+		}
+
+		for _, n := range resp.ListNodes.GetItems() {
+			lastPing := time.Unix(lo.FromPtr(n.GetLastPing()), 0)
+			if now.Sub(lastPing) > dataPlaneNodeConnectedThreshold {
+				continue
+			}
+			configHash := lo.FromPtr(n.GetConfigHash())
+			status.Nodes = append(status.Nodes, DataPlaneNode{
+				ID:         lo.FromPtr(n.GetID()),
+				Hostname:   lo.FromPtr(n.GetHostname()),
+				Version:    lo.FromPtr(n.GetVersion()),
+				ConfigHash: configHash,
+				// Without the expected hash, the sync state can't be determined.
+				InSync: status.ExpectedConfigHash == "" || configHash == status.ExpectedConfigHash,
+			})
+		}
+
+		pageAfter = resp.ListNodes.GetPage().GetNext()
+		if lo.FromPtr(pageAfter) == "" {
+			return status, nil
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package ops
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDataPlaneNodesStatus(t *testing.T) {
+	const cpID = "cp-id"
+	now := time.Unix(1_700_000_000, 0)
+	node := func(id, hash string, lastPing time.Time) sdkkonnectcomp.ListNodesItems {
+		return sdkkonnectcomp.ListNodesItems{
+			ID:         lo.ToPtr(id),
+			Hostname:   lo.ToPtr("host-" + id),
+			Version:    lo.ToPtr("3.8.0.0"),
+			ConfigHash: lo.ToPtr(hash),
+			LastPing:   lo.ToPtr(lastPing.Unix()),
+		}
+	}
+
+	t.Run("connected nodes are returned with their sync state", func(t *testing.T) {
+		sdk := NewMockDataPlaneNodesSDK(t)
+		sdk.EXPECT().GetExpectedConfigHash(context.Background(), cpID).Return(
+			&sdkkonnectops.GetExpectedConfigHashResponse{
+				GetExpectedConfigHash: &sdkkonnectcomp.GetExpectedConfigHash{
+					ExpectedHash: lo.ToPtr("hash-2"),
+				},
+			}, nil,
+		)
+		sdk.EXPECT().ListDataplaneNodes(context.Background(), sdkkonnectops.ListDataplaneNodesRequest{
+			ControlPlaneID: cpID,
+			PageSize:       lo.ToPtr(dataPlaneNodesPageSize),
+		}).Return(
+			&sdkkonnectops.ListDataplaneNodesResponse{
+				ListNodes: &sdkkonnectcomp.ListNodes{
+					Items: []sdkkonnectcomp.ListNodesItems{
+						node("1", "hash-2", now.Add(-10*time.Second)),
+						node("2", "hash-1", now.Add(-time.Hour)),
+					},
+					Page: &sdkkonnectcomp.ListNodesPage{
+						Next: lo.ToPtr("next"),
+					},
+				},
+			}, nil,
+		)
+		sdk.EXPECT().ListDataplaneNodes(context.Background(), sdkkonnectops.ListDataplaneNodesRequest{
+			ControlPlaneID: cpID,
+			PageSize:       lo.ToPtr(dataPlaneNodesPageSize),
+			PageAfter:      lo.ToPtr("next"),
+		}).Return(
+			&sdkkonnectops.ListDataplaneNodesResponse{
+				ListNodes: &sdkkonnectcomp.ListNodes{
+					Items: []sdkkonnectcomp.ListNodesItems{
+						node("3", "hash-1", now.Add(-30*time.Second)),
+					},
+				},
+			}, nil,
+		)
+
+		status, err := GetDataPlaneNodesStatus(context.Background(), sdk, cpID, now)
+		require.NoError(t, err)
+		assert.Equal(t, DataPlaneNodesStatus{
+			ExpectedConfigHash: "hash-2",
+			Nodes: []DataPlaneNode{
+				{ID: "1", Hostname: "host-1", Version: "3.8.0.0", ConfigHash: "hash-2", InSync: true},
+				{ID: "3", Hostname: "host-3", Version: "3.8.0.0", ConfigHash: "hash-1", InSync: false},
+			},
+		}, status)
+		assert.Equal(t, []DataPlaneNode{status.Nodes[1]}, status.OutOfSync())
+	})
+
+	t.Run("error listing nodes is returned", func(t *testing.T) {
+		sdk := NewMockDataPlaneNodesSDK(t)
+		sdk.EXPECT().GetExpectedConfigHash(context.Background(), cpID).Return(
+			&sdkkonnectops.GetExpectedConfigHashResponse{
+				GetExpectedConfigHash: &sdkkonnectcomp.GetExpectedConfigHash{},
+			}, nil,
+		)
+		sdk.EXPECT().ListDataplaneNodes(context.Background(), sdkkonnectops.ListDataplaneNodesRequest{
+			ControlPlaneID: cpID,
+			PageSize:       lo.ToPtr(dataPlaneNodesPageSize),
+		}).Return(nil, assert.AnError)
+
+		_, err := GetDataPlaneNodesStatus(context.Background(), sdk, cpID, now)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
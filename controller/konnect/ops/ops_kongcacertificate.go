@@ -8,6 +8,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -120,3 +121,25 @@ func kongCACertificateToCACertificateInput(cert *configurationv1alpha1.KongCACer
 		Tags: GenerateTagsForObject(cert, cert.Spec.Tags...),
 	}
 }
+
+// getKongCACertificateForUID lists KongCACertificates in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongCACertificate and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongCACertificateForUID(
+	ctx context.Context,
+	sdk CACertificatesSDK,
+	cert *configurationv1alpha1.KongCACertificate,
+) (string, error) {
+	resp, err := sdk.ListCaCertificate(ctx, sdkkonnectops.ListCaCertificateRequest{
+		ControlPlaneID: cert.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(cert)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cert)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -8,6 +8,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -141,3 +142,25 @@ func kongCertificateToCertificateInput(cert *configurationv1alpha1.KongCertifica
 		Tags: GenerateTagsForObject(cert, cert.Spec.Tags...),
 	}
 }
+
+// getKongCertificateForUID lists KongCertificates in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongCertificate and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongCertificateForUID(
+	ctx context.Context,
+	sdk CertificatesSDK,
+	cert *configurationv1alpha1.KongCertificate,
+) (string, error) {
+	resp, err := sdk.ListCertificate(ctx, sdkkonnectops.ListCertificateRequest{
+		ControlPlaneID: cert.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(cert)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cert)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
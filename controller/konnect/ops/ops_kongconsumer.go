@@ -332,3 +332,25 @@ func kongConsumerToSDKConsumerInput(
 		Username: lo.ToPtr(consumer.Username),
 	}
 }
+
+// getKongConsumerForUID lists KongConsumers in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongConsumer and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongConsumerForUID(
+	ctx context.Context,
+	sdk ConsumersSDK,
+	consumer *configurationv1.KongConsumer,
+) (string, error) {
+	resp, err := sdk.ListConsumer(ctx, sdkkonnectops.ListConsumerRequest{
+		ControlPlaneID: consumer.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(consumer)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", consumer)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -8,6 +8,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -119,3 +120,25 @@ func kongConsumerGroupToSDKConsumerGroupInput(
 		Name: group.Spec.Name,
 	}
 }
+
+// getKongConsumerGroupForUID lists KongConsumerGroups in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongConsumerGroup and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongConsumerGroupForUID(
+	ctx context.Context,
+	sdk ConsumerGroupSDK,
+	cg *configurationv1beta1.KongConsumerGroup,
+) (string, error) {
+	resp, err := sdk.ListConsumerGroup(ctx, sdkkonnectops.ListConsumerGroupRequest{
+		ControlPlaneID: cg.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(cg)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", cg)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -152,3 +152,25 @@ func kongKeyToKeyInput(key *configurationv1alpha1.KongKey) sdkkonnectcomp.KeyInp
 	}
 	return k
 }
+
+// getKongKeyForUID lists KongKeys in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongKey and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongKeyForUID(
+	ctx context.Context,
+	sdk KeysSDK,
+	key *configurationv1alpha1.KongKey,
+) (string, error) {
+	resp, err := sdk.ListKey(ctx, sdkkonnectops.ListKeyRequest{
+		ControlPlaneID: key.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(key)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", key)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -136,3 +136,25 @@ func kongKeySetToKeySetInput(keySet *configurationv1alpha1.KongKeySet) sdkkonnec
 		Tags: GenerateTagsForObject(keySet, keySet.Spec.Tags...),
 	}
 }
+
+// getKongKeySetForUID lists KongKeySets in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongKeySet and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongKeySetForUID(
+	ctx context.Context,
+	sdk KeySetsSDK,
+	keySet *configurationv1alpha1.KongKeySet,
+) (string, error) {
+	resp, err := sdk.ListKeySet(ctx, sdkkonnectops.ListKeySetRequest{
+		ControlPlaneID: keySet.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(keySet)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", keySet)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -213,18 +213,26 @@ func getPluginBindingTargets(
 	return targetObjects, nil
 }
 
-// getReferencedPlugin returns the KongPlugin referenced by the KongPluginBinding.spec.pluginRef field.
-func getReferencedPlugin(ctx context.Context, cl client.Client, pluginBinding *configurationv1alpha1.KongPluginBinding) (*configurationv1.KongPlugin, error) {
-	// TODO(mlavacca): add support for KongClusterPlugin
-	plugin := configurationv1.KongPlugin{}
+// getReferencedPlugin returns the KongPlugin or the KongClusterPlugin referenced
+// by the KongPluginBinding.spec.pluginRef field.
+func getReferencedPlugin(ctx context.Context, cl client.Client, pluginBinding *configurationv1alpha1.KongPluginBinding) (client.Object, error) {
+	var plugin client.Object
+	switch kind := lo.FromPtrOr(pluginBinding.Spec.PluginReference.Kind, "KongPlugin"); kind {
+	case "KongPlugin":
+		plugin = &configurationv1.KongPlugin{}
+		plugin.SetNamespace(pluginBinding.GetNamespace())
+	case "KongClusterPlugin":
+		plugin = &configurationv1.KongClusterPlugin{}
+	default:
+		return nil, fmt.Errorf("unsupported plugin kind %q", kind)
+	}
 	plugin.SetName(pluginBinding.Spec.PluginReference.Name)
-	plugin.SetNamespace(pluginBinding.GetNamespace())
 
-	if err := cl.Get(ctx, client.ObjectKeyFromObject(&plugin), &plugin); err != nil {
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(plugin), plugin); err != nil {
 		return nil, err
 	}
 
-	return &plugin, nil
+	return plugin, nil
 }
 
 type pluginTarget interface {
@@ -233,29 +241,48 @@ type pluginTarget interface {
 	GetTypeName() string
 }
 
-// kongPluginWithTargetsToKongPluginInput converts a KongPlugin configuration along with KongPluginBinding's targets and
-// tags to an SKD PluginInput.
+// kongPluginWithTargetsToKongPluginInput converts a KongPlugin or KongClusterPlugin configuration
+// along with KongPluginBinding's targets and tags to an SKD PluginInput.
 func kongPluginWithTargetsToKongPluginInput(
-	plugin *configurationv1.KongPlugin,
+	plugin client.Object,
 	targets []pluginTarget,
 	tags []string,
 ) (*sdkkonnectcomp.PluginInput, error) {
+	// TODO: support global plugins bound to a KonnectGatewayControlPlane only
+	// once the KongPluginBinding CRD from kubernetes-configuration accepts
+	// bindings without targets. Until then, such bindings are rejected by the
+	// CRD validation and this is not reachable through the API.
 	if len(targets) == 0 {
-		return nil, fmt.Errorf("no targets found for KongPluginBinding %s", client.ObjectKeyFromObject(plugin))
+		return nil, fmt.Errorf("no targets found for KongPluginBinding of %s, global plugins are not supported", client.ObjectKeyFromObject(plugin))
+	}
+
+	var (
+		kind       string
+		pluginName string
+		rawConfig  []byte
+		disabled   bool
+	)
+	switch p := plugin.(type) {
+	case *configurationv1.KongPlugin:
+		kind, pluginName, rawConfig, disabled = "KongPlugin", p.PluginName, p.Config.Raw, p.Disabled
+	case *configurationv1.KongClusterPlugin:
+		kind, pluginName, rawConfig, disabled = "KongClusterPlugin", p.PluginName, p.Config.Raw, p.Disabled
+	default:
+		return nil, fmt.Errorf("unsupported plugin type %T", plugin)
 	}
 
 	pluginConfig := map[string]any{}
-	if rawConfig := plugin.Config.Raw; rawConfig != nil {
+	if rawConfig != nil {
 		// If the config is empty (a valid case), there's no need to unmarshal (as it would fail).
 		if err := json.Unmarshal(rawConfig, &pluginConfig); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal KongPlugin %s config: %w", client.ObjectKeyFromObject(plugin), err)
+			return nil, fmt.Errorf("failed to unmarshal %s %s config: %w", kind, client.ObjectKeyFromObject(plugin), err)
 		}
 	}
 
 	pluginInput := &sdkkonnectcomp.PluginInput{
-		Name:    plugin.PluginName,
+		Name:    pluginName,
 		Config:  pluginConfig,
-		Enabled: lo.ToPtr(!plugin.Disabled),
+		Enabled: lo.ToPtr(!disabled),
 		Tags:    tags,
 	}
 
@@ -291,3 +318,25 @@ func kongPluginWithTargetsToKongPluginInput(
 
 	return pluginInput, nil
 }
+
+// getPluginForUID lists KongPluginBindings in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongPluginBinding and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getPluginForUID(
+	ctx context.Context,
+	sdk PluginSDK,
+	pb *configurationv1alpha1.KongPluginBinding,
+) (string, error) {
+	resp, err := sdk.ListPlugin(ctx, sdkkonnectops.ListPluginRequest{
+		ControlPlaneID: pb.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(pb)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", pb)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -104,3 +105,64 @@ func TestKongPluginBindingToSDKPluginInput_Tags(t *testing.T) {
 	}
 	require.ElementsMatch(t, expectedTags, output.Tags)
 }
+
+func TestKongPluginBindingToSDKPluginInput_KongClusterPlugin(t *testing.T) {
+	ctx := context.Background()
+	newPluginBinding := func(targets configurationv1alpha1.KongPluginBindingTargets) *configurationv1alpha1.KongPluginBinding {
+		return &configurationv1alpha1.KongPluginBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "plugin-binding-1",
+				Namespace: "default",
+			},
+			Spec: configurationv1alpha1.KongPluginBindingSpec{
+				PluginReference: configurationv1alpha1.PluginRef{
+					Name: "cluster-plugin-1",
+					Kind: lo.ToPtr("KongClusterPlugin"),
+				},
+				Targets: targets,
+			},
+		}
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme.Get()).WithObjects(
+		&configurationv1.KongClusterPlugin{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-plugin-1",
+			},
+			PluginName: "rate-limiting",
+			Config: apiextensionsv1.JSON{
+				Raw: []byte(`{"minute":5}`),
+			},
+		},
+		&configurationv1alpha1.KongService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "service-1",
+				Namespace: "default",
+			},
+			Status: configurationv1alpha1.KongServiceStatus{
+				Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneRef{
+					KonnectEntityStatus: konnectv1alpha1.KonnectEntityStatus{
+						ID: "12345",
+					},
+				},
+			},
+		},
+	).Build()
+
+	t.Run("KongClusterPlugin bound to a service", func(t *testing.T) {
+		output, err := kongPluginBindingToSDKPluginInput(ctx, cl, newPluginBinding(configurationv1alpha1.KongPluginBindingTargets{
+			ServiceReference: &configurationv1alpha1.TargetRefWithGroupKind{
+				Name: "service-1",
+				Kind: "KongService",
+			},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "rate-limiting", output.Name)
+		require.Equal(t, map[string]any{"minute": float64(5)}, output.Config)
+		require.Equal(t, lo.ToPtr("12345"), output.Service.ID)
+	})
+
+	t.Run("KongClusterPlugin without targets", func(t *testing.T) {
+		_, err := kongPluginBindingToSDKPluginInput(ctx, cl, newPluginBinding(configurationv1alpha1.KongPluginBindingTargets{}))
+		require.ErrorContains(t, err, "no targets found for KongPluginBinding")
+	})
+}
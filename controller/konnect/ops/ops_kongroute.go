@@ -9,6 +9,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -137,3 +138,25 @@ func kongRouteToSDKRouteInput(
 	}
 	return r
 }
+
+// getKongRouteForUID lists KongRoutes in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongRoute and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongRouteForUID(
+	ctx context.Context,
+	sdk RoutesSDK,
+	route *configurationv1alpha1.KongRoute,
+) (string, error) {
+	resp, err := sdk.ListRoute(ctx, sdkkonnectops.ListRouteRequest{
+		ControlPlaneID: route.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(route)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", route)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
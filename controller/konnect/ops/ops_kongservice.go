@@ -8,6 +8,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -161,3 +162,25 @@ func kongServiceToSDKServiceInput(
 		WriteTimeout:   svc.Spec.KongServiceAPISpec.WriteTimeout,
 	}
 }
+
+// getKongServiceForUID lists KongServices in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongService and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongServiceForUID(
+	ctx context.Context,
+	sdk ServicesSDK,
+	svc *configurationv1alpha1.KongService,
+) (string, error) {
+	resp, err := sdk.ListService(ctx, sdkkonnectops.ListServiceRequest{
+		ControlPlaneID: svc.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(svc)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", svc)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -9,6 +9,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -151,3 +152,25 @@ func kongSNIToSNIWithoutParents(sni *configurationv1alpha1.KongSNI) sdkkonnectco
 		Tags: GenerateTagsForObject(sni, sni.Spec.Tags...),
 	}
 }
+
+// getKongSNIForUID lists KongSNIs in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongSNI and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongSNIForUID(
+	ctx context.Context,
+	sdk SNIsSDK,
+	sni *configurationv1alpha1.KongSNI,
+) (string, error) {
+	resp, err := sdk.ListSni(ctx, sdkkonnectops.ListSniRequest{
+		ControlPlaneID: sni.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(sni)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", sni)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -127,3 +127,30 @@ func kongTargetToTargetWithoutParents(target *configurationv1alpha1.KongTarget)
 		Tags:   GenerateTagsForObject(target, target.Spec.Tags...),
 	}
 }
+
+// getKongTargetForUID lists KongTargets in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongTarget and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongTargetForUID(
+	ctx context.Context,
+	sdk TargetsSDK,
+	target *configurationv1alpha1.KongTarget,
+) (string, error) {
+	if target.Status.Konnect == nil || target.Status.Konnect.UpstreamID == "" {
+		return "", fmt.Errorf("can't look up %T %s without a Konnect Upstream ID", target, client.ObjectKeyFromObject(target))
+	}
+
+	resp, err := sdk.ListTargetWithUpstream(ctx, sdkkonnectops.ListTargetWithUpstreamRequest{
+		ControlPlaneID:      target.GetControlPlaneID(),
+		UpstreamIDForTarget: target.Status.Konnect.UpstreamID,
+		Tags:                lo.ToPtr(UIDLabelForObject(target)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", target)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -8,6 +8,7 @@ import (
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
 	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
 	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -165,3 +166,25 @@ func kongUpstreamToSDKUpstreamInput(
 		UseSrvName:             upstream.Spec.UseSrvName,
 	}
 }
+
+// getKongUpstreamForUID lists KongUpstreams in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongUpstream and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongUpstreamForUID(
+	ctx context.Context,
+	sdk UpstreamsSDK,
+	upstream *configurationv1alpha1.KongUpstream,
+) (string, error) {
+	resp, err := sdk.ListUpstream(ctx, sdkkonnectops.ListUpstreamRequest{
+		ControlPlaneID: upstream.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(upstream)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", upstream)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -145,3 +145,25 @@ func kongVaultToVaultInput(vault *configurationv1alpha1.KongVault) (sdkkonnectco
 	}
 	return input, nil
 }
+
+// getKongVaultForUID lists KongVaults in Konnect with the "k8s-uid:<uid>" tag of the
+// provided KongVault and returns the Konnect ID of the matching one.
+// It returns an empty string when there's no such entity.
+func getKongVaultForUID(
+	ctx context.Context,
+	sdk VaultSDK,
+	vault *configurationv1alpha1.KongVault,
+) (string, error) {
+	resp, err := sdk.ListVault(ctx, sdkkonnectops.ListVaultRequest{
+		ControlPlaneID: vault.GetControlPlaneID(),
+		Tags:           lo.ToPtr(UIDLabelForObject(vault)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %T: got empty response", vault)
+	}
+
+	return getMatchingEntryFromListResponseData(resp.Object.Data), nil
+}
@@ -0,0 +1,92 @@
+package ops
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	konnectconsts "github.com/kong/gateway-operator/controller/konnect/consts"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func TestOrphan(t *testing.T) {
+	ctx := context.Background()
+	newService := func(annotations map[string]string) *configurationv1alpha1.KongService {
+		return &configurationv1alpha1.KongService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "svc-1",
+				Namespace:         "default",
+				UID:               k8stypes.UID("uid-1"),
+				DeletionTimestamp: lo.ToPtr(metav1.Now()),
+				Annotations:       annotations,
+			},
+			Spec: configurationv1alpha1.KongServiceSpec{
+				KongServiceAPISpec: configurationv1alpha1.KongServiceAPISpec{
+					Name: lo.ToPtr("svc-1"),
+					Host: "example.com",
+					Tags: []string{"user-tag"},
+				},
+			},
+			Status: configurationv1alpha1.KongServiceStatus{
+				Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneRef{
+					ControlPlaneID: "cp-id",
+					KonnectEntityStatus: konnectv1alpha1.KonnectEntityStatus{
+						ID: "svc-id",
+					},
+				},
+			},
+		}
+	}
+	orphanPolicy := map[string]string{
+		konnectconsts.AnnotationDeletionPolicy: konnectconsts.DeletionPolicyOrphan,
+	}
+	listRequest := sdkkonnectops.ListServiceRequest{
+		ControlPlaneID: "cp-id",
+		Tags:           lo.ToPtr("k8s-uid:uid-1"),
+	}
+
+	t.Run("kubernetes metadata tags are stripped from the entity", func(t *testing.T) {
+		sdk := NewMockSDKWrapperWithT(t)
+		sdk.ServicesSDK.EXPECT().ListService(ctx, listRequest).Return(
+			&sdkkonnectops.ListServiceResponse{
+				Object: &sdkkonnectops.ListServiceResponseBody{
+					Data: []sdkkonnectcomp.Service{{ID: lo.ToPtr("svc-id")}},
+				},
+			}, nil,
+		)
+		sdk.ServicesSDK.EXPECT().UpsertService(ctx,
+			mock.MatchedBy(func(req sdkkonnectops.UpsertServiceRequest) bool {
+				return req.ServiceID == "svc-id" &&
+					slices.Equal(req.Service.Tags, []string{"user-tag"})
+			}),
+		).Return(&sdkkonnectops.UpsertServiceResponse{}, nil)
+
+		require.NoError(t, Orphan[configurationv1alpha1.KongService](ctx, sdk, nil, newService(orphanPolicy)))
+	})
+
+	t.Run("entity missing in Konnect is not recreated", func(t *testing.T) {
+		sdk := NewMockSDKWrapperWithT(t)
+		sdk.ServicesSDK.EXPECT().ListService(ctx, listRequest).Return(
+			&sdkkonnectops.ListServiceResponse{
+				Object: &sdkkonnectops.ListServiceResponseBody{},
+			}, nil,
+		)
+
+		require.NoError(t, Orphan[configurationv1alpha1.KongService](ctx, sdk, nil, newService(orphanPolicy)))
+	})
+
+	t.Run("object without the orphan deletion policy can't be orphaned", func(t *testing.T) {
+		sdk := NewMockSDKWrapperWithT(t)
+		require.Error(t, Orphan[configurationv1alpha1.KongService](ctx, sdk, nil, newService(nil)))
+	})
+}
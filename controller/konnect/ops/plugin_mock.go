@@ -175,6 +175,80 @@ func (_c *MockPluginSDK_DeletePlugin_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ListPlugin provides a mock function with given fields: ctx, request, opts
+func (_m *MockPluginSDK) ListPlugin(ctx context.Context, request operations.ListPluginRequest, opts ...operations.Option) (*operations.ListPluginResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPlugin")
+	}
+
+	var r0 *operations.ListPluginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListPluginRequest, ...operations.Option) (*operations.ListPluginResponse, error)); ok {
+		return rf(ctx, request, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operations.ListPluginRequest, ...operations.Option) *operations.ListPluginResponse); ok {
+		r0 = rf(ctx, request, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.ListPluginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operations.ListPluginRequest, ...operations.Option) error); ok {
+		r1 = rf(ctx, request, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPluginSDK_ListPlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPlugin'
+type MockPluginSDK_ListPlugin_Call struct {
+	*mock.Call
+}
+
+// ListPlugin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request operations.ListPluginRequest
+//   - opts ...operations.Option
+func (_e *MockPluginSDK_Expecter) ListPlugin(ctx interface{}, request interface{}, opts ...interface{}) *MockPluginSDK_ListPlugin_Call {
+	return &MockPluginSDK_ListPlugin_Call{Call: _e.mock.On("ListPlugin",
+		append([]interface{}{ctx, request}, opts...)...)}
+}
+
+func (_c *MockPluginSDK_ListPlugin_Call) Run(run func(ctx context.Context, request operations.ListPluginRequest, opts ...operations.Option)) *MockPluginSDK_ListPlugin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(operations.ListPluginRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockPluginSDK_ListPlugin_Call) Return(_a0 *operations.ListPluginResponse, _a1 error) *MockPluginSDK_ListPlugin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPluginSDK_ListPlugin_Call) RunAndReturn(run func(context.Context, operations.ListPluginRequest, ...operations.Option) (*operations.ListPluginResponse, error)) *MockPluginSDK_ListPlugin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertPlugin provides a mock function with given fields: ctx, request, opts
 func (_m *MockPluginSDK) UpsertPlugin(ctx context.Context, request operations.UpsertPluginRequest, opts ...operations.Option) (*operations.UpsertPluginResponse, error) {
 	_va := make([]interface{}, len(opts))
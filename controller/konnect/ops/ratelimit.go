@@ -0,0 +1,230 @@
+package ops
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// rateLimitMaxClientSideWait is the maximum time a request waits for the
+	// client side rate limiter before it's rejected with a RateLimitedError.
+	// Longer waits would block the reconciler's workers.
+	rateLimitMaxClientSideWait = time.Second
+
+	// rateLimiterIdleTimeout is the time after which rate limiters of
+	// organizations (or tokens) which are not used anymore are evicted.
+	rateLimiterIdleTimeout = time.Hour
+
+	// headerRetryAfter is the header set by Konnect on throttled (429) responses.
+	headerRetryAfter = "Retry-After"
+	// headerRateLimitRemaining is the header set by Konnect with the number
+	// of requests left in the current rate limiting window.
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	// headerRateLimitReset is the header set by Konnect with the number of
+	// seconds until the current rate limiting window resets.
+	headerRateLimitReset = "RateLimit-Reset"
+)
+
+// RateLimitedError is returned when a request to Konnect API is throttled,
+// either by the client side rate limiter or by Konnect itself.
+// Callers should retry after RetryAfter instead of treating it as a failure.
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e RateLimitedError) Error() string {
+	return fmt.Sprintf("Konnect API rate limit exceeded, retry after %s", e.RetryAfter)
+}
+
+// RateLimiterConfig configures the client side rate limiting of Konnect API requests.
+type RateLimiterConfig struct {
+	// Limit is the number of requests per second allowed.
+	Limit float64
+	// Burst is the maximum number of requests allowed at once.
+	Burst int
+}
+
+// rateLimiters holds rate limiters shared by all the SDKs using the same
+// Konnect organization.
+type rateLimiters struct {
+	cfg      RateLimiterConfig
+	now      func() time.Time
+	lock     sync.Mutex
+	limiters map[string]*orgRateLimiter
+}
+
+func newRateLimiters(cfg RateLimiterConfig) *rateLimiters {
+	return &rateLimiters{
+		cfg:      cfg,
+		now:      time.Now,
+		limiters: make(map[string]*orgRateLimiter),
+	}
+}
+
+// get returns the rate limiter for the provided Konnect organization.
+// Limiters are keyed by the server URL and the organization ID. When the
+// organization ID is not known yet (e.g. before the KonnectAPIAuthConfiguration
+// is validated), the (hashed) token is used instead as Konnect tokens are
+// issued for a single organization.
+// Limiters which have not been used for rateLimiterIdleTimeout are evicted.
+func (r *rateLimiters) get(serverURL string, token SDKToken, orgID string) *orgRateLimiter {
+	key := serverURL + "/org/" + orgID
+	if orgID == "" {
+		hash := sha256.Sum256([]byte(token))
+		key = serverURL + "/token/" + hex.EncodeToString(hash[:])
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	for k, l := range r.limiters {
+		if k != key && now.Sub(l.lastUsed) > rateLimiterIdleTimeout && l.blocked(now) <= 0 {
+			delete(r.limiters, k)
+		}
+	}
+
+	l, ok := r.limiters[key]
+	if !ok {
+		l = &orgRateLimiter{
+			serverURL: serverURL,
+			limiter:   rate.NewLimiter(rate.Limit(r.cfg.Limit), r.cfg.Burst),
+		}
+		r.limiters[key] = l
+	}
+	l.lastUsed = now
+	return l
+}
+
+// orgRateLimiter is a token bucket rate limiter for a Konnect organization,
+// which additionally blocks all requests until the time indicated by Konnect
+// in throttled responses.
+type orgRateLimiter struct {
+	serverURL string
+	limiter   *rate.Limiter
+	// lastUsed is the last time the limiter was handed out to an SDK.
+	// It's guarded by the rateLimiters' lock.
+	lastUsed time.Time
+
+	lock         sync.Mutex
+	blockedUntil time.Time
+}
+
+// blockUntil blocks all requests until the provided time.
+func (l *orgRateLimiter) blockUntil(t time.Time) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if t.After(l.blockedUntil) {
+		l.blockedUntil = t
+	}
+}
+
+// blocked returns the time left until requests are allowed again.
+func (l *orgRateLimiter) blocked(now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.blockedUntil.Sub(now)
+}
+
+// rateLimitedHTTPClient is an HTTP client used by the Konnect SDK which
+// throttles requests using the organization's rate limiter.
+type rateLimitedHTTPClient struct {
+	client  sdkkonnectgo.HTTPClient
+	limiter *orgRateLimiter
+	now     func() time.Time
+}
+
+var _ sdkkonnectgo.HTTPClient = (*rateLimitedHTTPClient)(nil)
+
+// Do sends the request when the rate limiter allows it.
+// It returns a RateLimitedError without sending the request when the
+// organization is throttled, and when the response is a 429.
+func (c *rateLimitedHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if d := c.limiter.blocked(c.now()); d > 0 {
+		konnectAPIThrottledRequests.WithLabelValues(c.limiter.serverURL, throttleReasonServerSide).Inc()
+		return nil, RateLimitedError{RetryAfter: d}
+	}
+
+	reservation := c.limiter.limiter.Reserve()
+	if d := reservation.Delay(); d > 0 {
+		if d > rateLimitMaxClientSideWait {
+			reservation.Cancel()
+			konnectAPIThrottledRequests.WithLabelValues(c.limiter.serverURL, throttleReasonClientSide).Inc()
+			return nil, RateLimitedError{RetryAfter: d}
+		}
+		konnectAPIClientSideThrottleWait.WithLabelValues(c.limiter.serverURL).Observe(d.Seconds())
+		t := time.NewTimer(d)
+		select {
+		case <-t.C:
+		case <-req.Context().Done():
+			t.Stop()
+			reservation.Cancel()
+			return nil, req.Context().Err()
+		}
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	now := c.now()
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter, ok := parseRetryAfter(resp.Header, now)
+		if !ok {
+			retryAfter, ok = parseRateLimitReset(resp.Header)
+		}
+		if !ok {
+			retryAfter = rateLimitMaxClientSideWait
+		}
+		c.limiter.blockUntil(now.Add(retryAfter))
+		konnectAPIThrottledRequests.WithLabelValues(c.limiter.serverURL, throttleReasonServerSide).Inc()
+		_ = resp.Body.Close()
+		return nil, RateLimitedError{RetryAfter: retryAfter}
+	}
+
+	// Stop sending requests when the rate limiting window is exhausted
+	// to prevent getting throttled by Konnect.
+	if resp.Header.Get(headerRateLimitRemaining) == "0" {
+		if reset, ok := parseRateLimitReset(resp.Header); ok {
+			c.limiter.blockUntil(now.Add(reset))
+		}
+	}
+
+	return resp, nil
+}
+
+// parseRetryAfter parses the Retry-After header which can contain either
+// the number of seconds or an HTTP date.
+func parseRetryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	v := h.Get(headerRetryAfter)
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// parseRateLimitReset parses the RateLimit-Reset header containing the number
+// of seconds until the rate limiting window resets.
+func parseRateLimitReset(h http.Header) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(h.Get(headerRateLimitReset))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
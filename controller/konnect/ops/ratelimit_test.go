@@ -0,0 +1,164 @@
+package ops
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpClientFunc func(req *http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimitedHTTPClient(t *testing.T) {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	newRequest := func(t *testing.T) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "https://us.api.konghq.com/v2/control-planes", nil)
+		require.NoError(t, err)
+		return req
+	}
+	newClient := func(cfg RateLimiterConfig, respond func() *http.Response, calls *int) *rateLimitedHTTPClient {
+		return &rateLimitedHTTPClient{
+			client: httpClientFunc(func(*http.Request) (*http.Response, error) {
+				*calls++
+				return respond(), nil
+			}),
+			limiter: newRateLimiters(cfg).get("https://us.api.konghq.com", "token", "org"),
+			now:     func() time.Time { return now },
+		}
+	}
+	response := func(code int, headers map[string]string) func() *http.Response {
+		return func() *http.Response {
+			resp := &http.Response{
+				StatusCode: code,
+				Header:     http.Header{},
+				Body:       http.NoBody,
+			}
+			for k, v := range headers {
+				resp.Header.Set(k, v)
+			}
+			return resp
+		}
+	}
+	unlimited := RateLimiterConfig{Limit: 1000, Burst: 1000}
+
+	t.Run("429 with Retry-After blocks subsequent requests", func(t *testing.T) {
+		var calls int
+		c := newClient(unlimited, response(http.StatusTooManyRequests, map[string]string{"Retry-After": "30"}), &calls)
+
+		_, err := c.Do(newRequest(t))
+		var errRateLimited RateLimitedError
+		require.True(t, errors.As(err, &errRateLimited))
+		assert.Equal(t, 30*time.Second, errRateLimited.RetryAfter)
+
+		now = now.Add(10 * time.Second)
+		_, err = c.Do(newRequest(t))
+		require.True(t, errors.As(err, &errRateLimited))
+		assert.Equal(t, 20*time.Second, errRateLimited.RetryAfter)
+		assert.Equal(t, 1, calls, "blocked request should not be sent")
+
+		now = now.Add(20 * time.Second)
+		_, err = c.Do(newRequest(t))
+		require.True(t, errors.As(err, &errRateLimited))
+		assert.Equal(t, 2, calls, "request should be sent after the Retry-After time")
+	})
+
+	t.Run("exhausted RateLimit-Remaining blocks subsequent requests until reset", func(t *testing.T) {
+		var calls int
+		c := newClient(unlimited, response(http.StatusOK, map[string]string{
+			"RateLimit-Remaining": "0",
+			"RateLimit-Reset":     "5",
+		}), &calls)
+
+		resp, err := c.Do(newRequest(t))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		_, err = c.Do(newRequest(t))
+		var errRateLimited RateLimitedError
+		require.True(t, errors.As(err, &errRateLimited))
+		assert.Equal(t, 5*time.Second, errRateLimited.RetryAfter)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("client side rate limit is enforced", func(t *testing.T) {
+		var calls int
+		c := newClient(RateLimiterConfig{Limit: 0.01, Burst: 1}, response(http.StatusOK, nil), &calls)
+
+		_, err := c.Do(newRequest(t))
+		require.NoError(t, err)
+
+		_, err = c.Do(newRequest(t))
+		var errRateLimited RateLimitedError
+		require.True(t, errors.As(err, &errRateLimited))
+		assert.Greater(t, errRateLimited.RetryAfter, rateLimitMaxClientSideWait)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestRateLimitersAreSharedPerOrganization(t *testing.T) {
+	l := newRateLimiters(RateLimiterConfig{Limit: 1, Burst: 1})
+
+	t.Log("tokens of the same organization share the limiter")
+	assert.Same(t, l.get("https://us.api.konghq.com", "token-1", "org-1"), l.get("https://us.api.konghq.com", "token-2", "org-1"))
+	assert.NotSame(t, l.get("https://us.api.konghq.com", "token-1", "org-1"), l.get("https://us.api.konghq.com", "token-1", "org-2"))
+	assert.NotSame(t, l.get("https://us.api.konghq.com", "token-1", "org-1"), l.get("https://eu.api.konghq.com", "token-1", "org-1"))
+
+	t.Log("tokens are used when the organization is not known yet")
+	assert.Same(t, l.get("https://us.api.konghq.com", "token-1", ""), l.get("https://us.api.konghq.com", "token-1", ""))
+	assert.NotSame(t, l.get("https://us.api.konghq.com", "token-1", ""), l.get("https://us.api.konghq.com", "token-2", ""))
+}
+
+func TestRateLimitersEvictIdleLimiters(t *testing.T) {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	l := newRateLimiters(RateLimiterConfig{Limit: 1, Burst: 1})
+	l.now = func() time.Time { return now }
+
+	byToken := l.get("https://us.api.konghq.com", "token", "")
+	blocked := l.get("https://us.api.konghq.com", "token", "org-blocked")
+	blocked.blockUntil(now.Add(2 * rateLimiterIdleTimeout))
+	used := l.get("https://us.api.konghq.com", "token", "org")
+
+	now = now.Add(rateLimiterIdleTimeout / 2)
+	require.Same(t, used, l.get("https://us.api.konghq.com", "token", "org"))
+
+	now = now.Add(rateLimiterIdleTimeout)
+	require.Same(t, used, l.get("https://us.api.konghq.com", "token", "org"))
+	require.Len(t, l.limiters, 2, "only the idle limiter which is not blocked should be evicted")
+	assert.Same(t, blocked, l.get("https://us.api.konghq.com", "token", "org-blocked"))
+	assert.NotSame(t, byToken, l.get("https://us.api.konghq.com", "token", ""))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "seconds", value: "120", expected: 2 * time.Minute, ok: true},
+		{name: "HTTP date", value: now.Add(time.Minute).Format(http.TimeFormat), expected: time.Minute, ok: true},
+		{name: "HTTP date in the past", value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0, ok: true},
+		{name: "missing", value: "", ok: false},
+		{name: "invalid", value: "soon", ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := http.Header{}
+			if tc.value != "" {
+				h.Set(headerRetryAfter, tc.value)
+			}
+			d, ok := parseRetryAfter(h, now)
+			require.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, d)
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package ops
 
 import (
+	"net/http"
+	"time"
+
 	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
 	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+
+	"github.com/kong/gateway-operator/pkg/consts"
 )
 
 // SDKWrapper is a wrapper of Konnect SDK to allow using mock SDKs in tests.
@@ -29,6 +34,8 @@ type SDKWrapper interface {
 	GetKeySetsSDK() KeySetsSDK
 	GetSNIsSDK() SNIsSDK
 	GetDataPlaneCertificatesSDK() DataPlaneClientCertificatesSDK
+	GetDataPlaneNodesSDK() DataPlaneNodesSDK
+	GetSystemAccountAccessTokensSDK() SystemAccountAccessTokensSDK
 }
 
 type sdkWrapper struct {
@@ -147,23 +154,58 @@ func (w sdkWrapper) GetDataPlaneCertificatesSDK() DataPlaneClientCertificatesSDK
 	return w.sdk.DPCertificates
 }
 
+// GetDataPlaneNodesSDK returns the SDK to get data plane nodes.
+func (w sdkWrapper) GetDataPlaneNodesSDK() DataPlaneNodesSDK {
+	return w.sdk.DPNodes
+}
+
+// GetSystemAccountAccessTokensSDK returns the SDK to get system account access tokens.
+func (w sdkWrapper) GetSystemAccountAccessTokensSDK() SystemAccountAccessTokensSDK {
+	return w.sdk.SystemAccountsAccessTokens
+}
+
 // SDKToken is a token used to authenticate with the Konnect SDK.
 type SDKToken string
 
 // SDKFactory is a factory for creating Konnect SDKs.
 type SDKFactory interface {
-	NewKonnectSDK(serverURL string, token SDKToken) SDKWrapper
+	NewKonnectSDK(serverURL string, token SDKToken, orgID string) SDKWrapper
 }
 
-type sdkFactory struct{}
+type sdkFactory struct {
+	rateLimiters *rateLimiters
+}
+
+// SDKFactoryOption is a functional option for the SDKFactory.
+type SDKFactoryOption func(*sdkFactory)
+
+// WithRateLimiterConfig sets the configuration of the client side rate limiter
+// shared by all the SDKs operating on the same Konnect organization.
+func WithRateLimiterConfig(cfg RateLimiterConfig) SDKFactoryOption {
+	return func(f *sdkFactory) {
+		f.rateLimiters = newRateLimiters(cfg)
+	}
+}
 
 // NewSDKFactory creates a new SDKFactory.
-func NewSDKFactory() SDKFactory {
-	return sdkFactory{}
+func NewSDKFactory(opts ...SDKFactoryOption) SDKFactory {
+	f := sdkFactory{
+		rateLimiters: newRateLimiters(RateLimiterConfig{
+			Limit: consts.DefaultKonnectAPIRateLimit,
+			Burst: consts.DefaultKonnectAPIBurst,
+		}),
+	}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
 }
 
 // NewKonnectSDK creates a new Konnect SDK.
-func (f sdkFactory) NewKonnectSDK(serverURL string, token SDKToken) SDKWrapper {
+// Requests sent by the SDK are throttled by the rate limiter of the Konnect
+// organization the token belongs to. The organization ID can be empty when
+// it's not known yet.
+func (f sdkFactory) NewKonnectSDK(serverURL string, token SDKToken, orgID string) SDKWrapper {
 	return sdkWrapper{
 		sdk: sdkkonnectgo.New(
 			sdkkonnectgo.WithSecurity(
@@ -172,6 +214,11 @@ func (f sdkFactory) NewKonnectSDK(serverURL string, token SDKToken) SDKWrapper {
 				},
 			),
 			sdkkonnectgo.WithServerURL(serverURL),
+			sdkkonnectgo.WithClient(&rateLimitedHTTPClient{
+				client:  &http.Client{Timeout: 60 * time.Second},
+				limiter: f.rateLimiters.get(serverURL, token, orgID),
+				now:     time.Now,
+			}),
 		),
 	}
 }
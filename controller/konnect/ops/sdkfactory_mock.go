@@ -7,56 +7,60 @@ import (
 )
 
 type MockSDKWrapper struct {
-	ControlPlaneSDK             *MockControlPlaneSDK
-	ControlPlaneGroupSDK        *MockControlPlaneGroupSDK
-	ServicesSDK                 *MockServicesSDK
-	RoutesSDK                   *MockRoutesSDK
-	ConsumersSDK                *MockConsumersSDK
-	ConsumerGroupSDK            *MockConsumerGroupSDK
-	PluginSDK                   *MockPluginSDK
-	UpstreamsSDK                *MockUpstreamsSDK
-	TargetsSDK                  *MockTargetsSDK
-	MeSDK                       *MockMeSDK
-	KongCredentialsBasicAuthSDK *MockKongCredentialBasicAuthSDK
-	KongCredentialsAPIKeySDK    *MockKongCredentialAPIKeySDK
-	KongCredentialsACLSDK       *MockKongCredentialACLSDK
-	KongCredentialsJWTSDK       *MockKongCredentialJWTSDK
-	KongCredentialsHMACSDK      *MockKongCredentialHMACSDK
-	CACertificatesSDK           *MockCACertificatesSDK
-	CertificatesSDK             *MockCertificatesSDK
-	VaultSDK                    *MockVaultSDK
-	KeysSDK                     *MockKeysSDK
-	KeySetsSDK                  *MockKeySetsSDK
-	SNIsSDK                     *MockSNIsSDK
-	DataPlaneCertificatesSDK    *MockDataPlaneClientCertificatesSDK
+	ControlPlaneSDK              *MockControlPlaneSDK
+	ControlPlaneGroupSDK         *MockControlPlaneGroupSDK
+	ServicesSDK                  *MockServicesSDK
+	RoutesSDK                    *MockRoutesSDK
+	ConsumersSDK                 *MockConsumersSDK
+	ConsumerGroupSDK             *MockConsumerGroupSDK
+	PluginSDK                    *MockPluginSDK
+	UpstreamsSDK                 *MockUpstreamsSDK
+	TargetsSDK                   *MockTargetsSDK
+	MeSDK                        *MockMeSDK
+	KongCredentialsBasicAuthSDK  *MockKongCredentialBasicAuthSDK
+	KongCredentialsAPIKeySDK     *MockKongCredentialAPIKeySDK
+	KongCredentialsACLSDK        *MockKongCredentialACLSDK
+	KongCredentialsJWTSDK        *MockKongCredentialJWTSDK
+	KongCredentialsHMACSDK       *MockKongCredentialHMACSDK
+	CACertificatesSDK            *MockCACertificatesSDK
+	CertificatesSDK              *MockCertificatesSDK
+	VaultSDK                     *MockVaultSDK
+	KeysSDK                      *MockKeysSDK
+	KeySetsSDK                   *MockKeySetsSDK
+	SNIsSDK                      *MockSNIsSDK
+	DataPlaneCertificatesSDK     *MockDataPlaneClientCertificatesSDK
+	DataPlaneNodesSDK            *MockDataPlaneNodesSDK
+	SystemAccountAccessTokensSDK *MockSystemAccountAccessTokensSDK
 }
 
 var _ SDKWrapper = MockSDKWrapper{}
 
 func NewMockSDKWrapperWithT(t *testing.T) *MockSDKWrapper {
 	return &MockSDKWrapper{
-		ControlPlaneSDK:             NewMockControlPlaneSDK(t),
-		ControlPlaneGroupSDK:        NewMockControlPlaneGroupSDK(t),
-		ServicesSDK:                 NewMockServicesSDK(t),
-		RoutesSDK:                   NewMockRoutesSDK(t),
-		ConsumersSDK:                NewMockConsumersSDK(t),
-		ConsumerGroupSDK:            NewMockConsumerGroupSDK(t),
-		PluginSDK:                   NewMockPluginSDK(t),
-		UpstreamsSDK:                NewMockUpstreamsSDK(t),
-		TargetsSDK:                  NewMockTargetsSDK(t),
-		MeSDK:                       NewMockMeSDK(t),
-		KongCredentialsBasicAuthSDK: NewMockKongCredentialBasicAuthSDK(t),
-		KongCredentialsAPIKeySDK:    NewMockKongCredentialAPIKeySDK(t),
-		KongCredentialsACLSDK:       NewMockKongCredentialACLSDK(t),
-		KongCredentialsJWTSDK:       NewMockKongCredentialJWTSDK(t),
-		KongCredentialsHMACSDK:      NewMockKongCredentialHMACSDK(t),
-		CACertificatesSDK:           NewMockCACertificatesSDK(t),
-		CertificatesSDK:             NewMockCertificatesSDK(t),
-		VaultSDK:                    NewMockVaultSDK(t),
-		KeysSDK:                     NewMockKeysSDK(t),
-		KeySetsSDK:                  NewMockKeySetsSDK(t),
-		SNIsSDK:                     NewMockSNIsSDK(t),
-		DataPlaneCertificatesSDK:    NewMockDataPlaneClientCertificatesSDK(t),
+		ControlPlaneSDK:              NewMockControlPlaneSDK(t),
+		ControlPlaneGroupSDK:         NewMockControlPlaneGroupSDK(t),
+		ServicesSDK:                  NewMockServicesSDK(t),
+		RoutesSDK:                    NewMockRoutesSDK(t),
+		ConsumersSDK:                 NewMockConsumersSDK(t),
+		ConsumerGroupSDK:             NewMockConsumerGroupSDK(t),
+		PluginSDK:                    NewMockPluginSDK(t),
+		UpstreamsSDK:                 NewMockUpstreamsSDK(t),
+		TargetsSDK:                   NewMockTargetsSDK(t),
+		MeSDK:                        NewMockMeSDK(t),
+		KongCredentialsBasicAuthSDK:  NewMockKongCredentialBasicAuthSDK(t),
+		KongCredentialsAPIKeySDK:     NewMockKongCredentialAPIKeySDK(t),
+		KongCredentialsACLSDK:        NewMockKongCredentialACLSDK(t),
+		KongCredentialsJWTSDK:        NewMockKongCredentialJWTSDK(t),
+		KongCredentialsHMACSDK:       NewMockKongCredentialHMACSDK(t),
+		CACertificatesSDK:            NewMockCACertificatesSDK(t),
+		CertificatesSDK:              NewMockCertificatesSDK(t),
+		VaultSDK:                     NewMockVaultSDK(t),
+		KeysSDK:                      NewMockKeysSDK(t),
+		KeySetsSDK:                   NewMockKeySetsSDK(t),
+		SNIsSDK:                      NewMockSNIsSDK(t),
+		DataPlaneCertificatesSDK:     NewMockDataPlaneClientCertificatesSDK(t),
+		DataPlaneNodesSDK:            NewMockDataPlaneNodesSDK(t),
+		SystemAccountAccessTokensSDK: NewMockSystemAccountAccessTokensSDK(t),
 	}
 }
 
@@ -148,6 +152,14 @@ func (m MockSDKWrapper) GetDataPlaneCertificatesSDK() DataPlaneClientCertificate
 	return m.DataPlaneCertificatesSDK
 }
 
+func (m MockSDKWrapper) GetDataPlaneNodesSDK() DataPlaneNodesSDK {
+	return m.DataPlaneNodesSDK
+}
+
+func (m MockSDKWrapper) GetSystemAccountAccessTokensSDK() SystemAccountAccessTokensSDK {
+	return m.SystemAccountAccessTokensSDK
+}
+
 type MockSDKFactory struct {
 	t   *testing.T
 	SDK *MockSDKWrapper
@@ -162,7 +174,7 @@ func NewMockSDKFactory(t *testing.T) *MockSDKFactory {
 	}
 }
 
-func (m MockSDKFactory) NewKonnectSDK(_ string, _ SDKToken) SDKWrapper {
+func (m MockSDKFactory) NewKonnectSDK(_ string, _ SDKToken, _ string) SDKWrapper {
 	require.NotNil(m.t, m.SDK)
 	return *m.SDK
 }
@@ -0,0 +1,12 @@
+package ops
+
+import (
+	"context"
+
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+)
+
+// SystemAccountAccessTokensSDK is the interface for the Konnect system account access tokens SDK.
+type SystemAccountAccessTokensSDK interface {
+	GetSystemAccountsIDAccessTokensID(ctx context.Context, accountID string, tokenID string, opts ...sdkkonnectops.Option) (*sdkkonnectops.GetSystemAccountsIDAccessTokensIDResponse, error)
+}
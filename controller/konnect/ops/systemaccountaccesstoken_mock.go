@@ -0,0 +1,112 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package ops
+
+import (
+	context "context"
+
+	operations "github.com/Kong/sdk-konnect-go/models/operations"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSystemAccountAccessTokensSDK is an autogenerated mock type for the SystemAccountAccessTokensSDK type
+type MockSystemAccountAccessTokensSDK struct {
+	mock.Mock
+}
+
+type MockSystemAccountAccessTokensSDK_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSystemAccountAccessTokensSDK) EXPECT() *MockSystemAccountAccessTokensSDK_Expecter {
+	return &MockSystemAccountAccessTokensSDK_Expecter{mock: &_m.Mock}
+}
+
+// GetSystemAccountsIDAccessTokensID provides a mock function with given fields: ctx, accountID, tokenID, opts
+func (_m *MockSystemAccountAccessTokensSDK) GetSystemAccountsIDAccessTokensID(ctx context.Context, accountID string, tokenID string, opts ...operations.Option) (*operations.GetSystemAccountsIDAccessTokensIDResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, accountID, tokenID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemAccountsIDAccessTokensID")
+	}
+
+	var r0 *operations.GetSystemAccountsIDAccessTokensIDResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...operations.Option) (*operations.GetSystemAccountsIDAccessTokensIDResponse, error)); ok {
+		return rf(ctx, accountID, tokenID, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...operations.Option) *operations.GetSystemAccountsIDAccessTokensIDResponse); ok {
+		r0 = rf(ctx, accountID, tokenID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operations.GetSystemAccountsIDAccessTokensIDResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...operations.Option) error); ok {
+		r1 = rf(ctx, accountID, tokenID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSystemAccountsIDAccessTokensID'
+type MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call struct {
+	*mock.Call
+}
+
+// GetSystemAccountsIDAccessTokensID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accountID string
+//   - tokenID string
+//   - opts ...operations.Option
+func (_e *MockSystemAccountAccessTokensSDK_Expecter) GetSystemAccountsIDAccessTokensID(ctx interface{}, accountID interface{}, tokenID interface{}, opts ...interface{}) *MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call {
+	return &MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call{Call: _e.mock.On("GetSystemAccountsIDAccessTokensID",
+		append([]interface{}{ctx, accountID, tokenID}, opts...)...)}
+}
+
+func (_c *MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call) Run(run func(ctx context.Context, accountID string, tokenID string, opts ...operations.Option)) *MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]operations.Option, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(operations.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call) Return(_a0 *operations.GetSystemAccountsIDAccessTokensIDResponse, _a1 error) *MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call) RunAndReturn(run func(context.Context, string, string, ...operations.Option) (*operations.GetSystemAccountsIDAccessTokensIDResponse, error)) *MockSystemAccountAccessTokensSDK_GetSystemAccountsIDAccessTokensID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSystemAccountAccessTokensSDK creates a new instance of MockSystemAccountAccessTokensSDK. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSystemAccountAccessTokensSDK(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSystemAccountAccessTokensSDK {
+	mock := &MockSystemAccountAccessTokensSDK{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,35 @@
+package konnect
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+)
+
+// minRateLimitedRequeueAfter is the minimum requeue delay of objects which
+// reconciliation was throttled by the Konnect API rate limiting.
+const minRateLimitedRequeueAfter = time.Second
+
+// requeueIfRateLimited translates errors caused by throttled Konnect API
+// requests into a requeue at the time indicated by Konnect (or by the client
+// side rate limiter), instead of returning an error which would cause an
+// exponential backoff. Other results are returned unchanged.
+func requeueIfRateLimited(ctx context.Context, res ctrl.Result, err error) (ctrl.Result, error) {
+	var errRateLimited ops.RateLimitedError
+	if !errors.As(err, &errRateLimited) {
+		return res, err
+	}
+
+	requeueAfter := max(errRateLimited.RetryAfter, minRateLimitedRequeueAfter)
+	ctrllog.FromContext(ctx).Info("Konnect API rate limit exceeded, requeueing",
+		"requeue_after", requeueAfter.String(),
+	)
+	return ctrl.Result{
+		RequeueAfter: requeueAfter,
+	}, nil
+}
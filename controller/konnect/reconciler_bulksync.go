@@ -0,0 +1,669 @@
+package konnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kong/gateway-operator/controller/konnect/constraints"
+	konnectconsts "github.com/kong/gateway-operator/controller/konnect/consts"
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+// KonnectBulkSyncReconciler synchronizes entities referencing KonnectGatewayControlPlanes
+// which have the bulk sync mode enabled (see IsBulkSyncEnabled).
+// Instead of reconciling each entity separately, it builds a snapshot of all
+// the supported entities referencing the ControlPlane, computes a diff against
+// the state in Konnect and applies only the changes, in batches.
+// Entities of objects being deleted are deleted (or orphaned) in batches as well.
+//
+// All entity types defined within a ControlPlane are supported (see ops.IsBulkSyncSupported)
+// apart from credentials and DataPlane client certificates which are still
+// handled by the per entity KonnectEntityReconcilers.
+// References of the objects (e.g. to their ControlPlanes) are resolved by
+// the KonnectEntityReconcilers in both modes.
+type KonnectBulkSyncReconciler struct {
+	sdkFactory      ops.SDKFactory
+	developmentMode bool
+	client          client.Client
+	syncPeriod      time.Duration
+	batchSize       int
+}
+
+// NewKonnectBulkSyncReconciler creates a new KonnectBulkSyncReconciler.
+func NewKonnectBulkSyncReconciler(
+	sdkFactory ops.SDKFactory,
+	developmentMode bool,
+	client client.Client,
+	syncPeriod time.Duration,
+) *KonnectBulkSyncReconciler {
+	return &KonnectBulkSyncReconciler{
+		sdkFactory:      sdkFactory,
+		developmentMode: developmentMode,
+		client:          client,
+		syncPeriod:      syncPeriod,
+		batchSize:       ops.DefaultBulkSyncBatchSize,
+	}
+}
+
+// IsBulkSyncEnabled returns true when the provided KonnectGatewayControlPlane
+// has the bulk sync mode enabled through the konnect.konghq.com/sync-mode annotation.
+func IsBulkSyncEnabled(cp *konnectv1alpha1.KonnectGatewayControlPlane) bool {
+	return cp.GetAnnotations()[konnectconsts.AnnotationSyncMode] == konnectconsts.SyncModeBulk
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KonnectBulkSyncReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("KonnectBulkSync").
+		For(&konnectv1alpha1.KonnectGatewayControlPlane{},
+			builder.WithPredicates(
+				predicate.NewPredicateFuncs(func(obj client.Object) bool {
+					cp, ok := obj.(*konnectv1alpha1.KonnectGatewayControlPlane)
+					return ok && IsBulkSyncEnabled(cp)
+				}),
+			),
+		).
+		Watches(
+			&configurationv1alpha1.KongService{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongService](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongService]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongRoute{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongRoute](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongRoute]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongUpstream{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongUpstream](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongUpstream]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongTarget{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongTarget](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongTarget]()),
+		).
+		Watches(
+			&configurationv1.KongConsumer{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1.KongConsumer](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1.KongConsumer]()),
+		).
+		Watches(
+			&configurationv1beta1.KongConsumerGroup{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1beta1.KongConsumerGroup](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1beta1.KongConsumerGroup]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongPluginBinding{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongPluginBinding](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongPluginBinding]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongCertificate{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongCertificate](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongCertificate]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongCACertificate{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongCACertificate](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongCACertificate]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongSNI{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongSNI](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongSNI]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongVault{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongVault](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongVault]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongKey{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongKey](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongKey]()),
+		).
+		Watches(
+			&configurationv1alpha1.KongKeySet{},
+			handler.EnqueueRequestsFromMapFunc(enqueueBulkSyncControlPlaneForEntity[configurationv1alpha1.KongKeySet](r.client)),
+			builder.WithPredicates(bulkSyncEntityChanged[configurationv1alpha1.KongKeySet]()),
+		).
+		Complete(r)
+}
+
+// Reconcile synchronizes the entities referencing the KonnectGatewayControlPlane with Konnect.
+func (r *KonnectBulkSyncReconciler) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var cp konnectv1alpha1.KonnectGatewayControlPlane
+	if err := r.client.Get(ctx, req.NamespacedName, &cp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	logger := log.GetLogger(ctx, "KonnectBulkSync", r.developmentMode).
+		WithValues("konnect_id", cp.GetKonnectStatus().GetKonnectID())
+	ctx = ctrllog.IntoContext(ctx, logger)
+	log.Debug(logger, "reconciling", cp)
+
+	if !IsBulkSyncEnabled(&cp) || !cp.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Resuming the ControlPlane's reconciliation will trigger another reconciliation.
+	if k8sutils.IsReconciliationPaused(&cp) {
+		log.Debug(logger, "ControlPlane reconciliation is paused, skipping bulk sync", cp)
+		return ctrl.Result{}, nil
+	}
+
+	// ControlPlane's update will trigger another reconciliation once it's programmed.
+	if cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, &cp); !ok ||
+		cond.Status != metav1.ConditionTrue ||
+		cp.GetKonnectStatus().GetKonnectID() == "" {
+		log.Debug(logger, "ControlPlane is not programmed yet, skipping bulk sync", cp)
+		return ctrl.Result{}, nil
+	}
+
+	sdk, apiAuth, err := newKonnectSDKForControlPlane(ctx, r.client, r.sdkFactory, &cp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	s := bulkSync{
+		client:    r.client,
+		sdk:       sdk,
+		cp:        &cp,
+		serverURL: ops.NewServerURL(apiAuth.Spec.ServerURL),
+		orgID:     apiAuth.Status.OrganizationID,
+		batchSize: r.batchSize,
+	}
+	// NOTE: The order matters: entities are deleted before the entities they
+	// refer to (e.g. KongRoutes before KongServices as Konnect does not allow
+	// deleting Services with Routes) and synced after them (e.g. KongPluginBindings
+	// can only be synced once their targets are created in Konnect).
+	err = errors.Join(
+		bulkDeleteEntities[configurationv1alpha1.KongPluginBinding](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongRoute](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongService](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongTarget](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongUpstream](ctx, s),
+		bulkDeleteEntities[configurationv1.KongConsumer](ctx, s),
+		bulkDeleteEntities[configurationv1beta1.KongConsumerGroup](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongSNI](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongCertificate](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongCACertificate](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongKey](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongKeySet](ctx, s),
+		bulkDeleteEntities[configurationv1alpha1.KongVault](ctx, s),
+
+		bulkSyncEntities[configurationv1alpha1.KongService](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongRoute](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongUpstream](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongTarget](ctx, s),
+		bulkSyncEntities[configurationv1beta1.KongConsumerGroup](ctx, s),
+		bulkSyncEntities[configurationv1.KongConsumer](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongCertificate](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongSNI](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongCACertificate](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongKeySet](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongKey](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongVault](ctx, s),
+		bulkSyncEntities[configurationv1alpha1.KongPluginBinding](ctx, s),
+	)
+	if err != nil {
+		return requeueIfRateLimited(ctx, ctrl.Result{}, err)
+	}
+
+	// NOTE: We requeue here to keep enforcing the state of the resources in Konnect.
+	// Konnect does not allow subscribing to changes so we need to keep pushing the
+	// desired state periodically.
+	return ctrl.Result{
+		RequeueAfter: r.syncPeriod,
+	}, nil
+}
+
+// bulkSync holds the state shared by the bulk sync of all entity types of a ControlPlane.
+type bulkSync struct {
+	client    client.Client
+	sdk       ops.SDKWrapper
+	cp        *konnectv1alpha1.KonnectGatewayControlPlane
+	serverURL ops.ServerURL
+	orgID     string
+	batchSize int
+}
+
+// bulkSyncEntities synchronizes all the objects of the given type which belong
+// to the ControlPlane and stores the results in the objects' statuses.
+func bulkSyncEntities[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](ctx context.Context, s bulkSync) error {
+	ents, err := listEntitiesForBulkSync[T, TEnt](ctx, s.client, s.cp)
+	if err != nil {
+		return err
+	}
+	if len(ents) == 0 {
+		return nil
+	}
+
+	olds := make(map[types.UID]TEnt, len(ents))
+	for _, e := range ents {
+		ent := TEnt(e)
+		olds[ent.GetUID()] = ent.DeepCopyObject().(TEnt)
+	}
+
+	changes, err := ops.PlanBulkSync[T, TEnt](ctx, s.sdk, s.client, s.cp.GetKonnectStatus().GetKonnectID(), ents)
+	if err != nil {
+		return err
+	}
+	log.Debug(ctrllog.FromContext(ctx), "applying bulk sync changes", s.cp,
+		"type", constraints.EntityTypeName[T](),
+		"entities", len(changes),
+		"changes", lo.CountBy(changes, func(c ops.BulkSyncChange[T]) bool { return c.Op != "" }),
+	)
+	errApply := ops.ApplyBulkSync[T, TEnt](ctx, s.sdk, s.client, changes, s.batchSize)
+
+	// Report the results back, regardless of the errors, as the statuses
+	// can contain Konnect IDs of the created entities.
+	errs := []error{errApply}
+	for _, e := range ents {
+		ent := TEnt(e)
+		old := olds[ent.GetUID()]
+		if ent.GetKonnectStatus().GetKonnectID() != "" {
+			errs = append(errs, ensureKonnectCleanupFinalizer(ctx, s.client, old))
+		}
+		setServerURLAndOrgID(ent, s.serverURL, s.orgID)
+		if err := s.client.Status().Patch(ctx, ent, client.MergeFrom(old)); err != nil && !k8serrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to update status of %s %s: %w",
+				constraints.EntityTypeName[T](), client.ObjectKeyFromObject(ent), err,
+			))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// bulkDeleteEntities deletes the entities of the objects of the given type which
+// belong to the ControlPlane and are being deleted, and removes the objects'
+// KonnectCleanupFinalizer. Entities of objects with the orphan deletion policy
+// are left in Konnect (see ops.Orphan).
+func bulkDeleteEntities[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](ctx context.Context, s bulkSync) error {
+	ents, err := listDeletedEntitiesForBulkSync[T, TEnt](ctx, s.client, s.cp)
+	if err != nil {
+		return err
+	}
+	if len(ents) == 0 {
+		return nil
+	}
+
+	olds := make([]TEnt, 0, len(ents))
+	changes := make([]ops.BulkSyncChange[T], 0, len(ents))
+	for _, e := range ents {
+		ent := TEnt(e)
+		olds = append(olds, ent.DeepCopyObject().(TEnt))
+		op := ops.DeleteOp
+		if ops.IsOrphaned(ent) {
+			op = ops.OrphanOp
+		}
+		changes = append(changes, ops.BulkSyncChange[T]{Entity: e, Op: op})
+	}
+
+	log.Debug(ctrllog.FromContext(ctx), "applying bulk sync deletions", s.cp,
+		"type", constraints.EntityTypeName[T](),
+		"entities", len(changes),
+	)
+	errs := []error{ops.ApplyBulkSync[T, TEnt](ctx, s.sdk, s.client, changes, s.batchSize)}
+	for i, c := range changes {
+		ent, old := TEnt(c.Entity), olds[i]
+		if c.Err != nil {
+			if err := s.client.Status().Patch(ctx, ent, client.MergeFrom(old)); err != nil && !k8serrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to update status of %s %s: %w",
+					constraints.EntityTypeName[T](), client.ObjectKeyFromObject(ent), err,
+				))
+			}
+			continue
+		}
+		errs = append(errs, removeKonnectCleanupFinalizer(ctx, s.client, old))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ensureKonnectCleanupFinalizer adds the KonnectCleanupFinalizer to the object
+// so that its entity gets removed from Konnect when the object is deleted.
+func ensureKonnectCleanupFinalizer(ctx context.Context, cl client.Client, obj client.Object) error {
+	objWithFinalizer := obj.DeepCopyObject().(client.Object)
+	if !controllerutil.AddFinalizer(objWithFinalizer, KonnectCleanupFinalizer) {
+		return nil
+	}
+	if err := cl.Patch(ctx, objWithFinalizer, client.MergeFrom(obj)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to add finalizer %s to %s: %w",
+			KonnectCleanupFinalizer, client.ObjectKeyFromObject(obj), err,
+		)
+	}
+	return nil
+}
+
+// removeKonnectCleanupFinalizer removes the KonnectCleanupFinalizer from the object
+// once its entity is removed from Konnect.
+func removeKonnectCleanupFinalizer(ctx context.Context, cl client.Client, obj client.Object) error {
+	objWithoutFinalizer := obj.DeepCopyObject().(client.Object)
+	if !controllerutil.RemoveFinalizer(objWithoutFinalizer, KonnectCleanupFinalizer) {
+		return nil
+	}
+	if err := cl.Patch(ctx, objWithoutFinalizer, client.MergeFrom(obj)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to remove finalizer %s from %s: %w",
+			KonnectCleanupFinalizer, client.ObjectKeyFromObject(obj), err,
+		)
+	}
+	return nil
+}
+
+// listEntitiesForBulkSync returns the objects of the given type which belong to
+// the ControlPlane and are ready to be synced, i.e. have their references resolved
+// by their KonnectEntityReconciler and are not being deleted.
+func listEntitiesForBulkSync[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](
+	ctx context.Context,
+	cl client.Client,
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+) ([]*T, error) {
+	ents, err := listControlPlaneEntitiesForBulkSync[T, TEnt](ctx, cl, cp)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Filter(ents, func(e *T, _ int) bool {
+		ent := TEnt(e)
+		return ent.GetDeletionTimestamp().IsZero() && isReadyForBulkSync(ent)
+	}), nil
+}
+
+// listDeletedEntitiesForBulkSync returns the objects of the given type which
+// belong to the ControlPlane and are being deleted, once their termination
+// grace period is over, which still have their entities in Konnect,
+// i.e. have the KonnectCleanupFinalizer.
+func listDeletedEntitiesForBulkSync[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](
+	ctx context.Context,
+	cl client.Client,
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+) ([]*T, error) {
+	ents, err := listControlPlaneEntitiesForBulkSync[T, TEnt](ctx, cl, cp)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return lo.Filter(ents, func(e *T, _ int) bool {
+		ent := TEnt(e)
+		delTimestamp := ent.GetDeletionTimestamp()
+		return !delTimestamp.IsZero() &&
+			!delTimestamp.After(now) &&
+			controllerutil.ContainsFinalizer(ent, KonnectCleanupFinalizer)
+	}), nil
+}
+
+// listControlPlaneEntitiesForBulkSync returns the objects of the given type
+// which belong to the ControlPlane and don't have their reconciliation paused.
+func listControlPlaneEntitiesForBulkSync[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](
+	ctx context.Context,
+	cl client.Client,
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+) ([]*T, error) {
+	var (
+		e    T
+		ents []*T
+		err  error
+		// TODO: change this when cross namespace refs are allowed.
+		inNamespace = client.InNamespace(cp.GetNamespace())
+	)
+	switch any(&e).(type) {
+	case *configurationv1alpha1.KongService:
+		var l configurationv1alpha1.KongServiceList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongRoute:
+		var l configurationv1alpha1.KongRouteList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongUpstream:
+		var l configurationv1alpha1.KongUpstreamList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongTarget:
+		var l configurationv1alpha1.KongTargetList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1.KongConsumer:
+		var l configurationv1.KongConsumerList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1beta1.KongConsumerGroup:
+		var l configurationv1beta1.KongConsumerGroupList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongPluginBinding:
+		var l configurationv1alpha1.KongPluginBindingList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongCertificate:
+		var l configurationv1alpha1.KongCertificateList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongCACertificate:
+		var l configurationv1alpha1.KongCACertificateList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongSNI:
+		var l configurationv1alpha1.KongSNIList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongKey:
+		var l configurationv1alpha1.KongKeyList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongKeySet:
+		var l configurationv1alpha1.KongKeySetList
+		err = cl.List(ctx, &l, inNamespace)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	case *configurationv1alpha1.KongVault:
+		// KongVaults are cluster scoped.
+		var l configurationv1alpha1.KongVaultList
+		err = cl.List(ctx, &l)
+		ents = any(lo.ToSlicePtr(l.Items)).([]*T)
+	default:
+		return nil, fmt.Errorf("unsupported entity type %T for bulk sync", &e)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %ss: %w", constraints.EntityTypeName[T](), err)
+	}
+
+	cpID := cp.GetKonnectStatus().GetKonnectID()
+	return lo.Filter(ents, func(e *T, _ int) bool {
+		ent := TEnt(e)
+		entWithCPRef, ok := any(ent).(EntityWithControlPlaneRef)
+		return ok &&
+			!k8sutils.IsReconciliationPaused(ent) &&
+			entWithCPRef.GetControlPlaneID() == cpID
+	}), nil
+}
+
+// isReadyForBulkSync returns true when the Konnect IDs of the entities the object
+// is nested under or refers to are known.
+func isReadyForBulkSync(ent client.Object) bool {
+	parentID := bulkSyncParentID(ent)
+	switch ent := ent.(type) {
+	case *configurationv1alpha1.KongRoute:
+		return ent.Spec.ServiceRef == nil || parentID != ""
+	case *configurationv1alpha1.KongKey:
+		return ent.Spec.KeySetRef == nil || parentID != ""
+	case *configurationv1alpha1.KongTarget,
+		*configurationv1alpha1.KongSNI:
+		return parentID != ""
+	default:
+		return true
+	}
+}
+
+// bulkSyncParentID returns the Konnect ID of the entity the object is nested
+// under or refers to, as resolved by the object's KonnectEntityReconciler.
+// KongPluginBindings' targets are resolved when their desired state is built.
+func bulkSyncParentID(ent client.Object) string {
+	switch ent := ent.(type) {
+	case *configurationv1alpha1.KongRoute:
+		return kongRouteServiceID(ent)
+	case *configurationv1alpha1.KongTarget:
+		if ent.Status.Konnect == nil {
+			return ""
+		}
+		return ent.Status.Konnect.UpstreamID
+	case *configurationv1alpha1.KongSNI:
+		if ent.Status.Konnect == nil {
+			return ""
+		}
+		return ent.Status.Konnect.CertificateID
+	case *configurationv1alpha1.KongKey:
+		if ent.Status.Konnect == nil {
+			return ""
+		}
+		return ent.Status.Konnect.KeySetID
+	default:
+		return ""
+	}
+}
+
+// isBulkSyncEnabledForControlPlaneID returns true when the KonnectGatewayControlPlane
+// with the provided Konnect ID, in the provided namespace, has the bulk sync mode enabled.
+func isBulkSyncEnabledForControlPlaneID(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	cpID string,
+) (bool, error) {
+	cp, err := getControlPlaneForKonnectID(ctx, cl, namespace, cpID)
+	if err != nil || cp == nil {
+		return false, err
+	}
+	return IsBulkSyncEnabled(cp), nil
+}
+
+func getControlPlaneForKonnectID(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	cpID string,
+) (*konnectv1alpha1.KonnectGatewayControlPlane, error) {
+	if cpID == "" {
+		return nil, nil
+	}
+
+	var l konnectv1alpha1.KonnectGatewayControlPlaneList
+	// TODO: change this when cross namespace refs are allowed.
+	if err := cl.List(ctx, &l, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list KonnectGatewayControlPlanes: %w", err)
+	}
+	cp, ok := lo.Find(l.Items, func(cp konnectv1alpha1.KonnectGatewayControlPlane) bool {
+		return cp.GetKonnectStatus().GetKonnectID() == cpID
+	})
+	if !ok {
+		return nil, nil
+	}
+	return &cp, nil
+}
+
+// bulkSyncEntityChanged returns a predicate which filters out updates of
+// objects that don't affect the bulk sync, most notably status updates
+// performed by the bulk sync itself.
+func bulkSyncEntityChanged[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+]() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldEnt, okOld := e.ObjectOld.(TEnt)
+			newEnt, okNew := e.ObjectNew.(TEnt)
+			if !okOld || !okNew {
+				return false
+			}
+			if oldEnt.GetGeneration() != newEnt.GetGeneration() ||
+				!oldEnt.GetDeletionTimestamp().Equal(newEnt.GetDeletionTimestamp()) ||
+				k8sutils.IsReconciliationPaused(oldEnt) != k8sutils.IsReconciliationPaused(newEnt) {
+				return true
+			}
+
+			// Objects get synced once their references are resolved by their
+			// KonnectEntityReconcilers.
+			oldWithCPRef, okOld := any(oldEnt).(EntityWithControlPlaneRef)
+			newWithCPRef, okNew := any(newEnt).(EntityWithControlPlaneRef)
+			if okOld && okNew && oldWithCPRef.GetControlPlaneID() != newWithCPRef.GetControlPlaneID() {
+				return true
+			}
+			return bulkSyncParentID(oldEnt) != bulkSyncParentID(newEnt)
+		},
+	}
+}
+
+func kongRouteServiceID(route *configurationv1alpha1.KongRoute) string {
+	if route.Status.Konnect == nil {
+		return ""
+	}
+	return route.Status.Konnect.ServiceID
+}
+
+func enqueueBulkSyncControlPlaneForEntity[
+	T constraints.SupportedKonnectEntityType,
+	TEnt constraints.EntityType[T],
+](
+	cl client.Client,
+) func(ctx context.Context, obj client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		ent, ok := obj.(TEnt)
+		if !ok {
+			return nil
+		}
+		entWithCPRef, ok := any(ent).(EntityWithControlPlaneRef)
+		if !ok {
+			return nil
+		}
+		cp, err := getControlPlaneForKonnectID(ctx, cl, ent.GetNamespace(), entWithCPRef.GetControlPlaneID())
+		if err != nil || cp == nil || !IsBulkSyncEnabled(cp) {
+			return nil
+		}
+		return []reconcile.Request{
+			{
+				NamespacedName: client.ObjectKeyFromObject(cp),
+			},
+		}
+	}
+}
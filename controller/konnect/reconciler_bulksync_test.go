@@ -0,0 +1,241 @@
+package konnect
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	konnectconsts "github.com/kong/gateway-operator/controller/konnect/consts"
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func TestIsReadyForBulkSync(t *testing.T) {
+	testCases := []struct {
+		name     string
+		obj      client.Object
+		expected bool
+	}{
+		{
+			name: "KongTarget with Upstream ID",
+			obj: &configurationv1alpha1.KongTarget{
+				Status: configurationv1alpha1.KongTargetStatus{
+					Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneAndUpstreamRefs{
+						UpstreamID: "upstream-id",
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name:     "KongTarget without Upstream ID",
+			obj:      &configurationv1alpha1.KongTarget{},
+			expected: false,
+		},
+		{
+			name:     "KongSNI without Certificate ID",
+			obj:      &configurationv1alpha1.KongSNI{},
+			expected: false,
+		},
+		{
+			name:     "KongKey without KeySet ref",
+			obj:      &configurationv1alpha1.KongKey{},
+			expected: true,
+		},
+		{
+			name: "KongKey with KeySet ref without KeySet ID",
+			obj: &configurationv1alpha1.KongKey{
+				Spec: configurationv1alpha1.KongKeySpec{
+					KeySetRef: &configurationv1alpha1.KeySetRef{
+						Type: configurationv1alpha1.KeySetRefNamespacedRef,
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name:     "KongConsumer",
+			obj:      &configurationv1.KongConsumer{},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isReadyForBulkSync(tc.obj))
+		})
+	}
+}
+
+func TestBulkDeleteEntities(t *testing.T) {
+	ctx := context.Background()
+	cp := &konnectv1alpha1.KonnectGatewayControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp",
+			Namespace: "default",
+		},
+		Status: konnectv1alpha1.KonnectGatewayControlPlaneStatus{
+			KonnectEntityStatus: konnectv1alpha1.KonnectEntityStatus{
+				ID: "cp-id",
+			},
+		},
+	}
+	deletedService := func(name string) *configurationv1alpha1.KongService {
+		return &configurationv1alpha1.KongService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				DeletionTimestamp: &metav1.Time{Time: time.Now()},
+				Finalizers:        []string{KonnectCleanupFinalizer},
+			},
+			Status: configurationv1alpha1.KongServiceStatus{
+				Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneRef{
+					ControlPlaneID: "cp-id",
+					KonnectEntityStatus: konnectv1alpha1.KonnectEntityStatus{
+						ID: name + "-id",
+					},
+				},
+			},
+		}
+	}
+	deleted := deletedService("svc-deleted")
+	failing := deletedService("svc-failing")
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, configurationv1alpha1.AddToScheme(scheme))
+	require.NoError(t, konnectv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(cp, deleted, failing).
+		WithStatusSubresource(deleted, failing).
+		Build()
+
+	sdk := ops.NewMockSDKWrapperWithT(t)
+	sdk.ServicesSDK.EXPECT().DeleteService(mock.Anything, "cp-id", "svc-deleted-id").
+		Return(&sdkkonnectops.DeleteServiceResponse{}, nil)
+	sdk.ServicesSDK.EXPECT().DeleteService(mock.Anything, "cp-id", "svc-failing-id").
+		Return(nil, errors.New("boom"))
+
+	err := bulkDeleteEntities[configurationv1alpha1.KongService](ctx, bulkSync{
+		client:    fakeClient,
+		sdk:       sdk,
+		cp:        cp,
+		batchSize: ops.DefaultBulkSyncBatchSize,
+	})
+	require.ErrorContains(t, err, "boom")
+
+	// The finalizer of the deleted entity's object is removed so the object is gone.
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(deleted), &configurationv1alpha1.KongService{})
+	require.True(t, k8serrors.IsNotFound(err))
+
+	// The object of the entity which failed to be deleted keeps its finalizer
+	// and gets the error reported in its status.
+	var svc configurationv1alpha1.KongService
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(failing), &svc))
+	assert.Contains(t, svc.Finalizers, KonnectCleanupFinalizer)
+	cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, &svc)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, cond.Message, "boom")
+}
+
+func TestListEntitiesForBulkSync(t *testing.T) {
+	cp := &konnectv1alpha1.KonnectGatewayControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp",
+			Namespace: "default",
+			Annotations: map[string]string{
+				konnectconsts.AnnotationSyncMode: konnectconsts.SyncModeBulk,
+			},
+		},
+		Status: konnectv1alpha1.KonnectGatewayControlPlaneStatus{
+			KonnectEntityStatus: konnectv1alpha1.KonnectEntityStatus{
+				ID: "cp-id",
+			},
+		},
+	}
+	route := func(name, cpID, serviceID string, withServiceRef bool) *configurationv1alpha1.KongRoute {
+		r := &configurationv1alpha1.KongRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Status: configurationv1alpha1.KongRouteStatus{
+				Konnect: &konnectv1alpha1.KonnectEntityStatusWithControlPlaneAndServiceRefs{
+					ControlPlaneID: cpID,
+					ServiceID:      serviceID,
+				},
+			},
+		}
+		if withServiceRef {
+			r.Spec.ServiceRef = &configurationv1alpha1.ServiceRef{
+				Type: configurationv1alpha1.ServiceRefNamespacedRef,
+				NamespacedRef: &configurationv1alpha1.NamespacedServiceRef{
+					Name: "svc",
+				},
+			}
+		}
+		return r
+	}
+
+	objects := []client.Object{
+		cp,
+		route("route-ready", "cp-id", "svc-id", true),
+		route("route-without-service", "cp-id", "", false),
+		route("route-service-not-created", "cp-id", "", true),
+		route("route-other-cp", "other-cp-id", "svc-id", true),
+	}
+	deleted := route("route-deleted", "cp-id", "svc-id", true)
+	deleted.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deleted.Finalizers = []string{KonnectCleanupFinalizer}
+	objects = append(objects, deleted)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, configurationv1alpha1.AddToScheme(scheme))
+	require.NoError(t, konnectv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		Build()
+
+	routes, err := listEntitiesForBulkSync[configurationv1alpha1.KongRoute](context.Background(), fakeClient, cp)
+	require.NoError(t, err)
+	assert.ElementsMatch(t,
+		[]string{"route-ready", "route-without-service"},
+		lo.Map(routes, func(r *configurationv1alpha1.KongRoute, _ int) string { return r.Name }),
+	)
+
+	routes, err = listEntitiesForBulkSync[configurationv1alpha1.KongRoute](context.Background(), fakeClient, cp)
+	require.NoError(t, err)
+	assert.Len(t, routes, 2, "objects being deleted should not be synced")
+	routes, err = listDeletedEntitiesForBulkSync[configurationv1alpha1.KongRoute](context.Background(), fakeClient, cp)
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{"route-deleted"},
+		lo.Map(routes, func(r *configurationv1alpha1.KongRoute, _ int) string { return r.Name }),
+	)
+
+	bulk, err := isBulkSyncEnabledForControlPlaneID(context.Background(), fakeClient, "default", "cp-id")
+	require.NoError(t, err)
+	assert.True(t, bulk)
+
+	bulk, err = isBulkSyncEnabledForControlPlaneID(context.Background(), fakeClient, "default", "other-cp-id")
+	require.NoError(t, err)
+	assert.False(t, bulk)
+}
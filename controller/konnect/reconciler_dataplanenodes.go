@@ -0,0 +1,374 @@
+package konnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operatorv1alpha1 "github.com/kong/gateway-operator/api/v1alpha1"
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+// maxOutOfSyncNodesInMessage is the maximum number of out of sync data plane
+// nodes listed in the DataPlaneNodesSynced condition's message.
+const maxOutOfSyncNodesInMessage = 10
+
+// KonnectDataPlaneNodesReconciler periodically polls the Konnect data plane nodes
+// API for each KonnectGatewayControlPlane and reports the state of the nodes:
+//   - in the DataPlaneNodesSynced condition of the KonnectGatewayControlPlane
+//     (number of connected nodes and the nodes which did not apply the latest configuration),
+//   - in the KonnectConnected condition of the DataPlanes using a KonnectExtension
+//     which references the KonnectGatewayControlPlane (DataPlane's Pods are matched
+//     with the nodes by their hostnames).
+type KonnectDataPlaneNodesReconciler struct {
+	sdkFactory      ops.SDKFactory
+	developmentMode bool
+	client          client.Client
+	syncPeriod      time.Duration
+}
+
+// NewKonnectDataPlaneNodesReconciler creates a new KonnectDataPlaneNodesReconciler.
+func NewKonnectDataPlaneNodesReconciler(
+	sdkFactory ops.SDKFactory,
+	developmentMode bool,
+	client client.Client,
+	syncPeriod time.Duration,
+) *KonnectDataPlaneNodesReconciler {
+	return &KonnectDataPlaneNodesReconciler{
+		sdkFactory:      sdkFactory,
+		developmentMode: developmentMode,
+		client:          client,
+		syncPeriod:      syncPeriod,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KonnectDataPlaneNodesReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("KonnectDataPlaneNodes").
+		For(&konnectv1alpha1.KonnectGatewayControlPlane{},
+			// NOTE: Status updates are not watched to not poll Konnect on every
+			// status change. The ControlPlanes are polled periodically anyway.
+			builder.WithPredicates(
+				predicate.Or(
+					predicate.GenerationChangedPredicate{},
+					predicate.AnnotationChangedPredicate{},
+				),
+			),
+		).
+		Complete(r)
+}
+
+// Reconcile polls the data plane nodes of the KonnectGatewayControlPlane and
+// reports their state.
+func (r *KonnectDataPlaneNodesReconciler) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var cp konnectv1alpha1.KonnectGatewayControlPlane
+	if err := r.client.Get(ctx, req.NamespacedName, &cp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	logger := log.GetLogger(ctx, "KonnectDataPlaneNodes", r.developmentMode).
+		WithValues("konnect_id", cp.GetKonnectStatus().GetKonnectID())
+	ctx = ctrllog.IntoContext(ctx, logger)
+	log.Debug(logger, "reconciling", cp)
+
+	if !cp.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Resuming the ControlPlane's reconciliation will trigger another reconciliation.
+	if k8sutils.IsReconciliationPaused(&cp) {
+		log.Debug(logger, "ControlPlane reconciliation is paused, skipping data plane nodes status", cp)
+		return ctrl.Result{}, nil
+	}
+
+	if cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, &cp); !ok ||
+		cond.Status != metav1.ConditionTrue ||
+		cp.GetKonnectStatus().GetKonnectID() == "" {
+		log.Debug(logger, "ControlPlane is not programmed yet, skipping data plane nodes status", cp)
+		return ctrl.Result{RequeueAfter: r.syncPeriod}, nil
+	}
+
+	sdk, _, err := newKonnectSDKForControlPlane(ctx, r.client, r.sdkFactory, &cp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	status, err := ops.GetDataPlaneNodesStatus(ctx, sdk.GetDataPlaneNodesSDK(), cp.GetKonnectStatus().GetKonnectID(), time.Now())
+	if err != nil {
+		return requeueIfRateLimited(ctx, ctrl.Result{}, err)
+	}
+
+	if err := r.ensureControlPlaneNodesCondition(ctx, &cp, status); err != nil {
+		if k8serrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if err := r.ensureDataPlanesConnectedCondition(ctx, logger, &cp, status); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// NOTE: Konnect does not allow subscribing to data plane nodes changes
+	// so we need to keep polling periodically.
+	return ctrl.Result{
+		RequeueAfter: r.syncPeriod,
+	}, nil
+}
+
+// ensureControlPlaneNodesCondition sets the DataPlaneNodesSynced condition of
+// the KonnectGatewayControlPlane according to the provided nodes status.
+func (r *KonnectDataPlaneNodesReconciler) ensureControlPlaneNodesCondition(
+	ctx context.Context,
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+	status ops.DataPlaneNodesStatus,
+) error {
+	old := cp.DeepCopy()
+	k8sutils.SetCondition(dataPlaneNodesSyncedCondition(cp, status), cp)
+	if !k8sutils.NeedsUpdate(old, cp) {
+		return nil
+	}
+	return r.client.Status().Patch(ctx, cp, client.MergeFromWithOptions(old, client.MergeFromWithOptimisticLock{}))
+}
+
+// dataPlaneNodesSyncedCondition returns the DataPlaneNodesSynced condition
+// for the KonnectGatewayControlPlane with the provided nodes status.
+func dataPlaneNodesSyncedCondition(
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+	status ops.DataPlaneNodesStatus,
+) metav1.Condition {
+	if len(status.Nodes) == 0 {
+		return k8sutils.NewConditionWithGeneration(
+			consts.DataPlaneNodesSyncedType,
+			metav1.ConditionFalse,
+			consts.NoDataPlaneNodesConnectedReason,
+			"No data plane nodes connected",
+			cp.GetGeneration(),
+		)
+	}
+
+	outOfSync := status.OutOfSync()
+	if len(outOfSync) == 0 {
+		return k8sutils.NewConditionWithGeneration(
+			consts.DataPlaneNodesSyncedType,
+			metav1.ConditionTrue,
+			consts.DataPlaneNodesSyncedReason,
+			fmt.Sprintf("%d data plane node(s) connected, all in sync", len(status.Nodes)),
+			cp.GetGeneration(),
+		)
+	}
+
+	listed := lo.Map(
+		lo.Subset(outOfSync, 0, maxOutOfSyncNodesInMessage),
+		func(n ops.DataPlaneNode, _ int) string {
+			return fmt.Sprintf("%s (version: %s, config hash: %s)", n.Hostname, n.Version, n.ConfigHash)
+		},
+	)
+	if len(outOfSync) > maxOutOfSyncNodesInMessage {
+		listed = append(listed, fmt.Sprintf("and %d more", len(outOfSync)-maxOutOfSyncNodesInMessage))
+	}
+	return k8sutils.NewConditionWithGeneration(
+		consts.DataPlaneNodesSyncedType,
+		metav1.ConditionFalse,
+		consts.DataPlaneNodesOutOfSyncReason,
+		fmt.Sprintf("%d data plane node(s) connected, %d out of sync (expected config hash: %s): %s",
+			len(status.Nodes), len(outOfSync), status.ExpectedConfigHash, strings.Join(listed, ", "),
+		),
+		cp.GetGeneration(),
+	)
+}
+
+// ensureDataPlanesConnectedCondition sets the KonnectConnected condition of the
+// DataPlanes using KonnectExtensions which reference the KonnectGatewayControlPlane.
+func (r *KonnectDataPlaneNodesReconciler) ensureDataPlanesConnectedCondition(
+	ctx context.Context,
+	logger logr.Logger,
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+	status ops.DataPlaneNodesStatus,
+) error {
+	// NOTE: KonnectExtensions can only reference ControlPlanes and be referenced
+	// by DataPlanes from their own namespace.
+	var extensions operatorv1alpha1.KonnectExtensionList
+	if err := r.client.List(ctx, &extensions, client.InNamespace(cp.Namespace)); err != nil {
+		return fmt.Errorf("failed to list KonnectExtensions: %w", err)
+	}
+	extensions.Items = lo.Filter(extensions.Items, func(ext operatorv1alpha1.KonnectExtension, _ int) bool {
+		return konnectExtensionReferencesControlPlane(&ext, cp)
+	})
+	if len(extensions.Items) == 0 {
+		return nil
+	}
+
+	var dataPlanes operatorv1beta1.DataPlaneList
+	if err := r.client.List(ctx, &dataPlanes, client.InNamespace(cp.Namespace)); err != nil {
+		return fmt.Errorf("failed to list DataPlanes: %w", err)
+	}
+
+	var errs []error
+	for i := range dataPlanes.Items {
+		dp := &dataPlanes.Items[i]
+		if !lo.ContainsBy(extensions.Items, func(ext operatorv1alpha1.KonnectExtension) bool {
+			return dataPlaneUsesKonnectExtension(dp, &ext)
+		}) {
+			continue
+		}
+
+		deployments, err := k8sutils.ListDeploymentsForOwner(ctx, r.client, dp.Namespace, dp.UID,
+			client.MatchingLabels{"app": dp.Name},
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list Deployments of DataPlane %s: %w", client.ObjectKeyFromObject(dp), err))
+			continue
+		}
+
+		var (
+			names = lo.Map(deployments, func(d appsv1.Deployment, _ int) string {
+				return d.Name
+			})
+			readyReplicas = lo.SumBy(deployments, func(d appsv1.Deployment) int32 {
+				return d.Status.ReadyReplicas
+			})
+			old = dp.DeepCopy()
+		)
+		k8sutils.SetCondition(konnectConnectedCondition(dp, names, readyReplicas, status), dp)
+		if !k8sutils.NeedsUpdate(old, dp) {
+			continue
+		}
+		if err := r.client.Status().Patch(ctx, dp, client.MergeFromWithOptions(old, client.MergeFromWithOptimisticLock{})); err != nil {
+			if k8serrors.IsConflict(err) {
+				// The condition will be set in the next poll.
+				log.Debug(logger, "conflict while setting KonnectConnected condition of DataPlane", dp)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to update status of DataPlane %s: %w", client.ObjectKeyFromObject(dp), err))
+			continue
+		}
+		log.Debug(logger, "KonnectConnected condition of DataPlane updated", dp)
+	}
+	return errors.Join(errs...)
+}
+
+// konnectConnectedCondition returns the KonnectConnected condition for the
+// DataPlane with the provided Deployments and nodes status.
+// Kong Gateway reports the name of its Pod as the node's hostname so the nodes
+// are matched with the DataPlane's Pods by the Deployments' names prefix.
+func konnectConnectedCondition(
+	dp *operatorv1beta1.DataPlane,
+	deployments []string,
+	readyReplicas int32,
+	status ops.DataPlaneNodesStatus,
+) metav1.Condition {
+	nodes := lo.Filter(status.Nodes, func(n ops.DataPlaneNode, _ int) bool {
+		return lo.ContainsBy(deployments, func(deployment string) bool {
+			return strings.HasPrefix(n.Hostname, deployment+"-")
+		})
+	})
+	outOfSync := lo.CountBy(nodes, func(n ops.DataPlaneNode) bool {
+		return !n.InSync
+	})
+
+	msg := fmt.Sprintf("%d/%d ready Pod(s) connected to Konnect", len(nodes), readyReplicas)
+	if outOfSync > 0 {
+		msg += fmt.Sprintf(", %d out of sync", outOfSync)
+	}
+	if readyReplicas == 0 || len(nodes) < int(readyReplicas) {
+		return k8sutils.NewConditionWithGeneration(
+			consts.KonnectConnectedType,
+			metav1.ConditionFalse,
+			consts.KonnectNotConnectedReason,
+			msg,
+			dp.GetGeneration(),
+		)
+	}
+	return k8sutils.NewConditionWithGeneration(
+		consts.KonnectConnectedType,
+		metav1.ConditionTrue,
+		consts.KonnectConnectedReason,
+		msg,
+		dp.GetGeneration(),
+	)
+}
+
+// konnectExtensionReferencesControlPlane returns true when the KonnectExtension
+// references the provided KonnectGatewayControlPlane, either by its name or by its Konnect ID.
+func konnectExtensionReferencesControlPlane(
+	ext *operatorv1alpha1.KonnectExtension,
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+) bool {
+	cpRef := ext.Spec.ControlPlaneRef
+	switch {
+	case cpRef.Type == configurationv1alpha1.ControlPlaneRefKonnectNamespacedRef && cpRef.KonnectNamespacedRef != nil:
+		return cpRef.KonnectNamespacedRef.Name == cp.Name
+	case cpRef.KonnectID != nil:
+		return *cpRef.KonnectID == cp.GetKonnectStatus().GetKonnectID()
+	default:
+		return false
+	}
+}
+
+// dataPlaneUsesKonnectExtension returns true when the DataPlane's extensions
+// include the provided KonnectExtension.
+func dataPlaneUsesKonnectExtension(dp *operatorv1beta1.DataPlane, ext *operatorv1alpha1.KonnectExtension) bool {
+	return lo.ContainsBy(dp.Spec.Extensions, func(ref operatorv1alpha1.ExtensionRef) bool {
+		return ref.Group == operatorv1alpha1.SchemeGroupVersion.Group &&
+			ref.Kind == operatorv1alpha1.KonnectExtensionKind &&
+			ref.Name == ext.Name &&
+			lo.FromPtrOr(ref.Namespace, dp.Namespace) == ext.Namespace
+	})
+}
+
+// newKonnectSDKForControlPlane creates a new Konnect SDK using the
+// KonnectAPIAuthConfiguration referenced by the KonnectGatewayControlPlane.
+// The KonnectAPIAuthConfiguration is returned as well.
+func newKonnectSDKForControlPlane(
+	ctx context.Context,
+	cl client.Client,
+	sdkFactory ops.SDKFactory,
+	cp *konnectv1alpha1.KonnectGatewayControlPlane,
+) (ops.SDKWrapper, *konnectv1alpha1.KonnectAPIAuthConfiguration, error) {
+	var apiAuth konnectv1alpha1.KonnectAPIAuthConfiguration
+	apiAuthRef := types.NamespacedName{
+		Name: cp.GetKonnectAPIAuthConfigurationRef().Name,
+		// TODO(pmalek): enable if cross namespace refs are allowed
+		Namespace: cp.GetNamespace(),
+	}
+	if err := cl.Get(ctx, apiAuthRef, &apiAuth); err != nil {
+		return nil, nil, fmt.Errorf("failed to get KonnectAPIAuthConfiguration %s: %w", apiAuthRef, err)
+	}
+	token, err := getTokenFromKonnectAPIAuthConfiguration(ctx, cl, &apiAuth)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// NOTE: We need to create a new SDK instance for each reconciliation
+	// because the token is retrieved in runtime through KonnectAPIAuthConfiguration.
+	sdk := sdkFactory.NewKonnectSDK(
+		ops.NewServerURL(apiAuth.Spec.ServerURL).String(),
+		ops.SDKToken(token),
+		apiAuth.Status.OrganizationID,
+	)
+	return sdk, &apiAuth, nil
+}
@@ -0,0 +1,224 @@
+package konnect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kong/gateway-operator/api/v1alpha1"
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func TestDataPlaneNodesSyncedCondition(t *testing.T) {
+	cp := &konnectv1alpha1.KonnectGatewayControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 3,
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		status          ops.DataPlaneNodesStatus
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  consts.ConditionReason
+		expectedMessage string
+	}{
+		{
+			name:            "no nodes connected",
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  consts.NoDataPlaneNodesConnectedReason,
+			expectedMessage: "No data plane nodes connected",
+		},
+		{
+			name: "all nodes in sync",
+			status: ops.DataPlaneNodesStatus{
+				ExpectedConfigHash: "hash",
+				Nodes: []ops.DataPlaneNode{
+					{Hostname: "node-1", ConfigHash: "hash", InSync: true},
+					{Hostname: "node-2", ConfigHash: "hash", InSync: true},
+				},
+			},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  consts.DataPlaneNodesSyncedReason,
+			expectedMessage: "2 data plane node(s) connected, all in sync",
+		},
+		{
+			name: "nodes out of sync",
+			status: ops.DataPlaneNodesStatus{
+				ExpectedConfigHash: "hash",
+				Nodes: []ops.DataPlaneNode{
+					{Hostname: "node-1", ConfigHash: "hash", InSync: true},
+					{Hostname: "node-2", Version: "3.8.0.0", ConfigHash: "old", InSync: false},
+				},
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  consts.DataPlaneNodesOutOfSyncReason,
+			expectedMessage: "2 data plane node(s) connected, 1 out of sync (expected config hash: hash): node-2 (version: 3.8.0.0, config hash: old)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cond := dataPlaneNodesSyncedCondition(cp, tc.status)
+			assert.Equal(t, string(consts.DataPlaneNodesSyncedType), cond.Type)
+			assert.Equal(t, tc.expectedStatus, cond.Status)
+			assert.Equal(t, string(tc.expectedReason), cond.Reason)
+			assert.Equal(t, tc.expectedMessage, cond.Message)
+			assert.Equal(t, cp.Generation, cond.ObservedGeneration)
+		})
+	}
+}
+
+func TestEnsureDataPlanesConnectedCondition(t *testing.T) {
+	cp := &konnectv1alpha1.KonnectGatewayControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp",
+			Namespace: "default",
+		},
+		Status: konnectv1alpha1.KonnectGatewayControlPlaneStatus{
+			KonnectEntityStatus: konnectv1alpha1.KonnectEntityStatus{
+				ID: "cp-id",
+			},
+		},
+	}
+	extension := func(name string, cpRef configurationv1alpha1.ControlPlaneRef) *operatorv1alpha1.KonnectExtension {
+		return &operatorv1alpha1.KonnectExtension{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: operatorv1alpha1.KonnectExtensionSpec{
+				ControlPlaneRef: cpRef,
+			},
+		}
+	}
+	dataPlane := func(name, extension string) *operatorv1beta1.DataPlane {
+		return &operatorv1beta1.DataPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				UID:       types.UID("uid-" + name),
+			},
+			Spec: operatorv1beta1.DataPlaneSpec{
+				DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+					Extensions: []operatorv1alpha1.ExtensionRef{
+						{
+							Group: operatorv1alpha1.SchemeGroupVersion.Group,
+							Kind:  operatorv1alpha1.KonnectExtensionKind,
+							NamespacedRef: operatorv1alpha1.NamespacedRef{
+								Name: extension,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	deployment := func(name string, dp *operatorv1beta1.DataPlane, readyReplicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					"app": dp.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: operatorv1beta1.SchemeGroupVersion.String(),
+						Kind:       "DataPlane",
+						Name:       dp.Name,
+						UID:        dp.UID,
+					},
+				},
+			},
+			Status: appsv1.DeploymentStatus{
+				ReadyReplicas: readyReplicas,
+			},
+		}
+	}
+
+	dpConnected := dataPlane("dp-connected", "ext-by-name")
+	dpNotConnected := dataPlane("dp-not-connected", "ext-by-id")
+	dpOtherCP := dataPlane("dp-other-cp", "ext-other-cp")
+	objects := []client.Object{
+		cp,
+		extension("ext-by-name", configurationv1alpha1.ControlPlaneRef{
+			Type:                 configurationv1alpha1.ControlPlaneRefKonnectNamespacedRef,
+			KonnectNamespacedRef: &configurationv1alpha1.KonnectNamespacedRef{Name: "cp"},
+		}),
+		extension("ext-by-id", configurationv1alpha1.ControlPlaneRef{
+			Type:      configurationv1alpha1.ControlPlaneRefKonnectID,
+			KonnectID: lo.ToPtr("cp-id"),
+		}),
+		extension("ext-other-cp", configurationv1alpha1.ControlPlaneRef{
+			Type:      configurationv1alpha1.ControlPlaneRefKonnectID,
+			KonnectID: lo.ToPtr("other-cp-id"),
+		}),
+		dpConnected,
+		dpNotConnected,
+		dpOtherCP,
+		deployment("dataplane-dp-connected-abcde", dpConnected, 2),
+		deployment("dataplane-dp-not-connected-fghij", dpNotConnected, 2),
+		deployment("dataplane-dp-other-cp-klmno", dpOtherCP, 1),
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, operatorv1alpha1.AddToScheme(scheme))
+	require.NoError(t, operatorv1beta1.AddToScheme(scheme))
+	require.NoError(t, konnectv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&operatorv1beta1.DataPlane{}).
+		Build()
+
+	status := ops.DataPlaneNodesStatus{
+		ExpectedConfigHash: "hash",
+		Nodes: []ops.DataPlaneNode{
+			{Hostname: "dataplane-dp-connected-abcde-7d9f8b6c5-x2x4z", ConfigHash: "hash", InSync: true},
+			{Hostname: "dataplane-dp-connected-abcde-7d9f8b6c5-q8w7e", ConfigHash: "old", InSync: false},
+			{Hostname: "dataplane-dp-not-connected-fghij-5c6d7e8f9-a1b2c", ConfigHash: "hash", InSync: true},
+			{Hostname: "dataplane-dp-other-cp-klmno-5c6d7e8f9-a1b2c", ConfigHash: "hash", InSync: true},
+		},
+	}
+	r := NewKonnectDataPlaneNodesReconciler(nil, false, fakeClient, 0)
+	require.NoError(t, r.ensureDataPlanesConnectedCondition(context.Background(), logr.Discard(), cp, status))
+
+	getCondition := func(t *testing.T, dp *operatorv1beta1.DataPlane) (metav1.Condition, bool) {
+		var current operatorv1beta1.DataPlane
+		require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(dp), &current))
+		return k8sutils.GetCondition(consts.KonnectConnectedType, &current)
+	}
+
+	cond, ok := getCondition(t, dpConnected)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(consts.KonnectConnectedReason), cond.Reason)
+	assert.Equal(t, "2/2 ready Pod(s) connected to Konnect, 1 out of sync", cond.Message)
+
+	cond, ok = getCondition(t, dpNotConnected)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(consts.KonnectNotConnectedReason), cond.Reason)
+	assert.Equal(t, "1/2 ready Pod(s) connected to Konnect", cond.Message)
+
+	_, ok = getCondition(t, dpOtherCP)
+	assert.False(t, ok, "DataPlanes of other ControlPlanes should not get the condition")
+}
@@ -43,6 +43,8 @@ type KonnectEntityReconciler[T constraints.SupportedKonnectEntityType, TEnt cons
 	DevelopmentMode bool
 	Client          client.Client
 	SyncPeriod      time.Duration
+
+	createExpectations *createExpectations
 }
 
 // KonnectEntityReconcilerOption is a functional option for the KonnectEntityReconciler.
@@ -72,10 +74,11 @@ func NewKonnectEntityReconciler[
 	opts ...KonnectEntityReconcilerOption[T, TEnt],
 ) *KonnectEntityReconciler[T, TEnt] {
 	r := &KonnectEntityReconciler[T, TEnt]{
-		sdkFactory:      sdkFactory,
-		DevelopmentMode: developmentMode,
-		Client:          client,
-		SyncPeriod:      consts.DefaultKonnectSyncPeriod,
+		sdkFactory:         sdkFactory,
+		DevelopmentMode:    developmentMode,
+		Client:             client,
+		SyncPeriod:         consts.DefaultKonnectSyncPeriod,
+		createExpectations: newCreateExpectations(),
 	}
 	for _, opt := range opts {
 		opt(r)
@@ -110,6 +113,8 @@ func (r *KonnectEntityReconciler[T, TEnt]) SetupWithManager(ctx context.Context,
 }
 
 // Reconcile reconciles the given Konnect entity.
+// Reconciliations throttled by Konnect API rate limiting are requeued at
+// the time indicated by Konnect.
 func (r *KonnectEntityReconciler[T, TEnt]) Reconcile(
 	ctx context.Context, req ctrl.Request,
 ) (ctrl.Result, error) {
@@ -117,6 +122,15 @@ func (r *KonnectEntityReconciler[T, TEnt]) Reconcile(
 		entityTypeName = constraints.EntityTypeName[T]()
 		logger         = log.GetLogger(ctx, entityTypeName, r.DevelopmentMode)
 	)
+	ctx = ctrllog.IntoContext(ctx, logger)
+	res, err := r.reconcile(ctx, req)
+	return requeueIfRateLimited(ctx, res, err)
+}
+
+func (r *KonnectEntityReconciler[T, TEnt]) reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	logger := ctrllog.FromContext(ctx)
 
 	var (
 		e   T
@@ -132,6 +146,18 @@ func (r *KonnectEntityReconciler[T, TEnt]) Reconcile(
 	ctx = ctrllog.IntoContext(ctx, logger)
 	log.Debug(logger, "reconciling", ent)
 
+	if k8sutils.IsReconciliationPaused(ent) {
+		log.Debug(logger, "reconciliation paused, skipping", ent)
+		if !k8sutils.SetPausedCondition(ent) {
+			return ctrl.Result{}, nil
+		}
+		return updateStatus(ctx, r.Client, ent)
+	}
+	if k8sutils.RemoveCondition(consts.PausedType, ent) {
+		// Status update will requeue the entity.
+		return updateStatus(ctx, r.Client, ent)
+	}
+
 	// If a type has a ControlPlane ref, handle it.
 	res, err := handleControlPlaneRef(ctx, r.Client, ent)
 	if err != nil || !res.IsZero() {
@@ -440,10 +466,27 @@ func (r *KonnectEntityReconciler[T, TEnt]) Reconcile(
 	sdk := r.sdkFactory.NewKonnectSDK(
 		serverURL.String(),
 		ops.SDKToken(token),
+		apiAuth.Status.OrganizationID,
 	)
 
+	// Entities referencing ControlPlanes in the bulk sync mode are created,
+	// updated and deleted by the KonnectBulkSyncReconciler.
+	if ops.IsBulkSyncSupported[T]() {
+		if entWithCPRef, ok := any(ent).(EntityWithControlPlaneRef); ok {
+			bulk, err := isBulkSyncEnabledForControlPlaneID(ctx, r.Client, ent.GetNamespace(), entWithCPRef.GetControlPlaneID())
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if bulk {
+				log.Debug(logger, "ControlPlane is in bulk sync mode, skipping entity sync", ent)
+				return ctrl.Result{}, nil
+			}
+		}
+	}
+
 	if delTimestamp := ent.GetDeletionTimestamp(); !delTimestamp.IsZero() {
 		logger.Info("resource is being deleted")
+		r.createExpectations.Observe(ent.GetUID())
 		// wait for termination grace period before cleaning up
 		if delTimestamp.After(time.Now()) {
 			logger.Info("resource still under grace period, requeueing")
@@ -457,7 +500,13 @@ func (r *KonnectEntityReconciler[T, TEnt]) Reconcile(
 		}
 
 		if controllerutil.RemoveFinalizer(ent, KonnectCleanupFinalizer) {
-			if err := ops.Delete[T, TEnt](ctx, sdk, r.Client, ent); err != nil {
+			// With the orphan deletion policy the entity is left in Konnect,
+			// only the Kubernetes metadata tags are removed from it.
+			cleanup := ops.Delete[T, TEnt]
+			if ops.IsOrphaned(ent) {
+				cleanup = ops.Orphan[T, TEnt]
+			}
+			if err := cleanup(ctx, sdk, r.Client, ent); err != nil {
 				if res, errStatus := updateStatusWithCondition(
 					ctx, r.Client, ent,
 					konnectv1alpha1.KonnectEntityProgrammedConditionType,
@@ -480,19 +529,26 @@ func (r *KonnectEntityReconciler[T, TEnt]) Reconcile(
 		return ctrl.Result{}, nil
 	}
 
-	// TODO: relying on status ID is OK but we need to rethink this because
-	// we're using a cached client so after creating the resource on Konnect it might
-	// happen that we've just created the resource but the status ID is not there yet.
-	//
-	// We should look at the "expectations" for this:
-	// https://github.com/kubernetes/kubernetes/blob/master/pkg/controller/controller_utils.go
 	if status := ent.GetKonnectStatus(); status == nil || status.GetKonnectID() == "" {
 		obj := ent.DeepCopyObject().(client.Object)
-		_, err := ops.Create[T, TEnt](ctx, sdk, r.Client, ent)
 
-		// TODO: this is actually not 100% error prone because when status
-		// update fails we don't store the Konnect ID and hence the reconciler
-		// will try to create the resource again on next reconciliation.
+		// If the entity has already been created in Konnect but its Konnect ID
+		// has not been observed in the status yet (e.g. because the status update
+		// has failed or the cache is stale) then use the expected ID instead of
+		// creating the entity again.
+		var err error
+		if id, ok := r.createExpectations.Get(ent.GetUID()); ok {
+			log.Debug(logger, "using Konnect ID of an entity created in a previous reconciliation", ent,
+				"konnect_id", id,
+			)
+			ent.GetKonnectStatus().SetKonnectID(id)
+		} else {
+			_, err = ops.Create[T, TEnt](ctx, sdk, r.Client, ent)
+		}
+
+		if id := ent.GetKonnectStatus().GetKonnectID(); id != "" {
+			r.createExpectations.Expect(ent.GetUID(), id)
+		}
 
 		// Regardless of the error reported from Create(), if the Konnect ID has been
 		// set then add the finalizer so that the resource can be cleaned up from Konnect on deletion.
@@ -542,6 +598,10 @@ func (r *KonnectEntityReconciler[T, TEnt]) Reconcile(
 		return ctrl.Result{}, nil
 	}
 
+	// The Konnect ID has been observed in the status so there's no need to
+	// track the create expectation anymore.
+	r.createExpectations.Observe(ent.GetUID())
+
 	if res, err := ops.Update[T, TEnt](ctx, sdk, r.SyncPeriod, r.Client, ent); err != nil {
 		setServerURLAndOrgID(ent, serverURL, apiAuth.Status.OrganizationID)
 		if errUpd := r.Client.Status().Update(ctx, ent); errUpd != nil {
@@ -589,6 +649,23 @@ type EntityWithControlPlaneRef interface {
 	GetControlPlaneID() string
 }
 
+func updateStatus[T interface {
+	client.Object
+	k8sutils.ConditionsAware
+}](
+	ctx context.Context,
+	cl client.Client,
+	ent T,
+) (ctrl.Result, error) {
+	if err := cl.Status().Update(ctx, ent); err != nil {
+		if k8serrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
 func updateStatusWithCondition[T interface {
 	client.Object
 	k8sutils.ConditionsAware
@@ -0,0 +1,54 @@
+package konnect
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// createExpectations tracks Konnect IDs of entities which have been created in
+// Konnect but which have not been observed in the status of their corresponding
+// objects yet.
+//
+// The status update storing the Konnect ID can fail after the entity has been
+// created or the reconciler can get a stale object (without the Konnect ID)
+// from the cache. In both cases the reconciler uses the expected ID instead
+// of creating the entity again.
+//
+// Ref: https://github.com/kubernetes/kubernetes/blob/master/pkg/controller/controller_utils.go
+type createExpectations struct {
+	lock sync.RWMutex
+	ids  map[types.UID]string
+}
+
+// newCreateExpectations returns a new, empty createExpectations.
+func newCreateExpectations() *createExpectations {
+	return &createExpectations{
+		ids: make(map[types.UID]string),
+	}
+}
+
+// Expect records the Konnect ID of the entity created for the object with the provided UID.
+func (e *createExpectations) Expect(uid types.UID, konnectID string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.ids[uid] = konnectID
+}
+
+// Get returns the Konnect ID of the entity created for the object with the
+// provided UID which has not been observed in the object's status yet.
+func (e *createExpectations) Get(uid types.UID) (string, bool) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	id, ok := e.ids[uid]
+	return id, ok
+}
+
+// Observe removes the expectation for the object with the provided UID.
+// It should be called once the Konnect ID is observed in the object's status
+// or when the object is deleted.
+func (e *createExpectations) Observe(uid types.UID) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	delete(e.ids, uid)
+}
@@ -0,0 +1,29 @@
+package konnect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCreateExpectations(t *testing.T) {
+	const uid = types.UID("uid-1")
+	e := newCreateExpectations()
+
+	_, ok := e.Get(uid)
+	require.False(t, ok)
+
+	e.Expect(uid, "konnect-id")
+	id, ok := e.Get(uid)
+	require.True(t, ok)
+	assert.Equal(t, "konnect-id", id)
+
+	_, ok = e.Get(types.UID("uid-2"))
+	assert.False(t, ok)
+
+	e.Observe(uid)
+	_, ok = e.Get(uid)
+	assert.False(t, ok)
+}
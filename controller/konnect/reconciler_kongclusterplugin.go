@@ -0,0 +1,226 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	"github.com/kong/gateway-operator/controller/pkg/log"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+)
+
+// KongClusterPluginReconciler reconciles a KongClusterPlugin object.
+type KongClusterPluginReconciler struct {
+	developmentMode bool
+	client          client.Client
+}
+
+// NewKongClusterPluginReconciler creates a new KongClusterPluginReconciler.
+func NewKongClusterPluginReconciler(
+	developmentMode bool,
+	client client.Client,
+) *KongClusterPluginReconciler {
+	return &KongClusterPluginReconciler{
+		developmentMode: developmentMode,
+		client:          client,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KongClusterPluginReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("KongClusterPlugin").
+		For(&configurationv1.KongClusterPlugin{}).
+		Watches(
+			&configurationv1alpha1.KongPluginBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.mapKongPluginBindings),
+		).
+		Watches(
+			&configurationv1.KongPlugin{},
+			handler.EnqueueRequestsFromMapFunc(mapKongClusterPluginForKongPlugin),
+			builder.WithPredicates(
+				kongPluginCreatedOrDeletedPredicate,
+			),
+		).
+		Watches(
+			&configurationv1alpha1.KongService{},
+			handler.EnqueueRequestsFromMapFunc(mapClusterPluginsFromAnnotation[configurationv1alpha1.KongService](r.developmentMode)),
+			builder.WithPredicates(
+				kongPluginsAnnotationChangedPredicate,
+			),
+		).
+		Watches(
+			&configurationv1alpha1.KongRoute{},
+			handler.EnqueueRequestsFromMapFunc(mapClusterPluginsFromAnnotation[configurationv1alpha1.KongRoute](r.developmentMode)),
+			builder.WithPredicates(
+				kongPluginsAnnotationChangedPredicate,
+			),
+		).
+		Watches(
+			&configurationv1.KongConsumer{},
+			handler.EnqueueRequestsFromMapFunc(mapClusterPluginsFromAnnotation[configurationv1.KongConsumer](r.developmentMode)),
+			builder.WithPredicates(
+				kongPluginsAnnotationChangedPredicate,
+			),
+		).
+		Watches(
+			&configurationv1beta1.KongConsumerGroup{},
+			handler.EnqueueRequestsFromMapFunc(mapClusterPluginsFromAnnotation[configurationv1beta1.KongConsumerGroup](r.developmentMode)),
+			builder.WithPredicates(
+				kongPluginsAnnotationChangedPredicate,
+			),
+		).
+		Complete(r)
+}
+
+// Reconcile reconciles a KongClusterPlugin object.
+// The purpose of this reconciler is to handle annotations on Kong entities objects that reference KongClusterPlugin objects.
+// As a result of such annotations, KongPluginBinding objects are created and managed by the controller
+// in the namespaces of the annotated entities.
+// A KongPlugin with the same name as the KongClusterPlugin takes precedence in its namespace.
+func (r *KongClusterPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var (
+		entityTypeName = "KongClusterPlugin"
+		logger         = log.GetLogger(ctx, entityTypeName, r.developmentMode)
+	)
+
+	var kongClusterPlugin configurationv1.KongClusterPlugin
+	if err := r.client.Get(ctx, req.NamespacedName, &kongClusterPlugin); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	log.Debug(logger, "reconciling", kongClusterPlugin)
+
+	// Get the pluginBindings that use this KongClusterPlugin
+	kongPluginBindingList := configurationv1alpha1.KongPluginBindingList{}
+	err := r.client.List(
+		ctx,
+		&kongPluginBindingList,
+		client.MatchingFields{
+			IndexFieldKongPluginBindingKongClusterPluginReference: kongClusterPlugin.Name,
+		},
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	foreignRelations, err := listAllEntitiesReferencingClusterPluginIntoRelations(ctx, r.client, kongClusterPlugin.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	pluginReferenceFound, res, err := ensureKongPluginBindings(ctx, logger, r.client, &kongClusterPlugin, foreignRelations, kongPluginBindingList.Items)
+	if err != nil || !res.IsZero() {
+		return res, err
+	}
+
+	if res, err := ensurePluginInUseFinalizer(ctx, logger, r.client, &kongClusterPlugin, pluginReferenceFound); err != nil || !res.IsZero() {
+		return res, err
+	}
+
+	log.Debug(logger, "reconciliation completed", kongClusterPlugin)
+	return ctrl.Result{}, nil
+}
+
+// listAllEntitiesReferencingClusterPluginIntoRelations returns the entities from all namespaces
+// referencing the KongClusterPlugin with the provided name via the konghq.com/plugins annotation.
+// Entities from namespaces with a KongPlugin of the same name are skipped as the KongPlugin takes precedence.
+func listAllEntitiesReferencingClusterPluginIntoRelations(
+	ctx context.Context,
+	cl client.Client,
+	name string,
+) (ForeignRelations, error) {
+	var kongServiceList configurationv1alpha1.KongServiceList
+	err := cl.List(ctx, &kongServiceList,
+		client.MatchingFields{
+			IndexFieldKongServiceOnReferencedClusterPluginNames: name,
+		},
+	)
+	if err != nil {
+		return ForeignRelations{}, fmt.Errorf("failed listing KongServices referencing %s KongClusterPlugin: %w", name, err)
+	}
+
+	var kongRouteList configurationv1alpha1.KongRouteList
+	err = cl.List(ctx, &kongRouteList,
+		client.MatchingFields{
+			IndexFieldKongRouteOnReferencedClusterPluginNames: name,
+		},
+	)
+	if err != nil {
+		return ForeignRelations{}, fmt.Errorf("failed listing KongRoutes referencing %s KongClusterPlugin: %w", name, err)
+	}
+
+	var kongConsumerList configurationv1.KongConsumerList
+	err = cl.List(ctx, &kongConsumerList,
+		client.MatchingFields{
+			IndexFieldKongConsumerOnClusterPlugin: name,
+		},
+	)
+	if err != nil {
+		return ForeignRelations{}, fmt.Errorf("failed listing KongConsumers referencing %s KongClusterPlugin: %w", name, err)
+	}
+
+	var kongConsumerGroupList configurationv1beta1.KongConsumerGroupList
+	err = cl.List(ctx, &kongConsumerGroupList,
+		client.MatchingFields{
+			IndexFieldKongConsumerGroupOnClusterPlugin: name,
+		},
+	)
+	if err != nil {
+		return ForeignRelations{}, fmt.Errorf("failed listing KongConsumerGroups referencing %s KongClusterPlugin: %w", name, err)
+	}
+
+	// Cache the namespaces' KongPlugin lookups as entities are typically grouped in few namespaces.
+	shadowedInNamespace := make(map[string]bool)
+	isShadowed := func(namespace string) (bool, error) {
+		if shadowed, ok := shadowedInNamespace[namespace]; ok {
+			return shadowed, nil
+		}
+		var kongPlugin configurationv1.KongPlugin
+		err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &kongPlugin)
+		if client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed getting KongPlugin %s/%s: %w", namespace, name, err)
+		}
+		shadowedInNamespace[namespace] = err == nil
+		return err == nil, nil
+	}
+	var errShadowed error
+	usesClusterPlugin := func(obj client.Object) bool {
+		if !obj.GetDeletionTimestamp().IsZero() {
+			return false
+		}
+		shadowed, err := isShadowed(obj.GetNamespace())
+		if err != nil {
+			errShadowed = err
+			return false
+		}
+		return !shadowed
+	}
+
+	relations := ForeignRelations{
+		Service: lo.Filter(kongServiceList.Items,
+			func(s configurationv1alpha1.KongService, _ int) bool { return usesClusterPlugin(&s) },
+		),
+		Route: lo.Filter(kongRouteList.Items,
+			func(r configurationv1alpha1.KongRoute, _ int) bool { return usesClusterPlugin(&r) },
+		),
+		Consumer: lo.Filter(kongConsumerList.Items,
+			func(c configurationv1.KongConsumer, _ int) bool { return usesClusterPlugin(&c) },
+		),
+		ConsumerGroup: lo.Filter(kongConsumerGroupList.Items,
+			func(c configurationv1beta1.KongConsumerGroup, _ int) bool { return usesClusterPlugin(&c) },
+		),
+	}
+	if errShadowed != nil {
+		return ForeignRelations{}, errShadowed
+	}
+	return relations, nil
+}
@@ -0,0 +1,116 @@
+package konnect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kong/gateway-operator/modules/manager/scheme"
+	"github.com/kong/gateway-operator/pkg/consts"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+func TestKongClusterPluginReconciler(t *testing.T) {
+	kongService := func(namespace, name string) *configurationv1alpha1.KongService {
+		return &configurationv1alpha1.KongService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Annotations: map[string]string{
+					consts.PluginsAnnotationKey: "rate-limiting",
+				},
+			},
+			Spec: configurationv1alpha1.KongServiceSpec{
+				ControlPlaneRef: &configurationv1alpha1.ControlPlaneRef{
+					Type: configurationv1alpha1.ControlPlaneRefKonnectNamespacedRef,
+					KonnectNamespacedRef: &configurationv1alpha1.KonnectNamespacedRef{
+						Name: "cp",
+					},
+				},
+			},
+		}
+	}
+	kongClusterPlugin := &configurationv1.KongClusterPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "rate-limiting",
+			UID:  "cluster-plugin-uid",
+		},
+		PluginName: "rate-limiting",
+	}
+
+	builder := fake.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(
+			kongClusterPlugin,
+			kongService("ns-a", "svc-a"),
+			kongService("ns-b", "svc-b"),
+			// KongPlugin with the same name takes precedence in its namespace.
+			&configurationv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rate-limiting",
+					Namespace: "ns-b",
+				},
+				PluginName: "rate-limiting",
+			},
+		)
+	for _, opts := range [][]ReconciliationIndexOption{
+		IndexOptionsForKongPluginBinding(),
+		IndexOptionsForKongService(),
+		IndexOptionsForKongRoute(),
+		IndexOptionsForKongConsumer(),
+		IndexOptionsForKongConsumerGroup(),
+	} {
+		for _, opt := range opts {
+			builder = builder.WithIndex(opt.IndexObject, opt.IndexField, opt.ExtractValue)
+		}
+	}
+	cl := builder.Build()
+
+	r := NewKongClusterPluginReconciler(false, cl)
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(kongClusterPlugin)})
+	require.NoError(t, err)
+
+	var kpbs configurationv1alpha1.KongPluginBindingList
+	require.NoError(t, cl.List(context.Background(), &kpbs))
+	require.Len(t, kpbs.Items, 1)
+	kpb := kpbs.Items[0]
+	assert.Equal(t, "ns-a", kpb.Namespace)
+	assert.Equal(t, "rate-limiting", kpb.Spec.PluginReference.Name)
+	require.NotNil(t, kpb.Spec.PluginReference.Kind)
+	assert.Equal(t, "KongClusterPlugin", *kpb.Spec.PluginReference.Kind)
+	require.NotNil(t, kpb.Spec.Targets.ServiceReference)
+	assert.Equal(t, "svc-a", kpb.Spec.Targets.ServiceReference.Name)
+	require.NotNil(t, kpb.Spec.ControlPlaneRef)
+	assert.Equal(t, "cp", kpb.Spec.ControlPlaneRef.KonnectNamespacedRef.Name)
+	assert.True(t, ownerRefIsPlugin(kongClusterPlugin)(kpb.OwnerReferences[0]))
+
+	var plugin configurationv1.KongClusterPlugin
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(kongClusterPlugin), &plugin))
+	assert.True(t, controllerutil.ContainsFinalizer(&plugin, consts.PluginInUseFinalizer))
+
+	t.Run("KongPluginBinding is removed when the KongClusterPlugin is shadowed by a KongPlugin", func(t *testing.T) {
+		require.NoError(t, cl.Create(context.Background(), &configurationv1.KongPlugin{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rate-limiting",
+				Namespace: "ns-a",
+			},
+			PluginName: "rate-limiting",
+		}))
+
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(kongClusterPlugin)})
+		require.NoError(t, err)
+
+		var kpbs configurationv1alpha1.KongPluginBindingList
+		require.NoError(t, cl.List(context.Background(), &kpbs))
+		assert.Empty(t, kpbs.Items)
+	})
+}
@@ -121,16 +121,78 @@ func (r *KongPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	grouped, err := foreignRelations.GroupByControlPlane(ctx, r.client)
+	pluginReferenceFound, res, err := ensureKongPluginBindings(ctx, logger, r.client, &kongPlugin, foreignRelations, kongPluginBindingList.Items)
+	if err != nil || !res.IsZero() {
+		return res, err
+	}
+
+	if res, err := ensurePluginInUseFinalizer(ctx, logger, r.client, &kongPlugin, pluginReferenceFound); err != nil || !res.IsZero() {
+		return res, err
+	}
+
+	log.Debug(logger, "reconciliation completed", kongPlugin)
+	return ctrl.Result{}, nil
+}
+
+// ensurePluginInUseFinalizer adds the plugin in use finalizer on the provided KongPlugin
+// or KongClusterPlugin when it's used by any resource and removes it otherwise.
+// The plugin cannot be deleted until all objects that reference it are deleted
+// or do not reference it anymore.
+func ensurePluginInUseFinalizer(
+	ctx context.Context,
+	logger logr.Logger,
+	cl client.Client,
+	plugin client.Object,
+	inUse bool,
+) (ctrl.Result, error) {
+	if inUse {
+		if controllerutil.AddFinalizer(plugin, consts.PluginInUseFinalizer) {
+			if err := cl.Update(ctx, plugin); err != nil {
+				if k8serrors.IsConflict(err) {
+					return ctrl.Result{Requeue: true}, nil
+				}
+				return ctrl.Result{}, err
+			}
+			log.Debug(logger, pluginKind(plugin)+" finalizer added", plugin, "finalizer", consts.PluginInUseFinalizer)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if controllerutil.RemoveFinalizer(plugin, consts.PluginInUseFinalizer) {
+		if err := cl.Update(ctx, plugin); err != nil {
+			if k8serrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		log.Debug(logger, pluginKind(plugin)+" finalizer removed", plugin, "finalizer", consts.PluginInUseFinalizer)
+	}
+	return ctrl.Result{}, nil
+}
+
+// ensureKongPluginBindings ensures that managed KongPluginBindings exist for all the combinations
+// of the provided entities referencing the provided KongPlugin or KongClusterPlugin via the
+// konghq.com/plugins annotation. The KongPluginBindings are created in the namespaces of the
+// referencing entities. Managed KongPluginBindings which are not used anymore are deleted.
+// It returns true when the plugin is referenced by any entity or KongPluginBinding.
+func ensureKongPluginBindings(
+	ctx context.Context,
+	logger logr.Logger,
+	cl client.Client,
+	plugin client.Object,
+	foreignRelations ForeignRelations,
+	kongPluginBindings []configurationv1alpha1.KongPluginBinding,
+) (bool, ctrl.Result, error) {
+	grouped, err := foreignRelations.GroupByControlPlane(ctx, cl)
 	if err != nil {
-		return ctrl.Result{}, err
+		return false, ctrl.Result{}, err
 	}
 
 	groupedCombinations := grouped.GetCombinations()
 
 	// Delete the KongPluginBindings that are not used anymore.
-	if err := deleteUnusedKongPluginBindings(ctx, logger, clientWithNamespace, &kongPlugin, groupedCombinations, kongPluginBindingList.Items); err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed deleting unused KongPluginBindings for %s KongPlugin: %w", client.ObjectKeyFromObject(&kongPlugin), err)
+	if err := deleteUnusedKongPluginBindings(ctx, logger, cl, plugin, groupedCombinations, kongPluginBindings); err != nil {
+		return false, ctrl.Result{}, fmt.Errorf("failed deleting unused KongPluginBindings for %s %s: %w", pluginKind(plugin), client.ObjectKeyFromObject(plugin), err)
 	}
 
 	// pluginReferenceFound represents whether the plugin is referenced by any resource.
@@ -140,9 +202,8 @@ func (r *KongPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			pluginReferenceFound = true
 
 			builder := NewKongPluginBindingBuilder().
-				WithGenerateName(kongPlugin.Name + "-").
-				WithNamespace(kongPlugin.Namespace).
-				WithPluginRef(kongPlugin.Name).
+				WithGenerateName(plugin.GetName() + "-").
+				WithNamespace(cpNN.Namespace).
 				WithControlPlaneRef(&configurationv1alpha1.ControlPlaneRef{
 					Type: configurationv1alpha1.ControlPlaneRefKonnectNamespacedRef,
 					KonnectNamespacedRef: &configurationv1alpha1.KonnectNamespacedRef{
@@ -150,8 +211,15 @@ func (r *KongPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 						Name:      cpNN.Name,
 					},
 				})
+			if _, ok := plugin.(*configurationv1.KongClusterPlugin); ok {
+				builder.WithClusterPluginRef(plugin.GetName())
+			} else {
+				builder.WithPluginRef(plugin.GetName())
+			}
 
-			kpbList := kongPluginBindingList.Items
+			kpbList := lo.Filter(kongPluginBindings, func(pb configurationv1alpha1.KongPluginBinding, _ int) bool {
+				return pb.Namespace == cpNN.Namespace
+			})
 
 			if rel.Service != "" {
 				kpbList = lo.Filter(kpbList, func(pb configurationv1alpha1.KongPluginBinding, _ int) bool {
@@ -182,17 +250,17 @@ func (r *KongPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				builder.WithConsumerGroupTarget(rel.ConsumerGroup)
 			}
 
-			builder, err = builder.WithOwnerReference(&kongPlugin, clientWithNamespace.Scheme())
+			builder, err = builder.WithOwnerReference(plugin, cl.Scheme())
 			if err != nil {
-				return ctrl.Result{}, fmt.Errorf("failed to set owner reference: %w", err)
+				return false, ctrl.Result{}, fmt.Errorf("failed to set owner reference: %w", err)
 			}
 
 			kongPluginBinding := builder.Build()
 
 			switch len(kpbList) {
 			case 0:
-				if err = clientWithNamespace.Create(ctx, kongPluginBinding); err != nil {
-					return ctrl.Result{}, fmt.Errorf("failed to create KongPluginBinding: %w", err)
+				if err = cl.Create(ctx, kongPluginBinding); err != nil {
+					return false, ctrl.Result{}, fmt.Errorf("failed to create KongPluginBinding: %w", err)
 				}
 				log.Debug(logger, "Managed KongPluginBinding created", kongPluginBinding)
 
@@ -207,54 +275,32 @@ func (r *KongPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 				existing.Spec.Targets = kongPluginBinding.Spec.Targets
 
-				if err = clientWithNamespace.Update(ctx, &existing); err != nil {
+				if err = cl.Update(ctx, &existing); err != nil {
 					if k8serrors.IsConflict(err) {
-						return ctrl.Result{Requeue: true}, nil
+						return false, ctrl.Result{Requeue: true}, nil
 					}
-					return ctrl.Result{}, fmt.Errorf("failed to update KongPluginBinding: %w", err)
+					return false, ctrl.Result{}, fmt.Errorf("failed to update KongPluginBinding: %w", err)
 				}
 				log.Debug(logger, "Managed KongPluginBinding updated", kongPluginBinding)
 
 			default:
-				if err := k8sreduce.ReduceKongPluginBindings(ctx, clientWithNamespace, kpbList); err != nil {
-					return ctrl.Result{}, fmt.Errorf("failed to reduce KongPluginBindings: %w", err)
+				if err := k8sreduce.ReduceKongPluginBindings(ctx, cl, kpbList); err != nil {
+					return false, ctrl.Result{}, fmt.Errorf("failed to reduce KongPluginBindings: %w", err)
 				}
 			}
 
 		}
 	}
 
-	pluginReferenceFound = pluginReferenceFound || len(kongPluginBindingList.Items) > 0
+	return pluginReferenceFound || len(kongPluginBindings) > 0, ctrl.Result{}, nil
+}
 
-	// If an entity is using the plugin, add a finalizer on the plugin.
-	// The KongPlugin cannot be deleted until all objects that reference it are
-	// deleted or do not reference it anymore.
-	if pluginReferenceFound {
-		if controllerutil.AddFinalizer(&kongPlugin, consts.PluginInUseFinalizer) {
-			if err = r.client.Update(ctx, &kongPlugin); err != nil {
-				if k8serrors.IsConflict(err) {
-					return ctrl.Result{Requeue: true}, nil
-				}
-				return ctrl.Result{}, err
-			}
-			log.Debug(logger, "KongPlugin finalizer added", kongPlugin, "finalizer", consts.PluginInUseFinalizer)
-			return ctrl.Result{}, nil
-		}
-	} else {
-		if controllerutil.RemoveFinalizer(&kongPlugin, consts.PluginInUseFinalizer) {
-			if err = r.client.Update(ctx, &kongPlugin); err != nil {
-				if k8serrors.IsConflict(err) {
-					return ctrl.Result{Requeue: true}, nil
-				}
-				return ctrl.Result{}, err
-			}
-			log.Debug(logger, "KongPlugin finalizer removed", kongPlugin, "finalizer", consts.PluginInUseFinalizer)
-			return ctrl.Result{}, nil
-		}
+// pluginKind returns the kind of the provided KongPlugin or KongClusterPlugin.
+func pluginKind(plugin client.Object) string {
+	if _, ok := plugin.(*configurationv1.KongClusterPlugin); ok {
+		return "KongClusterPlugin"
 	}
-
-	log.Debug(logger, "reconciliation completed", kongPlugin)
-	return ctrl.Result{}, nil
+	return "KongPlugin"
 }
 
 func listAllEntitiesReferencingPluginIntoRelations(
@@ -341,19 +387,19 @@ func deleteKongPluginBindings(
 	return nil
 }
 
-func ownerRefIsPlugin(kongPlugin *configurationv1.KongPlugin) func(ownerRef metav1.OwnerReference) bool {
+func ownerRefIsPlugin(plugin client.Object) func(ownerRef metav1.OwnerReference) bool {
 	return func(ownerRef metav1.OwnerReference) bool {
-		return ownerRef.Kind == "KongPlugin" &&
-			ownerRef.Name == kongPlugin.Name &&
-			ownerRef.UID == kongPlugin.UID
+		return ownerRef.Kind == pluginKind(plugin) &&
+			ownerRef.Name == plugin.GetName() &&
+			ownerRef.UID == plugin.GetUID()
 	}
 }
 
 func deleteUnusedKongPluginBindings(
 	ctx context.Context,
 	logger logr.Logger,
-	clientWithNamespace client.Client,
-	kongPlugin *configurationv1.KongPlugin,
+	cl client.Client,
+	plugin client.Object,
 	groupedCombinations map[types.NamespacedName][]Rel,
 	kongPluginBindings []configurationv1alpha1.KongPluginBinding,
 ) error {
@@ -365,7 +411,7 @@ func deleteUnusedKongPluginBindings(
 		}
 
 		// If the KongPluginBinding is unmanaged (created not using an annotation), skip it, do not delete it.
-		if !lo.ContainsBy(pb.OwnerReferences, ownerRefIsPlugin(kongPlugin)) {
+		if !lo.ContainsBy(pb.OwnerReferences, ownerRefIsPlugin(plugin)) {
 			continue
 		}
 
@@ -373,6 +419,7 @@ func deleteUnusedKongPluginBindings(
 		if !ok {
 			continue
 		}
+		clientWithNamespace := client.NewNamespacedClient(cl, pb.Namespace)
 
 		// If a ControlPlane this KongPluginBinding references, is not found, delete the it.
 		combinations, ok := groupedCombinations[types.NamespacedName{
@@ -418,9 +465,9 @@ func deleteUnusedKongPluginBindings(
 			r, routeExists := getIfRefNotNil[*configurationv1alpha1.KongRoute](ctx, clientWithNamespace, routeRef)
 			c, consumerExists := getIfRefNotNil[*configurationv1.KongConsumer](ctx, clientWithNamespace, consumerRef)
 			if !consumerExists || !serviceExists || !routeExists ||
-				!objHasPluginConfigured(c, kongPlugin.Name) || !c.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(s, kongPlugin.Name) || !s.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(r, kongPlugin.Name) || !r.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(c, plugin.GetName()) || !c.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(s, plugin.GetName()) || !s.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(r, plugin.GetName()) || !r.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -447,9 +494,9 @@ func deleteUnusedKongPluginBindings(
 			r, routeExists := getIfRefNotNil[*configurationv1alpha1.KongRoute](ctx, clientWithNamespace, routeRef)
 			cg, consumerGroupExists := getIfRefNotNil[*configurationv1beta1.KongConsumerGroup](ctx, clientWithNamespace, consumerGroupRef)
 			if !consumerGroupExists || !serviceExists || !routeExists ||
-				!objHasPluginConfigured(cg, kongPlugin.Name) || !cg.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(s, kongPlugin.Name) || !s.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(r, kongPlugin.Name) || !r.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(cg, plugin.GetName()) || !cg.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(s, plugin.GetName()) || !s.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(r, plugin.GetName()) || !r.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -475,8 +522,8 @@ func deleteUnusedKongPluginBindings(
 			r, routeExists := getIfRefNotNil[*configurationv1alpha1.KongRoute](ctx, clientWithNamespace, routeRef)
 			c, consumerExists := getIfRefNotNil[*configurationv1.KongConsumer](ctx, clientWithNamespace, consumerRef)
 			if !consumerExists || !routeExists ||
-				!objHasPluginConfigured(c, kongPlugin.Name) || !c.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(r, kongPlugin.Name) || !r.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(c, plugin.GetName()) || !c.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(r, plugin.GetName()) || !r.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -502,8 +549,8 @@ func deleteUnusedKongPluginBindings(
 			s, serviceExists := getIfRefNotNil[*configurationv1alpha1.KongService](ctx, clientWithNamespace, serviceRef)
 			c, consumerExists := getIfRefNotNil[*configurationv1.KongConsumer](ctx, clientWithNamespace, consumerRef)
 			if !consumerExists || !serviceExists ||
-				!objHasPluginConfigured(c, kongPlugin.Name) || !c.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(s, kongPlugin.Name) || !s.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(c, plugin.GetName()) || !c.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(s, plugin.GetName()) || !s.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -529,8 +576,8 @@ func deleteUnusedKongPluginBindings(
 			r, routeExists := getIfRefNotNil[*configurationv1alpha1.KongRoute](ctx, clientWithNamespace, routeRef)
 			cg, consumerGroupExists := getIfRefNotNil[*configurationv1beta1.KongConsumerGroup](ctx, clientWithNamespace, consumerGroupRef)
 			if !consumerGroupExists || !routeExists ||
-				!objHasPluginConfigured(cg, kongPlugin.Name) || !cg.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(r, kongPlugin.Name) || !r.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(cg, plugin.GetName()) || !cg.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(r, plugin.GetName()) || !r.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -556,8 +603,8 @@ func deleteUnusedKongPluginBindings(
 			s, serviceExists := getIfRefNotNil[*configurationv1alpha1.KongService](ctx, clientWithNamespace, serviceRef)
 			cg, consumerGroupExists := getIfRefNotNil[*configurationv1beta1.KongConsumerGroup](ctx, clientWithNamespace, consumerGroupRef)
 			if !consumerGroupExists || !serviceExists ||
-				!objHasPluginConfigured(cg, kongPlugin.Name) || !cg.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(s, kongPlugin.Name) || !s.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(cg, plugin.GetName()) || !cg.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(s, plugin.GetName()) || !s.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -583,8 +630,8 @@ func deleteUnusedKongPluginBindings(
 			s, serviceExists := getIfRefNotNil[*configurationv1alpha1.KongService](ctx, clientWithNamespace, serviceRef)
 			r, routeExists := getIfRefNotNil[*configurationv1alpha1.KongRoute](ctx, clientWithNamespace, routeRef)
 			if !serviceExists || !routeExists ||
-				!objHasPluginConfigured(s, kongPlugin.Name) || !s.DeletionTimestamp.IsZero() ||
-				!objHasPluginConfigured(r, kongPlugin.Name) || !r.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(s, plugin.GetName()) || !s.DeletionTimestamp.IsZero() ||
+				!objHasPluginConfigured(r, plugin.GetName()) || !r.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -609,7 +656,7 @@ func deleteUnusedKongPluginBindings(
 
 			s, serviceExists := getIfRefNotNil[*configurationv1alpha1.KongService](ctx, clientWithNamespace, serviceRef)
 			if !serviceExists ||
-				!objHasPluginConfigured(s, kongPlugin.Name) || !s.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(s, plugin.GetName()) || !s.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -634,7 +681,7 @@ func deleteUnusedKongPluginBindings(
 
 			r, routeExists := getIfRefNotNil[*configurationv1alpha1.KongRoute](ctx, clientWithNamespace, routeRef)
 			if !routeExists ||
-				!objHasPluginConfigured(r, kongPlugin.Name) || !r.DeletionTimestamp.IsZero() {
+				!objHasPluginConfigured(r, plugin.GetName()) || !r.DeletionTimestamp.IsZero() {
 				pluginBindingsToDelete[client.ObjectKeyFromObject(&pb)] = pb
 				continue
 			}
@@ -642,7 +689,7 @@ func deleteUnusedKongPluginBindings(
 
 	}
 
-	if err := deleteKongPluginBindings(ctx, logger, clientWithNamespace, pluginBindingsToDelete); err != nil {
+	if err := deleteKongPluginBindings(ctx, logger, cl, pluginBindingsToDelete); err != nil {
 		return err
 	}
 
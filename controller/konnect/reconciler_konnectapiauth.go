@@ -2,6 +2,7 @@ package konnect
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,14 +10,17 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	konnectconsts "github.com/kong/gateway-operator/controller/konnect/consts"
 	"github.com/kong/gateway-operator/controller/konnect/ops"
 	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/pkg/consts"
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 
 	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
@@ -24,9 +28,23 @@ import (
 
 // KonnectAPIAuthConfigurationReconciler reconciles a KonnectAPIAuthConfiguration object.
 type KonnectAPIAuthConfigurationReconciler struct {
-	sdkFactory      ops.SDKFactory
-	developmentMode bool
-	client          client.Client
+	sdkFactory               ops.SDKFactory
+	developmentMode          bool
+	client                   client.Client
+	eventRecorder            record.EventRecorder
+	tokenExpiryWarningPeriod time.Duration
+}
+
+// KonnectAPIAuthConfigurationReconcilerOption is a functional option for the
+// KonnectAPIAuthConfigurationReconciler.
+type KonnectAPIAuthConfigurationReconcilerOption func(*KonnectAPIAuthConfigurationReconciler)
+
+// WithTokenExpiryWarningPeriod sets the period before the token's expiry in which
+// the reconciler sets the TokenExpiring condition and emits warning events.
+func WithTokenExpiryWarningPeriod(d time.Duration) KonnectAPIAuthConfigurationReconcilerOption {
+	return func(r *KonnectAPIAuthConfigurationReconciler) {
+		r.tokenExpiryWarningPeriod = d
+	}
 }
 
 const (
@@ -45,12 +63,18 @@ func NewKonnectAPIAuthConfigurationReconciler(
 	sdkFactory ops.SDKFactory,
 	developmentMode bool,
 	client client.Client,
+	opts ...KonnectAPIAuthConfigurationReconcilerOption,
 ) *KonnectAPIAuthConfigurationReconciler {
-	return &KonnectAPIAuthConfigurationReconciler{
-		sdkFactory:      sdkFactory,
-		developmentMode: developmentMode,
-		client:          client,
+	r := &KonnectAPIAuthConfigurationReconciler{
+		sdkFactory:               sdkFactory,
+		developmentMode:          developmentMode,
+		client:                   client,
+		tokenExpiryWarningPeriod: consts.DefaultKonnectAPITokenExpiryWarningPeriod,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -66,6 +90,8 @@ func (r *KonnectAPIAuthConfigurationReconciler) SetupWithManager(ctx context.Con
 		return fmt.Errorf("failed to create Secret label selector predicate: %w", err)
 	}
 
+	r.eventRecorder = mgr.GetEventRecorderFor("konnectapiauthconfiguration")
+
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(&konnectv1alpha1.KonnectAPIAuthConfiguration{}).
 		Watches(
@@ -112,7 +138,7 @@ func (r *KonnectAPIAuthConfigurationReconciler) Reconcile(
 		return ctrl.Result{}, nil
 	}
 
-	token, err := getTokenFromKonnectAPIAuthConfiguration(ctx, r.client, &apiAuth)
+	token, tokenSource, err := getTokenAndSourceFromKonnectAPIAuthConfiguration(ctx, r.client, &apiAuth)
 	if err != nil {
 		if res, errStatus := updateStatusWithCondition(
 			ctx, r.client, &apiAuth,
@@ -130,6 +156,7 @@ func (r *KonnectAPIAuthConfigurationReconciler) Reconcile(
 	sdk := r.sdkFactory.NewKonnectSDK(
 		serverURL.String(),
 		ops.SDKToken(token),
+		apiAuth.Status.OrganizationID,
 	)
 
 	// TODO(pmalek): check if api auth config has a valid status condition
@@ -143,6 +170,10 @@ func (r *KonnectAPIAuthConfigurationReconciler) Reconcile(
 	// https://github.com/Kong/sdk-konnect-go/blob/999d9a987e1aa7d2e09ac11b1450f4563adf21ea/models/operations/getorganizationsme.go#L10-L12
 	respOrg, err := sdk.GetMeSDK().GetOrganizationsMe(ctx, sdkkonnectops.WithServerURL(serverURL.String()))
 	if err != nil {
+		// Throttled requests don't say anything about the token's validity.
+		if errors.As(err, &ops.RateLimitedError{}) {
+			return requeueIfRateLimited(ctx, ctrl.Result{}, err)
+		}
 		logger.Error(err, "failed to get organization info from Konnect")
 		if cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityAPIAuthConfigurationValidConditionType, &apiAuth); !ok ||
 			cond.Status != metav1.ConditionFalse ||
@@ -164,9 +195,9 @@ func (r *KonnectAPIAuthConfigurationReconciler) Reconcile(
 			if errUpdate != nil || !res.IsZero() {
 				return res, errUpdate
 			}
-			return ctrl.Result{}, nil
 		}
-		return ctrl.Result{}, nil
+		// The token might be invalid because it expired so check its expiry to report it.
+		return r.ensureTokenExpiryCondition(ctx, logger, &apiAuth, tokenSource, sdk, serverURL, time.Now())
 	}
 
 	// Update the status only if it would change to prevent unnecessary updates.
@@ -202,19 +233,28 @@ func (r *KonnectAPIAuthConfigurationReconciler) Reconcile(
 		if err != nil || !res.IsZero() {
 			return res, err
 		}
-		return ctrl.Result{}, nil
 	}
 
-	return ctrl.Result{}, nil
+	return r.ensureTokenExpiryCondition(ctx, logger, &apiAuth, tokenSource, sdk, serverURL, time.Now())
 }
 
 // getTokenFromKonnectAPIAuthConfiguration returns the token from the secret reference or the token field.
 func getTokenFromKonnectAPIAuthConfiguration(
 	ctx context.Context, cl client.Client, apiAuth *konnectv1alpha1.KonnectAPIAuthConfiguration,
 ) (string, error) {
+	token, _, err := getTokenAndSourceFromKonnectAPIAuthConfiguration(ctx, cl, apiAuth)
+	return token, err
+}
+
+// getTokenAndSourceFromKonnectAPIAuthConfiguration returns the token from the secret reference
+// or the token field, along with the object holding it: the referenced Secret or
+// the KonnectAPIAuthConfiguration itself.
+func getTokenAndSourceFromKonnectAPIAuthConfiguration(
+	ctx context.Context, cl client.Client, apiAuth *konnectv1alpha1.KonnectAPIAuthConfiguration,
+) (string, client.Object, error) {
 	switch apiAuth.Spec.Type {
 	case konnectv1alpha1.KonnectAPIAuthTypeToken:
-		return apiAuth.Spec.Token, nil
+		return apiAuth.Spec.Token, apiAuth, nil
 	case konnectv1alpha1.KonnectAPIAuthTypeSecretRef:
 		nn := types.NamespacedName{
 			Namespace: apiAuth.Spec.SecretRef.Namespace,
@@ -226,19 +266,23 @@ func getTokenFromKonnectAPIAuthConfiguration(
 
 		var secret corev1.Secret
 		if err := cl.Get(ctx, nn, &secret); err != nil {
-			return "", fmt.Errorf("failed to get Secret %s: %w", nn, err)
+			return "", nil, fmt.Errorf("failed to get Secret %s: %w", nn, err)
 		}
 		if secret.Labels == nil || secret.Labels[SecretCredentialLabel] != SecretCredentialLabelValueKonnect {
-			return "", fmt.Errorf("secret %s does not have label %s: %s", nn, SecretCredentialLabel, SecretCredentialLabelValueKonnect)
+			return "", nil, fmt.Errorf("secret %s does not have label %s: %s", nn, SecretCredentialLabel, SecretCredentialLabelValueKonnect)
 		}
 		if secret.Data == nil {
-			return "", fmt.Errorf("secret %s has no data", nn)
+			return "", nil, fmt.Errorf("secret %s has no data", nn)
+		}
+		key := SecretTokenKey
+		if k, ok := apiAuth.GetAnnotations()[konnectconsts.AnnotationTokenSecretKey]; ok && k != "" {
+			key = k
 		}
-		if _, ok := secret.Data[SecretTokenKey]; !ok {
-			return "", fmt.Errorf("secret %s does not have key %s", nn, SecretTokenKey)
+		if _, ok := secret.Data[key]; !ok {
+			return "", nil, fmt.Errorf("secret %s does not have key %s", nn, key)
 		}
-		return string(secret.Data[SecretTokenKey]), nil
+		return string(secret.Data[key]), &secret, nil
 	}
 
-	return "", fmt.Errorf("unknown KonnectAPIAuthType: %s", apiAuth.Spec.Type)
+	return "", nil, fmt.Errorf("unknown KonnectAPIAuthType: %s", apiAuth.Spec.Type)
 }
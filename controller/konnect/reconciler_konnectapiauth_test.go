@@ -63,6 +63,39 @@ func TestGetTokenFromKonnectAPIAuthConfiguration(t *testing.T) {
 			},
 			expectedToken: "test-token",
 		},
+		{
+			name: "valid Secret Reference with custom token key",
+			apiAuth: &konnectv1alpha1.KonnectAPIAuthConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-api-auth",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"konnect.konghq.com/token-secret-key": "rotated-token",
+					},
+				},
+				Spec: konnectv1alpha1.KonnectAPIAuthConfigurationSpec{
+					Type: konnectv1alpha1.KonnectAPIAuthTypeSecretRef,
+					SecretRef: &corev1.SecretReference{
+						Name:      "test-secret",
+						Namespace: "default",
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-secret",
+					Namespace: "default",
+					Labels: map[string]string{
+						"konghq.com/credential": "konnect",
+					},
+				},
+				Data: map[string][]byte{
+					"token":         []byte("test-token"),
+					"rotated-token": []byte("rotated-test-token"),
+				},
+			},
+			expectedToken: "rotated-test-token",
+		},
 		{
 			name: "Secret is missing konghq.com/credential=konnect label",
 			apiAuth: &konnectv1alpha1.KonnectAPIAuthConfiguration{
@@ -0,0 +1,183 @@
+package konnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konnectconsts "github.com/kong/gateway-operator/controller/konnect/consts"
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+// ensureTokenExpiryCondition sets the TokenExpiring condition on the provided
+// KonnectAPIAuthConfiguration based on the expiry of its token and emits a warning
+// event when the token starts to expire soon or expires.
+// The token's expiry is read from the annotations of the object holding the token
+// or from the system account access token metadata in Konnect.
+// When the expiry is unknown, the condition is removed.
+func (r *KonnectAPIAuthConfigurationReconciler) ensureTokenExpiryCondition(
+	ctx context.Context,
+	logger logr.Logger,
+	apiAuth *konnectv1alpha1.KonnectAPIAuthConfiguration,
+	tokenSource client.Object,
+	sdk ops.SDKWrapper,
+	serverURL ops.ServerURL,
+	now time.Time,
+) (ctrl.Result, error) {
+	var (
+		cond         metav1.Condition
+		requeueAfter time.Duration
+	)
+	expiresAt, err := getTokenExpiry(ctx, tokenSource, sdk, serverURL)
+	switch {
+	case err != nil:
+		if errors.As(err, &ops.RateLimitedError{}) {
+			return requeueIfRateLimited(ctx, ctrl.Result{}, err)
+		}
+		log.Debug(logger, "failed to get the token's expiry", apiAuth, "error", err.Error())
+		cond = k8sutils.NewConditionWithGeneration(
+			consts.KonnectAPIAuthTokenExpiringType,
+			metav1.ConditionUnknown,
+			consts.KonnectAPIAuthTokenExpiryUnknownReason,
+			err.Error(),
+			apiAuth.GetGeneration(),
+		)
+	case expiresAt == nil:
+		if !k8sutils.RemoveCondition(consts.KonnectAPIAuthTokenExpiringType, apiAuth) {
+			return ctrl.Result{}, nil
+		}
+		if err := r.client.Status().Update(ctx, apiAuth); err != nil {
+			if k8serrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to remove %s condition: %w", consts.KonnectAPIAuthTokenExpiringType, err)
+		}
+		return ctrl.Result{}, nil
+	default:
+		cond, requeueAfter = tokenExpiringCondition(apiAuth, *expiresAt, now, r.tokenExpiryWarningPeriod)
+	}
+
+	old, hadCondition := k8sutils.GetCondition(consts.KonnectAPIAuthTokenExpiringType, apiAuth)
+	updated := apiAuth.DeepCopy()
+	k8sutils.SetCondition(cond, updated)
+	if !k8sutils.NeedsUpdate(apiAuth, updated) {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	res, err := updateStatusWithCondition(
+		ctx, r.client, apiAuth,
+		consts.KonnectAPIAuthTokenExpiringType,
+		cond.Status,
+		consts.ConditionReason(cond.Reason),
+		cond.Message,
+	)
+	if err != nil || !res.IsZero() {
+		return res, err
+	}
+
+	// Emit the event only when the token enters a new state to not flood
+	// the events with the same warning on every reconciliation.
+	if cond.Status == metav1.ConditionTrue &&
+		(!hadCondition || old.Status != cond.Status || old.Reason != cond.Reason) {
+		reason := "KonnectAPITokenExpiring"
+		if cond.Reason == string(consts.KonnectAPIAuthTokenExpiredReason) {
+			reason = "KonnectAPITokenExpired"
+		}
+		r.eventRecorder.Event(apiAuth, corev1.EventTypeWarning, reason, cond.Message)
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// tokenExpiringCondition returns the TokenExpiring condition for a token expiring
+// at the provided time, along with the duration after which the condition changes.
+// The returned duration is 0 when the token has already expired.
+func tokenExpiringCondition(
+	apiAuth *konnectv1alpha1.KonnectAPIAuthConfiguration,
+	expiresAt time.Time,
+	now time.Time,
+	warningPeriod time.Duration,
+) (metav1.Condition, time.Duration) {
+	var (
+		status       metav1.ConditionStatus
+		reason       consts.ConditionReason
+		message      string
+		requeueAfter time.Duration
+		expiry       = expiresAt.UTC().Format(time.RFC3339)
+	)
+	switch {
+	case !now.Before(expiresAt):
+		status = metav1.ConditionTrue
+		reason = consts.KonnectAPIAuthTokenExpiredReason
+		message = fmt.Sprintf("Token expired at %s", expiry)
+	case expiresAt.Sub(now) <= warningPeriod:
+		status = metav1.ConditionTrue
+		reason = consts.KonnectAPIAuthTokenExpiringSoonReason
+		message = fmt.Sprintf("Token expires at %s", expiry)
+		requeueAfter = expiresAt.Sub(now)
+	default:
+		status = metav1.ConditionFalse
+		reason = consts.KonnectAPIAuthTokenNotExpiringSoonReason
+		message = fmt.Sprintf("Token expires at %s", expiry)
+		requeueAfter = expiresAt.Add(-warningPeriod).Sub(now)
+	}
+
+	return k8sutils.NewConditionWithGeneration(
+		consts.KonnectAPIAuthTokenExpiringType,
+		status,
+		reason,
+		message,
+		apiAuth.GetGeneration(),
+	), requeueAfter
+}
+
+// getTokenExpiry returns the expiry time of the token held by the provided object.
+// It is read from the object's AnnotationTokenExpiresAt annotation or, when the object
+// has the system account annotations, from the system account access token metadata
+// in Konnect. It returns nil when the token's expiry is unknown.
+func getTokenExpiry(
+	ctx context.Context,
+	tokenSource client.Object,
+	sdk ops.SDKWrapper,
+	serverURL ops.ServerURL,
+) (*time.Time, error) {
+	annotations := tokenSource.GetAnnotations()
+	if v, ok := annotations[konnectconsts.AnnotationTokenExpiresAt]; ok {
+		expiresAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s annotation: %w", konnectconsts.AnnotationTokenExpiresAt, err)
+		}
+		return &expiresAt, nil
+	}
+
+	accountID := annotations[konnectconsts.AnnotationSystemAccountID]
+	tokenID := annotations[konnectconsts.AnnotationSystemAccountTokenID]
+	if accountID == "" || tokenID == "" {
+		return nil, nil
+	}
+
+	resp, err := sdk.GetSystemAccountAccessTokensSDK().GetSystemAccountsIDAccessTokensID(
+		ctx, accountID, tokenID, sdkkonnectops.WithServerURL(serverURL.String()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token %s of system account %s: %w", tokenID, accountID, err)
+	}
+	if resp == nil || resp.SystemAccountAccessToken == nil {
+		return nil, fmt.Errorf("failed to get access token %s of system account %s: got empty response", tokenID, accountID)
+	}
+	return resp.SystemAccountAccessToken.GetExpiresAt(), nil
+}
@@ -0,0 +1,206 @@
+package konnect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func TestTokenExpiringCondition(t *testing.T) {
+	var (
+		now           = time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+		warningPeriod = 7 * 24 * time.Hour
+		apiAuth       = &konnectv1alpha1.KonnectAPIAuthConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Generation: 2,
+			},
+		}
+	)
+
+	testCases := []struct {
+		name                 string
+		expiresAt            time.Time
+		expectedStatus       metav1.ConditionStatus
+		expectedReason       consts.ConditionReason
+		expectedMessage      string
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:                 "token not expiring soon",
+			expiresAt:            now.Add(30 * 24 * time.Hour),
+			expectedStatus:       metav1.ConditionFalse,
+			expectedReason:       consts.KonnectAPIAuthTokenNotExpiringSoonReason,
+			expectedMessage:      "Token expires at 2024-10-31T00:00:00Z",
+			expectedRequeueAfter: 23 * 24 * time.Hour,
+		},
+		{
+			name:                 "token expiring soon",
+			expiresAt:            now.Add(24 * time.Hour),
+			expectedStatus:       metav1.ConditionTrue,
+			expectedReason:       consts.KonnectAPIAuthTokenExpiringSoonReason,
+			expectedMessage:      "Token expires at 2024-10-02T00:00:00Z",
+			expectedRequeueAfter: 24 * time.Hour,
+		},
+		{
+			name:            "token expired",
+			expiresAt:       now.Add(-time.Hour),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  consts.KonnectAPIAuthTokenExpiredReason,
+			expectedMessage: "Token expired at 2024-09-30T23:00:00Z",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cond, requeueAfter := tokenExpiringCondition(apiAuth, tc.expiresAt, now, warningPeriod)
+			assert.Equal(t, string(consts.KonnectAPIAuthTokenExpiringType), cond.Type)
+			assert.Equal(t, tc.expectedStatus, cond.Status)
+			assert.Equal(t, string(tc.expectedReason), cond.Reason)
+			assert.Equal(t, tc.expectedMessage, cond.Message)
+			assert.Equal(t, apiAuth.Generation, cond.ObservedGeneration)
+			assert.Equal(t, tc.expectedRequeueAfter, requeueAfter)
+		})
+	}
+}
+
+func TestEnsureTokenExpiryCondition(t *testing.T) {
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	newAPIAuth := func(annotations map[string]string) *konnectv1alpha1.KonnectAPIAuthConfiguration {
+		return &konnectv1alpha1.KonnectAPIAuthConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "api-auth",
+				Namespace:   "default",
+				Annotations: annotations,
+			},
+			Spec: konnectv1alpha1.KonnectAPIAuthConfigurationSpec{
+				Type:  konnectv1alpha1.KonnectAPIAuthTypeToken,
+				Token: "kpat_xxxxxxxxxxxx",
+			},
+		}
+	}
+	newReconciler := func(t *testing.T, apiAuth *konnectv1alpha1.KonnectAPIAuthConfiguration) (*KonnectAPIAuthConfigurationReconciler, *record.FakeRecorder) {
+		scheme := runtime.NewScheme()
+		require.NoError(t, konnectv1alpha1.AddToScheme(scheme))
+		cl := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(apiAuth).
+			WithStatusSubresource(apiAuth).
+			Build()
+		r := NewKonnectAPIAuthConfigurationReconciler(nil, false, cl, WithTokenExpiryWarningPeriod(7*24*time.Hour))
+		recorder := record.NewFakeRecorder(10)
+		r.eventRecorder = recorder
+		return r, recorder
+	}
+	getCondition := func(t *testing.T, r *KonnectAPIAuthConfigurationReconciler, apiAuth *konnectv1alpha1.KonnectAPIAuthConfiguration) (metav1.Condition, bool) {
+		var current konnectv1alpha1.KonnectAPIAuthConfiguration
+		require.NoError(t, r.client.Get(context.Background(), client.ObjectKeyFromObject(apiAuth), &current))
+		return k8sutils.GetCondition(consts.KonnectAPIAuthTokenExpiringType, &current)
+	}
+
+	t.Run("expiry from annotation sets the condition and emits an event once", func(t *testing.T) {
+		apiAuth := newAPIAuth(map[string]string{
+			"konnect.konghq.com/token-expires-at": "2024-10-03T00:00:00Z",
+		})
+		r, recorder := newReconciler(t, apiAuth)
+
+		res, err := r.ensureTokenExpiryCondition(context.Background(), logr.Discard(), apiAuth, apiAuth, nil, "", now)
+		require.NoError(t, err)
+		assert.Equal(t, 48*time.Hour, res.RequeueAfter)
+
+		cond, ok := getCondition(t, r, apiAuth)
+		require.True(t, ok)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, string(consts.KonnectAPIAuthTokenExpiringSoonReason), cond.Reason)
+		require.Len(t, recorder.Events, 1)
+		assert.Contains(t, <-recorder.Events, "KonnectAPITokenExpiring")
+
+		_, err = r.ensureTokenExpiryCondition(context.Background(), logr.Discard(), apiAuth, apiAuth, nil, "", now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, recorder.Events, "no event should be emitted when the state does not change")
+
+		_, err = r.ensureTokenExpiryCondition(context.Background(), logr.Discard(), apiAuth, apiAuth, nil, "", now.Add(72*time.Hour))
+		require.NoError(t, err)
+		cond, ok = getCondition(t, r, apiAuth)
+		require.True(t, ok)
+		assert.Equal(t, string(consts.KonnectAPIAuthTokenExpiredReason), cond.Reason)
+		require.Len(t, recorder.Events, 1)
+		assert.Contains(t, <-recorder.Events, "KonnectAPITokenExpired")
+	})
+
+	t.Run("expiry from system account access token", func(t *testing.T) {
+		apiAuth := newAPIAuth(map[string]string{
+			"konnect.konghq.com/system-account-id":       "account-id",
+			"konnect.konghq.com/system-account-token-id": "token-id",
+		})
+		r, recorder := newReconciler(t, apiAuth)
+		sdk := ops.NewMockSDKWrapperWithT(t)
+		sdk.SystemAccountAccessTokensSDK.EXPECT().
+			GetSystemAccountsIDAccessTokensID(mock.Anything, "account-id", "token-id", mock.Anything).
+			Return(&sdkkonnectops.GetSystemAccountsIDAccessTokensIDResponse{
+				SystemAccountAccessToken: &sdkkonnectcomp.SystemAccountAccessToken{
+					ExpiresAt: lo.ToPtr(now.Add(30 * 24 * time.Hour)),
+				},
+			}, nil)
+
+		_, err := r.ensureTokenExpiryCondition(context.Background(), logr.Discard(), apiAuth, apiAuth, sdk, "https://us.api.konghq.com", now)
+		require.NoError(t, err)
+		cond, ok := getCondition(t, r, apiAuth)
+		require.True(t, ok)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(consts.KonnectAPIAuthTokenNotExpiringSoonReason), cond.Reason)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("invalid annotation sets unknown condition", func(t *testing.T) {
+		apiAuth := newAPIAuth(map[string]string{
+			"konnect.konghq.com/token-expires-at": "tomorrow",
+		})
+		r, _ := newReconciler(t, apiAuth)
+
+		_, err := r.ensureTokenExpiryCondition(context.Background(), logr.Discard(), apiAuth, apiAuth, nil, "", now)
+		require.NoError(t, err)
+		cond, ok := getCondition(t, r, apiAuth)
+		require.True(t, ok)
+		assert.Equal(t, metav1.ConditionUnknown, cond.Status)
+		assert.Equal(t, string(consts.KonnectAPIAuthTokenExpiryUnknownReason), cond.Reason)
+	})
+
+	t.Run("unknown expiry removes the condition", func(t *testing.T) {
+		apiAuth := newAPIAuth(nil)
+		k8sutils.SetCondition(
+			k8sutils.NewConditionWithGeneration(
+				consts.KonnectAPIAuthTokenExpiringType,
+				metav1.ConditionTrue,
+				consts.KonnectAPIAuthTokenExpiringSoonReason,
+				"",
+				apiAuth.GetGeneration(),
+			),
+			apiAuth,
+		)
+		r, _ := newReconciler(t, apiAuth)
+
+		_, err := r.ensureTokenExpiryCondition(context.Background(), logr.Discard(), apiAuth, apiAuth, nil, "", now)
+		require.NoError(t, err)
+		_, ok := getCondition(t, r, apiAuth)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,100 @@
+package konnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/kong/gateway-operator/controller/konnect/constraints"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/pkg/annotations"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+)
+
+// kongPluginCreatedOrDeletedPredicate filters out KongPlugin updates as only
+// their existence affects KongClusterPlugins with the same name.
+var kongPluginCreatedOrDeletedPredicate = predicate.Funcs{
+	UpdateFunc: func(event.TypedUpdateEvent[client.Object]) bool {
+		return false
+	},
+}
+
+// mapClusterPluginsFromAnnotation enqueue requests for KongClusterPlugins based on
+// provided object's annotations.
+func mapClusterPluginsFromAnnotation[
+	T interface {
+		configurationv1alpha1.KongService |
+			configurationv1alpha1.KongRoute |
+			configurationv1.KongConsumer |
+			configurationv1beta1.KongConsumerGroup
+		GetTypeName() string
+	},
+](devMode bool) func(ctx context.Context, obj client.Object) []ctrl.Request {
+	return func(ctx context.Context, obj client.Object) []ctrl.Request {
+		_, ok := any(obj).(*T)
+		if !ok {
+			entityTypeName := constraints.EntityTypeName[T]()
+			logger := log.GetLogger(ctx, entityTypeName, devMode)
+			log.Error(logger,
+				fmt.Errorf("cannot cast object to %s", entityTypeName),
+				fmt.Sprintf("%s mapping handler", entityTypeName), obj,
+			)
+			return []ctrl.Request{}
+		}
+
+		var (
+			plugins  = annotations.ExtractPlugins(obj)
+			requests = make([]ctrl.Request, 0, len(plugins))
+		)
+
+		for _, p := range plugins {
+			requests = append(requests, ctrl.Request{
+				NamespacedName: client.ObjectKey{
+					Name: p,
+				},
+			})
+		}
+		return requests
+	}
+}
+
+// mapKongClusterPluginForKongPlugin enqueues a request for the KongClusterPlugin
+// with the same name as the provided KongPlugin, which takes precedence over it.
+func mapKongClusterPluginForKongPlugin(_ context.Context, obj client.Object) []ctrl.Request {
+	return []ctrl.Request{
+		{
+			NamespacedName: client.ObjectKey{
+				Name: obj.GetName(),
+			},
+		},
+	}
+}
+
+// mapKongPluginBindings enqueue requests for KongClusterPlugins referenced by KongPluginBindings in their .spec.pluginRef field.
+func (r *KongClusterPluginReconciler) mapKongPluginBindings(ctx context.Context, obj client.Object) []ctrl.Request {
+	logger := log.GetLogger(ctx, "KongClusterPlugin", r.developmentMode)
+	kongPluginBinding, ok := obj.(*configurationv1alpha1.KongPluginBinding)
+	if !ok {
+		log.Error(logger, errors.New("cannot cast object to KongPluginBinding"), "KongPluginBinding mapping handler", obj)
+		return []ctrl.Request{}
+	}
+	if kind := kongPluginBinding.Spec.PluginReference.Kind; kind == nil || *kind != "KongClusterPlugin" {
+		return []ctrl.Request{}
+	}
+
+	return []ctrl.Request{
+		{
+			NamespacedName: client.ObjectKey{
+				Name: kongPluginBinding.Spec.PluginReference.Name,
+			},
+		},
+	}
+}
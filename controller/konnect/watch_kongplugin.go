@@ -67,6 +67,9 @@ func (r *KongPluginReconciler) mapKongPluginBindings(ctx context.Context, obj cl
 		log.Error(logger, errors.New("cannot cast object to KongPluginBinding"), "KongPluginBinding mapping handler", obj)
 		return []ctrl.Request{}
 	}
+	if kind := kongPluginBinding.Spec.PluginReference.Kind; kind != nil && *kind != "KongPlugin" {
+		return []ctrl.Request{}
+	}
 
 	return []ctrl.Request{
 		{
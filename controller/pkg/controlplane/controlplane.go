@@ -247,6 +247,10 @@ func SpecDeepEqual(spec1, spec2 *operatorv1beta1.ControlPlaneOptions, envVarsToI
 		return false
 	}
 
+	if !reflect.DeepEqual(spec1.Monitoring, spec2.Monitoring) {
+		return false
+	}
+
 	return true
 }
 
@@ -274,3 +278,24 @@ func DeduceAnonymousReportsEnabled(developmentMode bool, cpOpts *operatorv1beta1
 
 	return !developmentMode
 }
+
+// DeduceIngressClass returns the ingress class used by the control plane
+// based on the environment variable `CONTROLLER_INGRESS_CLASS` in the control plane
+// pod template spec. When it's not set, the control plane's default ingress class is returned.
+func DeduceIngressClass(cpOpts *operatorv1beta1.ControlPlaneOptions) string {
+	pts := cpOpts.Deployment.PodTemplateSpec
+	if pts == nil {
+		return consts.DefaultControlPlaneIngressClass
+	}
+
+	container := k8sutils.GetPodContainerByName(&pts.Spec, consts.ControlPlaneControllerContainerName)
+	if container == nil {
+		return consts.DefaultControlPlaneIngressClass
+	}
+
+	if env := k8sutils.EnvValueByName(container.Env, "CONTROLLER_INGRESS_CLASS"); env != "" {
+		return env
+	}
+
+	return consts.DefaultControlPlaneIngressClass
+}
@@ -109,3 +109,62 @@ func TestDeduceAnonymousReportsEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestDeduceIngressClass(t *testing.T) {
+	tests := []struct {
+		name     string
+		cpOpts   *operatorv1beta1.ControlPlaneOptions
+		expected string
+	}{
+		{
+			name:     "pod template spec not set",
+			cpOpts:   &operatorv1beta1.ControlPlaneOptions{},
+			expected: "kong",
+		},
+		{
+			name: "ingress class not set",
+			cpOpts: &operatorv1beta1.ControlPlaneOptions{
+				Deployment: operatorv1beta1.ControlPlaneDeploymentOptions{
+					PodTemplateSpec: &corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: consts.ControlPlaneControllerContainerName,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: "kong",
+		},
+		{
+			name: "ingress class set",
+			cpOpts: &operatorv1beta1.ControlPlaneOptions{
+				Deployment: operatorv1beta1.ControlPlaneDeploymentOptions{
+					PodTemplateSpec: &corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: consts.ControlPlaneControllerContainerName,
+									Env: []corev1.EnvVar{
+										{
+											Name:  "CONTROLLER_INGRESS_CLASS",
+											Value: "kong-internal",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: "kong-internal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DeduceIngressClass(tt.cpOpts))
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/pkg/log"
+	"github.com/kong/gateway-operator/controller/pkg/op"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+)
+
+var monitorKinds = []operatorv1beta1.MonitorKind{
+	operatorv1beta1.MonitorKindServiceMonitor,
+	operatorv1beta1.MonitorKindPodMonitor,
+}
+
+// EnsureMonitor ensures that the provided generated ServiceMonitor or PodMonitor
+// exists for the owner and that the monitors of the other kind are deleted.
+// When the CRD of the generated monitor is not installed in the cluster, the monitor
+// is not created and no error is returned.
+func EnsureMonitor(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	owner client.Object,
+	generated *unstructured.Unstructured,
+	matchingLabels client.MatchingLabels,
+) (op.Result, error) {
+	var (
+		kind     = operatorv1beta1.MonitorKind(generated.GetKind())
+		existing []unstructured.Unstructured
+		res      = op.Noop
+	)
+	for _, k := range monitorKinds {
+		monitors, crdExists, err := listMonitorsForOwner(ctx, cl, owner, k, matchingLabels)
+		if err != nil {
+			return op.Noop, err
+		}
+		if k == kind {
+			if !crdExists {
+				log.Debug(logger, "CRD not installed, skipping monitor creation", owner, "kind", kind)
+				return res, nil
+			}
+			existing = monitors
+			continue
+		}
+		if len(monitors) > 0 {
+			if err := deleteMonitors(ctx, cl, monitors); err != nil {
+				return op.Noop, err
+			}
+			res = op.Deleted
+		}
+	}
+
+	if len(existing) > 1 {
+		// Keep the first monitor and delete the rest.
+		if err := deleteMonitors(ctx, cl, existing[1:]); err != nil {
+			return op.Noop, err
+		}
+		existing = existing[:1]
+	}
+
+	if len(existing) == 1 {
+		var updated bool
+		monitor := &existing[0]
+		if !maps.Equal(monitor.GetLabels(), generated.GetLabels()) {
+			monitor.SetLabels(generated.GetLabels())
+			updated = true
+		}
+		if !k8sresources.MonitorSpecEqual(monitor, generated) {
+			monitor.Object["spec"] = generated.Object["spec"]
+			updated = true
+		}
+		if !updated {
+			return res, nil
+		}
+		if err := cl.Update(ctx, monitor); err != nil {
+			return op.Noop, fmt.Errorf("failed updating %s %s: %w", kind, monitor.GetName(), err)
+		}
+		return op.Updated, nil
+	}
+
+	if err := cl.Create(ctx, generated); err != nil {
+		return op.Noop, fmt.Errorf("failed creating %s for %s: %w", kind, client.ObjectKeyFromObject(owner), err)
+	}
+	return op.Created, nil
+}
+
+// DeleteMonitors deletes all the ServiceMonitors and PodMonitors of the owner.
+// The monitors of the kinds whose CRDs are not installed in the cluster are skipped.
+func DeleteMonitors(
+	ctx context.Context,
+	cl client.Client,
+	owner client.Object,
+	matchingLabels client.MatchingLabels,
+) (op.Result, error) {
+	res := op.Noop
+	for _, k := range monitorKinds {
+		monitors, _, err := listMonitorsForOwner(ctx, cl, owner, k, matchingLabels)
+		if err != nil {
+			return op.Noop, err
+		}
+		if len(monitors) == 0 {
+			continue
+		}
+		if err := deleteMonitors(ctx, cl, monitors); err != nil {
+			return op.Noop, err
+		}
+		res = op.Deleted
+	}
+	return res, nil
+}
+
+// listMonitorsForOwner lists the monitors of the provided kind owned by the owner.
+// It returns false when the CRD of the monitor kind is not installed in the cluster.
+func listMonitorsForOwner(
+	ctx context.Context,
+	cl client.Client,
+	owner client.Object,
+	kind operatorv1beta1.MonitorKind,
+	matchingLabels client.MatchingLabels,
+) ([]unstructured.Unstructured, bool, error) {
+	crdExists, err := k8sutils.CRDChecker{Client: cl}.CRDExists(k8sresources.MonitorGVR(kind))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed checking if %s CRD exists: %w", kind, err)
+	}
+	if !crdExists {
+		return nil, false, nil
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(k8sresources.MonitoringGroupVersion.WithKind(string(kind) + "List"))
+	if err := cl.List(ctx, list, client.InNamespace(owner.GetNamespace()), matchingLabels); err != nil {
+		return nil, true, fmt.Errorf("failed listing %ss for %s: %w", kind, client.ObjectKeyFromObject(owner), err)
+	}
+
+	monitors := make([]unstructured.Unstructured, 0, len(list.Items))
+	for _, m := range list.Items {
+		if k8sutils.IsOwnedByRefUID(&m, owner.GetUID()) {
+			monitors = append(monitors, m)
+		}
+	}
+	return monitors, true, nil
+}
+
+func deleteMonitors(ctx context.Context, cl client.Client, monitors []unstructured.Unstructured) error {
+	for _, m := range monitors {
+		if err := cl.Delete(ctx, &m); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting %s %s: %w", m.GetKind(), m.GetName(), err)
+		}
+	}
+	return nil
+}
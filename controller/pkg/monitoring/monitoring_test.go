@@ -0,0 +1,104 @@
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/controller/pkg/op"
+	"github.com/kong/gateway-operator/modules/manager/scheme"
+	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
+)
+
+func TestEnsureMonitor(t *testing.T) {
+	dataplane := &operatorv1beta1.DataPlane{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway-operator.konghq.com/v1beta1",
+			Kind:       "DataPlane",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dp-1",
+			Namespace: "default",
+			UID:       "dp-uid",
+		},
+	}
+	matchingLabels := k8sresources.GetManagedLabelForOwner(dataplane)
+	matchingLabels["app"] = dataplane.Name
+
+	newClient := func(kinds ...operatorv1beta1.MonitorKind) client.Client {
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		for _, kind := range kinds {
+			restMapper.Add(k8sresources.MonitoringGroupVersion.WithKind(string(kind)), meta.RESTScopeNamespace)
+		}
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Get()).
+			WithRESTMapper(restMapper).
+			Build()
+	}
+	listMonitors := func(t *testing.T, cl client.Client, kind operatorv1beta1.MonitorKind) []unstructured.Unstructured {
+		monitors, _, err := listMonitorsForOwner(context.Background(), cl, dataplane, kind, matchingLabels)
+		require.NoError(t, err)
+		return monitors
+	}
+
+	t.Run("monitor is created, updated and replaced by the other kind", func(t *testing.T) {
+		cl := newClient(operatorv1beta1.MonitorKindServiceMonitor, operatorv1beta1.MonitorKindPodMonitor)
+		opts := &operatorv1beta1.MonitoringOptions{}
+
+		res, err := EnsureMonitor(context.Background(), cl, logr.Discard(), dataplane, k8sresources.GenerateMonitorForDataPlane(dataplane, opts), matchingLabels)
+		require.NoError(t, err)
+		assert.Equal(t, op.Created, res)
+		require.Len(t, listMonitors(t, cl, operatorv1beta1.MonitorKindServiceMonitor), 1)
+
+		res, err = EnsureMonitor(context.Background(), cl, logr.Discard(), dataplane, k8sresources.GenerateMonitorForDataPlane(dataplane, opts), matchingLabels)
+		require.NoError(t, err)
+		assert.Equal(t, op.Noop, res)
+
+		opts.Interval = lo.ToPtr("15s")
+		res, err = EnsureMonitor(context.Background(), cl, logr.Discard(), dataplane, k8sresources.GenerateMonitorForDataPlane(dataplane, opts), matchingLabels)
+		require.NoError(t, err)
+		assert.Equal(t, op.Updated, res)
+		monitors := listMonitors(t, cl, operatorv1beta1.MonitorKindServiceMonitor)
+		require.Len(t, monitors, 1)
+		endpoints, _, err := unstructured.NestedSlice(monitors[0].Object, "spec", "endpoints")
+		require.NoError(t, err)
+		require.Len(t, endpoints, 1)
+		assert.Equal(t, "15s", endpoints[0].(map[string]any)["interval"])
+
+		opts.Kind = operatorv1beta1.MonitorKindPodMonitor
+		res, err = EnsureMonitor(context.Background(), cl, logr.Discard(), dataplane, k8sresources.GenerateMonitorForDataPlane(dataplane, opts), matchingLabels)
+		require.NoError(t, err)
+		assert.Equal(t, op.Created, res)
+		assert.Empty(t, listMonitors(t, cl, operatorv1beta1.MonitorKindServiceMonitor))
+		assert.Len(t, listMonitors(t, cl, operatorv1beta1.MonitorKindPodMonitor), 1)
+
+		res, err = DeleteMonitors(context.Background(), cl, dataplane, matchingLabels)
+		require.NoError(t, err)
+		assert.Equal(t, op.Deleted, res)
+		assert.Empty(t, listMonitors(t, cl, operatorv1beta1.MonitorKindPodMonitor))
+	})
+
+	t.Run("monitor is skipped when its CRD is not installed", func(t *testing.T) {
+		cl := newClient()
+
+		res, err := EnsureMonitor(context.Background(), cl, logr.Discard(), dataplane,
+			k8sresources.GenerateMonitorForDataPlane(dataplane, &operatorv1beta1.MonitoringOptions{}), matchingLabels,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, op.Noop, res)
+
+		res, err = DeleteMonitors(context.Background(), cl, dataplane, matchingLabels)
+		require.NoError(t, err)
+		assert.Equal(t, op.Noop, res)
+	})
+}
@@ -198,6 +198,35 @@ func TestLog(t *testing.T) {
 	})
 }
 
+func TestGenerateSelfSignedCertificate(t *testing.T) {
+	notBefore := time.Now().Truncate(time.Second).UTC()
+	cert, key, err := GenerateSelfSignedCertificate("test", notBefore, time.Hour, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+	require.NoError(t, err)
+	require.True(t, IsTLSSecretValid(&corev1.Secret{
+		Data: map[string][]byte{
+			"tls.crt": cert,
+			"tls.key": key,
+		},
+	}))
+
+	certBlock, _ := pem.Decode(cert)
+	require.NotNil(t, certBlock)
+	parsed, err := x509.ParseCertificate(certBlock.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, "test", parsed.Subject.CommonName)
+	assert.Equal(t, parsed.Subject, parsed.Issuer)
+	assert.Equal(t, notBefore, parsed.NotBefore)
+	assert.Equal(t, notBefore.Add(time.Hour), parsed.NotAfter)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, parsed.ExtKeyUsage)
+	require.NoError(t, parsed.CheckSignature(parsed.SignatureAlgorithm, parsed.RawTBSCertificate, parsed.Signature))
+
+	keyBlock, _ := pem.Decode(key)
+	require.NotNil(t, keyBlock)
+	priv, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	require.NoError(t, err)
+	assert.True(t, priv.PublicKey.Equal(parsed.PublicKey))
+}
+
 func TestMaybeCreateCertificateSecret(t *testing.T) {
 	createDataPlane := func(nn types.NamespacedName, opt ...func(dp *operatorv1beta1.DataPlane)) *operatorv1beta1.DataPlane {
 		dp := &operatorv1beta1.DataPlane{
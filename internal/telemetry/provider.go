@@ -112,6 +112,10 @@ func NewDataPlaneRequestedReplicasCountProvider(cl client.Client) (provider.Prov
 					count += int(scaling.HorizontalScaling.MaxReplicas)
 					continue
 				}
+				if scaling.EventDrivenScaling != nil {
+					count += int(scaling.EventDrivenScaling.MaxReplicas)
+					continue
+				}
 			}
 			// No replicas nor scaling defined, count it as 1 replica.
 			count++
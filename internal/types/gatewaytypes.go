@@ -13,6 +13,9 @@ type (
 	HTTPRoute            = gatewayv1.HTTPRoute
 	HTTPRouteSpec        = gatewayv1.HTTPRouteSpec
 	HTTPRouteList        = gatewayv1.HTTPRouteList
+	GRPCRoute            = gatewayv1.GRPCRoute
+	GRPCRouteSpec        = gatewayv1.GRPCRouteSpec
+	GRPCRouteList        = gatewayv1.GRPCRouteList
 	ParentReference      = gatewayv1.ParentReference
 	CommonRouteSpec      = gatewayv1.CommonRouteSpec
 	Kind                 = gatewayv1.Kind
@@ -1,21 +1,66 @@
 package dataplane
 
 import (
+	"fmt"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/pkg/consts"
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 )
 
 const (
-	kongPluginsEnvVarName   = "KONG_PLUGINS"
+	kongPluginsEnvVarName   = consts.EnvVarKongPlugins
 	kongPluginsDefaultValue = "bundled"
 
 	kongLuaPackagePathVarName      = "KONG_LUA_PACKAGE_PATH"
 	kongLuaPackagePathDefaultValue = "/opt/?.lua;;"
+
+	kongTracingInstrumentationsEnvVarName = "KONG_TRACING_INSTRUMENTATIONS"
+	kongTracingSamplingRateEnvVarName     = "KONG_TRACING_SAMPLING_RATE"
+
+	kongProxyListenEnvVarName      = "KONG_PROXY_LISTEN"
+	kongStreamListenEnvVarName     = "KONG_STREAM_LISTEN"
+	kongPortMapsEnvVarName         = "KONG_PORT_MAPS"
+	kongLogLevelEnvVarName         = "KONG_LOG_LEVEL"
+	kongDNSResolverEnvVarName      = "KONG_DNS_RESOLVER"
+	kongDNSOrderEnvVarName         = "KONG_DNS_ORDER"
+	kongDNSValidTTLEnvVarName      = "KONG_DNS_VALID_TTL"
+	kongSSLCipherSuiteEnvVarName   = "KONG_SSL_CIPHER_SUITE"
+	kongSSLCiphersEnvVarName       = "KONG_SSL_CIPHERS"
+	kongSSLProtocolsEnvVarName     = "KONG_SSL_PROTOCOLS"
+	kongNginxDirectiveEnvVarFormat = "KONG_NGINX_%s_%s"
+
+	kongDatabasePostgres       = "postgres"
+	kongPGHostEnvVarName       = "KONG_PG_HOST"
+	kongPGPortEnvVarName       = "KONG_PG_PORT"
+	kongPGPortDefaultValue     = 5432
+	kongPGDatabaseEnvVarName   = "KONG_PG_DATABASE"
+	kongPGDatabaseDefaultValue = "kong"
+	kongPGUserEnvVarName       = "KONG_PG_USER"
+	kongPGUserSecretKey        = "username"
+	kongPGPasswordEnvVarName   = "KONG_PG_PASSWORD"
+	kongPGPasswordSecretKey    = "password"
+	kongPGSSLEnvVarName        = "KONG_PG_SSL"
+	kongPGSSLVerifyEnvVarName  = "KONG_PG_SSL_VERIFY"
+
+	kongRoleEnvVarName                       = "KONG_ROLE"
+	kongClusterMTLSEnvVarName                = "KONG_CLUSTER_MTLS"
+	kongClusterMTLSPKI                       = "pki"
+	kongClusterCertEnvVarName                = "KONG_CLUSTER_CERT"
+	kongClusterCertKeyEnvVarName             = "KONG_CLUSTER_CERT_KEY"
+	kongClusterCACertEnvVarName              = "KONG_CLUSTER_CA_CERT"
+	kongClusterListenEnvVarName              = "KONG_CLUSTER_LISTEN"
+	kongClusterTelemetryListenEnvVarName     = "KONG_CLUSTER_TELEMETRY_LISTEN"
+	kongClusterControlPlaneEnvVarName        = "KONG_CLUSTER_CONTROL_PLANE"
+	kongClusterServerNameEnvVarName          = "KONG_CLUSTER_SERVER_NAME"
+	kongClusterTelemetryEndpointEnvVarName   = "KONG_CLUSTER_TELEMETRY_ENDPOINT"
+	kongClusterTelemetryServerNameEnvVarName = "KONG_CLUSTER_TELEMETRY_SERVER_NAME"
 )
 
 // -----------------------------------------------------------------------------
@@ -78,3 +123,217 @@ func ConfigureKongPluginRelatedEnvVars(kongPluginNames []string) []corev1.EnvVar
 		},
 	}
 }
+
+// ConfigureTracingRelatedEnvVars returns the environment variables needed for
+// configuring the Kong Gateway to generate traces with the provided tracing options.
+// If tracing is nil, nil is returned. All the instrumentations are enabled
+// when none are provided.
+func ConfigureTracingRelatedEnvVars(tracing *operatorv1beta1.DataPlaneTracingOptions) []corev1.EnvVar {
+	if tracing == nil {
+		return nil
+	}
+	instrumentations := make([]string, 0, len(tracing.Instrumentations))
+	for _, i := range tracing.Instrumentations {
+		instrumentations = append(instrumentations, string(i))
+	}
+	if len(instrumentations) == 0 {
+		instrumentations = append(instrumentations, string(operatorv1beta1.TracingInstrumentationAll))
+	}
+	envVars := []corev1.EnvVar{
+		{
+			Name:  kongTracingInstrumentationsEnvVarName,
+			Value: strings.Join(instrumentations, ","),
+		},
+	}
+	if tracing.SamplingRate != nil {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  kongTracingSamplingRateEnvVarName,
+			Value: *tracing.SamplingRate,
+		})
+	}
+	return envVars
+}
+
+// ConfigureKongConfigEnvVars returns the environment variables rendered from
+// the provided typed Kong configuration, sorted by name.
+// If kongConfig is nil, nil is returned.
+func ConfigureKongConfigEnvVars(kongConfig *operatorv1beta1.KongConfig) []corev1.EnvVar {
+	if kongConfig == nil {
+		return nil
+	}
+
+	envSet := map[string]string{}
+	if listeners := kongConfig.Listeners; listeners != nil {
+		if len(listeners.Proxy) > 0 {
+			envSet[kongProxyListenEnvVarName] = renderKongListeners(listeners.Proxy)
+		}
+		if len(listeners.Stream) > 0 {
+			envSet[kongStreamListenEnvVarName] = renderKongListeners(listeners.Stream)
+		}
+		if len(listeners.PortMaps) > 0 {
+			portMaps := make([]string, 0, len(listeners.PortMaps))
+			for _, pm := range listeners.PortMaps {
+				portMaps = append(portMaps, fmt.Sprintf("%d:%d", pm.Port, pm.TargetPort))
+			}
+			envSet[kongPortMapsEnvVarName] = strings.Join(portMaps, ", ")
+		}
+	}
+	for _, d := range kongConfig.NginxDirectives {
+		envSet[NginxDirectiveEnvVarName(d)] = d.Value
+	}
+	if kongConfig.LogLevel != nil {
+		envSet[kongLogLevelEnvVarName] = string(*kongConfig.LogLevel)
+	}
+	if len(kongConfig.Plugins) > 0 {
+		envSet[kongPluginsEnvVarName] = strings.Join(kongConfig.Plugins, ",")
+	}
+	if dns := kongConfig.DNS; dns != nil {
+		if len(dns.Resolvers) > 0 {
+			envSet[kongDNSResolverEnvVarName] = strings.Join(dns.Resolvers, ",")
+		}
+		if len(dns.Order) > 0 {
+			order := make([]string, 0, len(dns.Order))
+			for _, o := range dns.Order {
+				order = append(order, string(o))
+			}
+			envSet[kongDNSOrderEnvVarName] = strings.Join(order, ",")
+		}
+		if dns.ValidTTL != nil {
+			envSet[kongDNSValidTTLEnvVarName] = strconv.Itoa(int(*dns.ValidTTL))
+		}
+	}
+	if tls := kongConfig.TLS; tls != nil {
+		if tls.CipherSuite != nil {
+			envSet[kongSSLCipherSuiteEnvVarName] = string(*tls.CipherSuite)
+		}
+		if tls.Ciphers != nil {
+			envSet[kongSSLCiphersEnvVarName] = *tls.Ciphers
+		}
+		if len(tls.Protocols) > 0 {
+			protocols := make([]string, 0, len(tls.Protocols))
+			for _, p := range tls.Protocols {
+				protocols = append(protocols, string(p))
+			}
+			envSet[kongSSLProtocolsEnvVarName] = strings.Join(protocols, " ")
+		}
+	}
+
+	envVars := make([]corev1.EnvVar, 0, len(envSet))
+	for k, v := range envSet {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  k,
+			Value: v,
+		})
+	}
+	sort.Sort(k8sutils.SortableEnvVars(envVars))
+	return envVars
+}
+
+// ConfigureDatabaseEnvVars returns the environment variables configuring
+// the Kong Gateway to use the provided database. The PostgreSQL credentials
+// are referenced from the configured Secret. If database is nil, nil is returned.
+func ConfigureDatabaseEnvVars(database *operatorv1beta1.DataPlaneDatabaseOptions) []corev1.EnvVar {
+	if database == nil {
+		return nil
+	}
+	pg := database.Postgres
+	secretKeyRef := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: pg.CredentialsSecretRef.Name,
+				},
+				Key: key,
+			},
+		}
+	}
+	port := pg.Port
+	if port == 0 {
+		port = kongPGPortDefaultValue
+	}
+	dbName := pg.Database
+	if dbName == "" {
+		dbName = kongPGDatabaseDefaultValue
+	}
+
+	return []corev1.EnvVar{
+		{Name: consts.EnvVarKongDatabase, Value: kongDatabasePostgres},
+		{Name: kongPGDatabaseEnvVarName, Value: dbName},
+		{Name: kongPGHostEnvVarName, Value: pg.Host},
+		{Name: kongPGPasswordEnvVarName, ValueFrom: secretKeyRef(kongPGPasswordSecretKey)},
+		{Name: kongPGPortEnvVarName, Value: strconv.Itoa(int(port))},
+		{Name: kongPGSSLEnvVarName, Value: onOff(pg.SSL)},
+		{Name: kongPGSSLVerifyEnvVarName, Value: onOff(pg.SSLVerify)},
+		{Name: kongPGUserEnvVarName, ValueFrom: secretKeyRef(kongPGUserSecretKey)},
+	}
+}
+
+// ConfigureHybridEnvVars returns the environment variables configuring
+// the Kong Gateway hybrid mode role of the DataPlane. The cluster certificate
+// is expected to be mounted at consts.HybridClusterCertificateVolumeMountPath.
+// controlPlaneHost is the hostname of the control plane's cluster Service and is
+// only used for the data_plane role. If hybrid is nil, nil is returned.
+func ConfigureHybridEnvVars(hybrid *operatorv1beta1.DataPlaneHybridOptions, controlPlaneHost string) []corev1.EnvVar {
+	if hybrid == nil {
+		return nil
+	}
+	envVars := []corev1.EnvVar{
+		{Name: kongRoleEnvVarName, Value: string(hybrid.Role)},
+		{Name: kongClusterMTLSEnvVarName, Value: kongClusterMTLSPKI},
+		{Name: kongClusterCertEnvVarName, Value: consts.HybridClusterCertificateVolumeMountPath + "/" + consts.TLSCRT},
+		{Name: kongClusterCertKeyEnvVarName, Value: consts.HybridClusterCertificateVolumeMountPath + "/" + consts.TLSKey},
+		{Name: kongClusterCACertEnvVarName, Value: consts.HybridClusterCertificateVolumeMountPath + "/" + consts.CACRT},
+	}
+
+	switch hybrid.Role {
+	case operatorv1beta1.DataPlaneHybridRoleControlPlane:
+		envVars = append(envVars,
+			corev1.EnvVar{Name: kongClusterListenEnvVarName, Value: fmt.Sprintf("0.0.0.0:%d", consts.DataPlaneClusterPort)},
+			corev1.EnvVar{Name: kongClusterTelemetryListenEnvVarName, Value: fmt.Sprintf("0.0.0.0:%d", consts.DataPlaneClusterTelemetryPort)},
+		)
+	case operatorv1beta1.DataPlaneHybridRoleDataPlane:
+		envVars = append(envVars,
+			corev1.EnvVar{Name: kongClusterControlPlaneEnvVarName, Value: net.JoinHostPort(controlPlaneHost, strconv.Itoa(consts.DataPlaneClusterPort))},
+			corev1.EnvVar{Name: kongClusterServerNameEnvVarName, Value: controlPlaneHost},
+			corev1.EnvVar{Name: kongClusterTelemetryEndpointEnvVarName, Value: net.JoinHostPort(controlPlaneHost, strconv.Itoa(consts.DataPlaneClusterTelemetryPort))},
+			corev1.EnvVar{Name: kongClusterTelemetryServerNameEnvVarName, Value: controlPlaneHost},
+		)
+	}
+
+	sort.Sort(k8sutils.SortableEnvVars(envVars))
+	return envVars
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+// NginxDirectiveEnvVarName returns the name of the environment variable
+// injecting the provided Nginx directive, e.g. KONG_NGINX_HTTP_CLIENT_MAX_BODY_SIZE.
+func NginxDirectiveEnvVarName(d operatorv1beta1.KongNginxDirective) string {
+	return fmt.Sprintf(kongNginxDirectiveEnvVarFormat, strings.ToUpper(string(d.Block)), strings.ToUpper(d.Name))
+}
+
+// renderKongListeners renders the provided listeners in the format of Kong's
+// listen configuration, e.g. "0.0.0.0:8000 reuseport backlog=16384, 0.0.0.0:8443 http2 ssl".
+func renderKongListeners(listeners []operatorv1beta1.KongListener) string {
+	rendered := make([]string, 0, len(listeners))
+	for _, l := range listeners {
+		address := l.Address
+		if address == "" {
+			address = "0.0.0.0"
+		}
+		parts := []string{net.JoinHostPort(address, strconv.Itoa(int(l.Port)))}
+		for _, p := range l.Parameters {
+			parts = append(parts, string(p))
+		}
+		if l.Backlog != nil {
+			parts = append(parts, fmt.Sprintf("backlog=%d", *l.Backlog))
+		}
+		rendered = append(rendered, strings.Join(parts, " "))
+	}
+	return strings.Join(rendered, ", ")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/pkg/consts"
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 )
@@ -157,3 +158,259 @@ func TestFillDataPlaneProxyContainerEnvs(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureTracingRelatedEnvVars(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tracing  *operatorv1beta1.DataPlaneTracingOptions
+		expected []corev1.EnvVar
+	}{
+		{
+			name:     "tracing not configured",
+			tracing:  nil,
+			expected: nil,
+		},
+		{
+			name: "all instrumentations are enabled by default",
+			tracing: &operatorv1beta1.DataPlaneTracingOptions{
+				Endpoint: "http://otel-collector.observability:4318/v1/traces",
+			},
+			expected: []corev1.EnvVar{
+				{Name: "KONG_TRACING_INSTRUMENTATIONS", Value: "all"},
+			},
+		},
+		{
+			name: "instrumentations and sampling rate are set",
+			tracing: &operatorv1beta1.DataPlaneTracingOptions{
+				Endpoint:     "http://otel-collector.observability:4318/v1/traces",
+				SamplingRate: lo.ToPtr("0.25"),
+				Instrumentations: []operatorv1beta1.TracingInstrumentation{
+					operatorv1beta1.TracingInstrumentationRequest,
+					operatorv1beta1.TracingInstrumentationBalancer,
+				},
+			},
+			expected: []corev1.EnvVar{
+				{Name: "KONG_TRACING_INSTRUMENTATIONS", Value: "request,balancer"},
+				{Name: "KONG_TRACING_SAMPLING_RATE", Value: "0.25"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ConfigureTracingRelatedEnvVars(tc.tracing))
+		})
+	}
+}
+
+func TestConfigureKongConfigEnvVars(t *testing.T) {
+	testCases := []struct {
+		name       string
+		kongConfig *operatorv1beta1.KongConfig
+		expected   []corev1.EnvVar
+	}{
+		{
+			name:       "kongConfig not set",
+			kongConfig: nil,
+			expected:   nil,
+		},
+		{
+			name: "all the options are rendered",
+			kongConfig: &operatorv1beta1.KongConfig{
+				Listeners: &operatorv1beta1.KongListenersConfig{
+					Proxy: []operatorv1beta1.KongListener{
+						{
+							Port:       8000,
+							Parameters: []operatorv1beta1.KongListenerParameter{operatorv1beta1.KongListenerParameterReusePort},
+							Backlog:    lo.ToPtr(int32(16384)),
+						},
+						{
+							Address: "::",
+							Port:    8443,
+							Parameters: []operatorv1beta1.KongListenerParameter{
+								operatorv1beta1.KongListenerParameterHTTP2,
+								operatorv1beta1.KongListenerParameterSSL,
+							},
+						},
+					},
+					Stream: []operatorv1beta1.KongListener{
+						{Address: "0.0.0.0", Port: 9000},
+					},
+					PortMaps: []operatorv1beta1.KongPortMap{
+						{Port: 80, TargetPort: 8000},
+						{Port: 443, TargetPort: 8443},
+					},
+				},
+				NginxDirectives: []operatorv1beta1.KongNginxDirective{
+					{Block: operatorv1beta1.KongNginxBlockHTTP, Name: "client_max_body_size", Value: "8m"},
+					{Block: operatorv1beta1.KongNginxBlockEvents, Name: "worker_connections", Value: "4096"},
+				},
+				LogLevel: lo.ToPtr(operatorv1beta1.KongLogLevelDebug),
+				Plugins:  []string{"bundled", "my-plugin"},
+				DNS: &operatorv1beta1.KongDNSConfig{
+					Resolvers: []string{"10.0.0.10", "10.0.0.11:5353"},
+					Order: []operatorv1beta1.KongDNSRecordType{
+						operatorv1beta1.KongDNSRecordTypeLast,
+						operatorv1beta1.KongDNSRecordTypeA,
+					},
+					ValidTTL: lo.ToPtr(int32(30)),
+				},
+				TLS: &operatorv1beta1.KongTLSConfig{
+					CipherSuite: lo.ToPtr(operatorv1beta1.KongTLSCipherSuiteCustom),
+					Ciphers:     lo.ToPtr("ECDHE-ECDSA-AES128-GCM-SHA256"),
+					Protocols: []operatorv1beta1.KongTLSProtocol{
+						operatorv1beta1.KongTLSProtocolTLS12,
+						operatorv1beta1.KongTLSProtocolTLS13,
+					},
+				},
+			},
+			expected: []corev1.EnvVar{
+				{Name: "KONG_DNS_ORDER", Value: "LAST,A"},
+				{Name: "KONG_DNS_RESOLVER", Value: "10.0.0.10,10.0.0.11:5353"},
+				{Name: "KONG_DNS_VALID_TTL", Value: "30"},
+				{Name: "KONG_LOG_LEVEL", Value: "debug"},
+				{Name: "KONG_NGINX_EVENTS_WORKER_CONNECTIONS", Value: "4096"},
+				{Name: "KONG_NGINX_HTTP_CLIENT_MAX_BODY_SIZE", Value: "8m"},
+				{Name: "KONG_PLUGINS", Value: "bundled,my-plugin"},
+				{Name: "KONG_PORT_MAPS", Value: "80:8000, 443:8443"},
+				{Name: "KONG_PROXY_LISTEN", Value: "0.0.0.0:8000 reuseport backlog=16384, [::]:8443 http2 ssl"},
+				{Name: "KONG_SSL_CIPHERS", Value: "ECDHE-ECDSA-AES128-GCM-SHA256"},
+				{Name: "KONG_SSL_CIPHER_SUITE", Value: "custom"},
+				{Name: "KONG_SSL_PROTOCOLS", Value: "TLSv1.2 TLSv1.3"},
+				{Name: "KONG_STREAM_LISTEN", Value: "0.0.0.0:9000"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ConfigureKongConfigEnvVars(tc.kongConfig))
+		})
+	}
+}
+
+func TestConfigureDatabaseEnvVars(t *testing.T) {
+	credentialsRef := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "kong-pg-credentials"},
+				Key:                  key,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		database *operatorv1beta1.DataPlaneDatabaseOptions
+		expected []corev1.EnvVar
+	}{
+		{
+			name:     "database not configured",
+			database: nil,
+			expected: nil,
+		},
+		{
+			name: "defaults are used for unset port and database",
+			database: &operatorv1beta1.DataPlaneDatabaseOptions{
+				Postgres: operatorv1beta1.DataPlanePostgresOptions{
+					Host:                 "postgres.db.svc",
+					CredentialsSecretRef: operatorv1beta1.DataPlanePostgresCredentialsSecretRef{Name: "kong-pg-credentials"},
+				},
+			},
+			expected: []corev1.EnvVar{
+				{Name: "KONG_DATABASE", Value: "postgres"},
+				{Name: "KONG_PG_DATABASE", Value: "kong"},
+				{Name: "KONG_PG_HOST", Value: "postgres.db.svc"},
+				{Name: "KONG_PG_PASSWORD", ValueFrom: credentialsRef("password")},
+				{Name: "KONG_PG_PORT", Value: "5432"},
+				{Name: "KONG_PG_SSL", Value: "off"},
+				{Name: "KONG_PG_SSL_VERIFY", Value: "off"},
+				{Name: "KONG_PG_USER", ValueFrom: credentialsRef("username")},
+			},
+		},
+		{
+			name: "all options are set",
+			database: &operatorv1beta1.DataPlaneDatabaseOptions{
+				Postgres: operatorv1beta1.DataPlanePostgresOptions{
+					Host:                 "pg.example.com",
+					Port:                 6432,
+					Database:             "gateway",
+					CredentialsSecretRef: operatorv1beta1.DataPlanePostgresCredentialsSecretRef{Name: "kong-pg-credentials"},
+					SSL:                  true,
+					SSLVerify:            true,
+				},
+			},
+			expected: []corev1.EnvVar{
+				{Name: "KONG_DATABASE", Value: "postgres"},
+				{Name: "KONG_PG_DATABASE", Value: "gateway"},
+				{Name: "KONG_PG_HOST", Value: "pg.example.com"},
+				{Name: "KONG_PG_PASSWORD", ValueFrom: credentialsRef("password")},
+				{Name: "KONG_PG_PORT", Value: "6432"},
+				{Name: "KONG_PG_SSL", Value: "on"},
+				{Name: "KONG_PG_SSL_VERIFY", Value: "on"},
+				{Name: "KONG_PG_USER", ValueFrom: credentialsRef("username")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ConfigureDatabaseEnvVars(tc.database))
+		})
+	}
+}
+
+func TestConfigureHybridEnvVars(t *testing.T) {
+	testCases := []struct {
+		name             string
+		hybrid           *operatorv1beta1.DataPlaneHybridOptions
+		controlPlaneHost string
+		expected         []corev1.EnvVar
+	}{
+		{
+			name:     "hybrid mode not configured",
+			hybrid:   nil,
+			expected: nil,
+		},
+		{
+			name: "control plane role",
+			hybrid: &operatorv1beta1.DataPlaneHybridOptions{
+				Role: operatorv1beta1.DataPlaneHybridRoleControlPlane,
+			},
+			expected: []corev1.EnvVar{
+				{Name: "KONG_CLUSTER_CA_CERT", Value: "/var/hybrid-cluster-certificate/ca.crt"},
+				{Name: "KONG_CLUSTER_CERT", Value: "/var/hybrid-cluster-certificate/tls.crt"},
+				{Name: "KONG_CLUSTER_CERT_KEY", Value: "/var/hybrid-cluster-certificate/tls.key"},
+				{Name: "KONG_CLUSTER_LISTEN", Value: "0.0.0.0:8005"},
+				{Name: "KONG_CLUSTER_MTLS", Value: "pki"},
+				{Name: "KONG_CLUSTER_TELEMETRY_LISTEN", Value: "0.0.0.0:8006"},
+				{Name: "KONG_ROLE", Value: "control_plane"},
+			},
+		},
+		{
+			name: "data plane role",
+			hybrid: &operatorv1beta1.DataPlaneHybridOptions{
+				Role:            operatorv1beta1.DataPlaneHybridRoleDataPlane,
+				ControlPlaneRef: &operatorv1beta1.DataPlaneHybridControlPlaneRef{Name: "cp"},
+			},
+			controlPlaneHost: "dataplane-cluster-cp-abcde.default.svc",
+			expected: []corev1.EnvVar{
+				{Name: "KONG_CLUSTER_CA_CERT", Value: "/var/hybrid-cluster-certificate/ca.crt"},
+				{Name: "KONG_CLUSTER_CERT", Value: "/var/hybrid-cluster-certificate/tls.crt"},
+				{Name: "KONG_CLUSTER_CERT_KEY", Value: "/var/hybrid-cluster-certificate/tls.key"},
+				{Name: "KONG_CLUSTER_CONTROL_PLANE", Value: "dataplane-cluster-cp-abcde.default.svc:8005"},
+				{Name: "KONG_CLUSTER_MTLS", Value: "pki"},
+				{Name: "KONG_CLUSTER_SERVER_NAME", Value: "dataplane-cluster-cp-abcde.default.svc"},
+				{Name: "KONG_CLUSTER_TELEMETRY_ENDPOINT", Value: "dataplane-cluster-cp-abcde.default.svc:8006"},
+				{Name: "KONG_CLUSTER_TELEMETRY_SERVER_NAME", Value: "dataplane-cluster-cp-abcde.default.svc"},
+				{Name: "KONG_ROLE", Value: "data_plane"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ConfigureHybridEnvVars(tc.hybrid, tc.controlPlaneHost))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package gatewayclass
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+// -----------------------------------------------------------------------------
+// GatewayClass - Supported Features
+// -----------------------------------------------------------------------------
+
+// SupportedFeatures returns the Gateway API features supported by the operator
+// regardless of the Kong router flavor used by the DataPlanes.
+func SupportedFeatures() sets.Set[features.FeatureName] {
+	return sets.New(
+		// core features
+		features.SupportHTTPRoute,
+		features.SupportGRPCRoute,
+		features.SupportGateway,
+		features.SupportReferenceGrant,
+
+		// Gateway extended
+		features.SupportGatewayPort8080,
+
+		// HTTPRoute extended
+		features.SupportHTTPRouteResponseHeaderModification,
+		features.SupportHTTPRoutePathRewrite,
+		features.SupportHTTPRouteHostRewrite,
+	)
+}
+
+// ExpressionsRouterSupportedFeatures returns the Gateway API features supported
+// by the operator when the DataPlanes use the expressions router flavor.
+func ExpressionsRouterSupportedFeatures() sets.Set[features.FeatureName] {
+	return SupportedFeatures().Insert(
+		// HTTPRoute extended
+		features.SupportHTTPRouteMethodMatching,
+		features.SupportHTTPRouteQueryParamMatching,
+	)
+}
+
+// SupportedFeaturesStatus returns the provided features in the form expected
+// in GatewayClass' status, i.e. sorted in ascending alphabetical order.
+func SupportedFeaturesStatus(fs sets.Set[features.FeatureName]) []gatewayv1.SupportedFeature {
+	names := fs.UnsortedList()
+	slices.Sort(names)
+
+	supportedFeatures := make([]gatewayv1.SupportedFeature, 0, len(names))
+	for _, name := range names {
+		supportedFeatures = append(supportedFeatures, gatewayv1.SupportedFeature{
+			Name: gatewayv1.FeatureName(name),
+		})
+	}
+	return supportedFeatures
+}
@@ -0,0 +1,32 @@
+package gatewayclass
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func TestSupportedFeaturesStatus(t *testing.T) {
+	require.Equal(t,
+		[]gatewayv1.SupportedFeature{
+			{Name: gatewayv1.FeatureName(features.SupportGRPCRoute)},
+			{Name: gatewayv1.FeatureName(features.SupportGateway)},
+			{Name: gatewayv1.FeatureName(features.SupportHTTPRoute)},
+		},
+		SupportedFeaturesStatus(sets.New(
+			features.SupportHTTPRoute,
+			features.SupportGateway,
+			features.SupportGRPCRoute,
+		)),
+	)
+	require.Empty(t, SupportedFeaturesStatus(sets.New[features.FeatureName]()))
+}
+
+func TestExpressionsRouterSupportedFeatures(t *testing.T) {
+	require.True(t, ExpressionsRouterSupportedFeatures().IsSuperset(SupportedFeatures()))
+	require.True(t, ExpressionsRouterSupportedFeatures().Has(features.SupportHTTPRouteMethodMatching))
+	require.False(t, SupportedFeatures().Has(features.SupportHTTPRouteMethodMatching))
+}
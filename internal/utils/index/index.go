@@ -23,6 +23,10 @@ const (
 	// KonnectExtensionIndex is the key to be used to access the .spec.extensions indexed values,
 	// in a form of list of namespace/name strings.
 	KonnectExtensionIndex = "KonnectExtension"
+
+	// HybridControlPlaneIndex is the key to be used to access the .spec.hybrid.controlPlaneRef indexed values,
+	// in a form of namespace/name strings.
+	HybridControlPlaneIndex = "HybridControlPlane"
 )
 
 // DataPlaneNameOnControlPlane indexes the ControlPlane .spec.dataplaneName field
@@ -113,3 +117,29 @@ func DataPlaneOnDataPlaneKonnecExtension(ctx context.Context, c cache.Cache) err
 		},
 	)
 }
+
+// HybridControlPlaneOnDataPlane indexes the DataPlane .spec.hybrid.controlPlaneRef field
+// on the "HybridControlPlane" key.
+func HybridControlPlaneOnDataPlane(ctx context.Context, c cache.Cache) error {
+	if _, err := c.GetInformer(ctx, &operatorv1beta1.DataPlane{}); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get informer for v1beta1 DataPlane: %w, disabling indexing hybrid control planes for DataPlanes' .spec.hybrid.controlPlaneRef", err)
+	}
+	return c.IndexField(
+		ctx,
+		&operatorv1beta1.DataPlane{},
+		HybridControlPlaneIndex,
+		func(o client.Object) []string {
+			dp, ok := o.(*operatorv1beta1.DataPlane)
+			if !ok {
+				return nil
+			}
+			if dp.Spec.Hybrid == nil || dp.Spec.Hybrid.ControlPlaneRef == nil {
+				return []string{}
+			}
+			return []string{dp.Namespace + "/" + dp.Spec.Hybrid.ControlPlaneRef.Name}
+		},
+	)
+}
@@ -2,12 +2,14 @@ package controlplane
 
 import (
 	"errors"
+	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/pkg/consts"
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
 )
 
 // Validator validates ControlPlane objects.
@@ -24,6 +26,10 @@ func (v *Validator) Validate(controlplane *operatorv1beta1.ControlPlane) error {
 		return err
 	}
 
+	if err := v.ValidatePatches(controlplane.Spec.Patches); err != nil {
+		return err
+	}
+
 	// prepared for more validations
 	return nil
 }
@@ -57,3 +63,18 @@ func (v *Validator) ValidateDeploymentOptions(opts *operatorv1beta1.ControlPlane
 
 	return nil
 }
+
+// ValidatePatches validates the Patches field of ControlPlane object.
+// Patches can only target the kinds of resources generated for ControlPlanes.
+func (v *Validator) ValidatePatches(patches []operatorv1beta1.ResourcePatch) error {
+	if err := k8sresources.ValidateResourcePatches(patches,
+		operatorv1beta1.ResourcePatchTargetKindDeployment,
+		operatorv1beta1.ResourcePatchTargetKindService,
+		operatorv1beta1.ResourcePatchTargetKindServiceAccount,
+		operatorv1beta1.ResourcePatchTargetKindClusterRole,
+		operatorv1beta1.ResourcePatchTargetKindClusterRoleBinding,
+	); err != nil {
+		return fmt.Errorf("invalid ControlPlane patches: %w", err)
+	}
+	return nil
+}
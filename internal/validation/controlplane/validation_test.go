@@ -119,3 +119,57 @@ func TestValidator_ValidateDeploymentOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_ValidatePatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		patches []operatorv1beta1.ResourcePatch
+		wantErr bool
+	}{
+		{
+			name: "patching the ClusterRole works",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindClusterRole},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "add", "path": "/metadata/annotations", "value": {"team": "gateway"}}]`,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "patching a HorizontalPodAutoscaler is an error",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindHorizontalPodAutoscaler},
+					Type:   operatorv1beta1.ResourcePatchTypeStrategicMerge,
+					Patch:  `{"spec": {"maxReplicas": 5}}`,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "strategic merge patch which is not an object is an error",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindDeployment},
+					Type:   operatorv1beta1.ResourcePatchTypeStrategicMerge,
+					Patch:  `["spec"]`,
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &Validator{}
+			err := v.ValidatePatches(tt.patches)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
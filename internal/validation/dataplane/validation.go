@@ -15,8 +15,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	dputils "github.com/kong/gateway-operator/internal/utils/dataplane"
 	"github.com/kong/gateway-operator/pkg/consts"
 	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/gateway-operator/pkg/utils/kubernetes/resources"
 )
 
 // Validator validates DataPlane objects.
@@ -45,11 +47,37 @@ func (v *Validator) Validate(dataplane *operatorv1beta1.DataPlane) error {
 		return err
 	}
 
-	if dataplane.Spec.Network.Services != nil && dataplane.Spec.Network.Services.Ingress != nil &&
-		dataplane.Spec.Deployment.PodTemplateSpec != nil {
+	if err := v.ValidateDataPlaneKongConfig(dataplane.Spec.KongConfig, dataplane.Spec.Deployment.PodTemplateSpec); err != nil {
+		return err
+	}
+
+	if err := v.ValidateDataPlaneDatabase(dataplane); err != nil {
+		return err
+	}
+
+	if err := v.ValidateDataPlaneHybrid(dataplane); err != nil {
+		return err
+	}
+
+	if err := v.ValidateDataPlanePatches(dataplane.Spec.Patches); err != nil {
+		return err
+	}
+
+	if dataplane.Spec.Network.Services != nil && dataplane.Spec.Deployment.PodTemplateSpec != nil {
 		proxyContainer := k8sutils.GetPodContainerByName(&dataplane.Spec.Deployment.PodTemplateSpec.Spec, consts.DataPlaneProxyContainerName)
-		if err := v.ValidateDataPlaneIngressServiceOptions(dataplane.Namespace, dataplane.Spec.Network.Services.Ingress, proxyContainer); err != nil {
-			return err
+		if dataplane.Spec.Network.Services.Ingress != nil {
+			if err := v.ValidateDataPlaneIngressServiceOptions(
+				dataplane.Namespace, dataplane.Spec.Network.Services.Ingress, proxyContainer, dataplane.Spec.KongConfig,
+			); err != nil {
+				return err
+			}
+		}
+		for _, additional := range dataplane.Spec.Network.Services.AdditionalIngresses {
+			if err := v.ValidateDataPlaneIngressServiceOptions(
+				dataplane.Namespace, &additional.DataPlaneServiceOptions, proxyContainer, dataplane.Spec.KongConfig,
+			); err != nil {
+				return fmt.Errorf("additional ingress service %s: %w", additional.Name, err)
+			}
 		}
 	}
 
@@ -130,36 +158,159 @@ func (v *Validator) ValidateDataPlaneDeploymentOptions(namespace string, opts *o
 	return nil
 }
 
+// ValidateDataPlaneDatabase validates spec.database of given DataPlane.
+// Database-backed DataPlanes can't use BlueGreen rollouts nor extensions yet.
+func (v *Validator) ValidateDataPlaneDatabase(dataplane *operatorv1beta1.DataPlane) error {
+	if dataplane.Spec.Database == nil {
+		return nil
+	}
+	if rollout := dataplane.Spec.Deployment.Rollout; rollout != nil && rollout.Strategy.BlueGreen != nil {
+		return errors.New("DataPlane with database cannot use BlueGreen rollout yet")
+	}
+	if len(dataplane.Spec.Extensions) > 0 {
+		return errors.New("DataPlane with database cannot use extensions")
+	}
+	return nil
+}
+
+// ValidateDataPlaneHybrid validates spec.hybrid of given DataPlane.
+// The control_plane role requires a database while the data_plane role
+// runs in DB-less mode. Hybrid mode cannot be combined with BlueGreen
+// rollouts nor with extensions, which configure the hybrid mode on their own.
+func (v *Validator) ValidateDataPlaneHybrid(dataplane *operatorv1beta1.DataPlane) error {
+	hybrid := dataplane.Spec.Hybrid
+	if hybrid == nil {
+		return nil
+	}
+	switch hybrid.Role {
+	case operatorv1beta1.DataPlaneHybridRoleControlPlane:
+		if dataplane.Spec.Database == nil {
+			return fmt.Errorf("DataPlane with the %s hybrid role requires a database", hybrid.Role)
+		}
+	case operatorv1beta1.DataPlaneHybridRoleDataPlane:
+		if dataplane.Spec.Database != nil {
+			return fmt.Errorf("DataPlane with the %s hybrid role cannot use a database", hybrid.Role)
+		}
+		if hybrid.ControlPlaneRef == nil {
+			return fmt.Errorf("DataPlane with the %s hybrid role requires controlPlaneRef", hybrid.Role)
+		}
+		if hybrid.ControlPlaneRef.Name == dataplane.Name {
+			return errors.New("DataPlane cannot reference itself as the hybrid control plane")
+		}
+	}
+	if rollout := dataplane.Spec.Deployment.Rollout; rollout != nil && rollout.Strategy.BlueGreen != nil {
+		return errors.New("DataPlane in hybrid mode cannot use BlueGreen rollout yet")
+	}
+	if len(dataplane.Spec.Extensions) > 0 {
+		return errors.New("DataPlane in hybrid mode cannot use extensions")
+	}
+	return nil
+}
+
+// ValidateDataPlanePatches validates spec.patches of given DataPlane.
+// Patches can only target the kinds of resources generated for DataPlanes.
+func (v *Validator) ValidateDataPlanePatches(patches []operatorv1beta1.ResourcePatch) error {
+	if err := k8sresources.ValidateResourcePatches(patches,
+		operatorv1beta1.ResourcePatchTargetKindDeployment,
+		operatorv1beta1.ResourcePatchTargetKindService,
+		operatorv1beta1.ResourcePatchTargetKindHorizontalPodAutoscaler,
+		operatorv1beta1.ResourcePatchTargetKindPodDisruptionBudget,
+		operatorv1beta1.ResourcePatchTargetKindNetworkPolicy,
+	); err != nil {
+		return fmt.Errorf("invalid DataPlane patches: %w", err)
+	}
+	return nil
+}
+
+// ValidateDataPlaneKongConfig validates spec.kongConfig of given DataPlane.
+// It rejects the configuration rendering environment variables which are also
+// set in the proxy container of the provided PodTemplateSpec.
+func (v *Validator) ValidateDataPlaneKongConfig(kongConfig *operatorv1beta1.KongConfig, podTemplateSpec *corev1.PodTemplateSpec) error {
+	if kongConfig == nil {
+		return nil
+	}
+
+	directives := make(map[string]struct{}, len(kongConfig.NginxDirectives))
+	for _, d := range kongConfig.NginxDirectives {
+		name := dputils.NginxDirectiveEnvVarName(d)
+		if _, ok := directives[name]; ok {
+			return fmt.Errorf("nginx directive %s is set more than once in the %s block", d.Name, d.Block)
+		}
+		directives[name] = struct{}{}
+	}
+
+	if podTemplateSpec == nil {
+		return nil
+	}
+	proxyContainer := k8sutils.GetPodContainerByName(&podTemplateSpec.Spec, consts.DataPlaneProxyContainerName)
+	if proxyContainer == nil {
+		return nil
+	}
+	for _, envVar := range dputils.ConfigureKongConfigEnvVars(kongConfig) {
+		if k8sutils.IsEnvVarPresent(envVar, proxyContainer.Env) {
+			return fmt.Errorf("environment variable %s of the proxy container conflicts with spec.kongConfig, only one of them can be set", envVar.Name)
+		}
+	}
+
+	return nil
+}
+
 // ValidateDataPlaneIngressServiceOptions validates spec.serviceOptions of given DataPlane.
+// The listeners and port maps set in the provided typed Kong configuration take
+// precedence over the environment variables of the proxy container.
 func (v *Validator) ValidateDataPlaneIngressServiceOptions(
-	namespace string, opts *operatorv1beta1.DataPlaneServiceOptions, proxyContainer *corev1.Container,
+	namespace string, opts *operatorv1beta1.DataPlaneServiceOptions, proxyContainer *corev1.Container, kongConfig *operatorv1beta1.KongConfig,
 ) error {
 	if len(opts.Ports) > 0 {
-		kongPortMaps, hasKongPortMaps, err := k8sutils.GetEnvValueFromContainer(context.Background(), proxyContainer, namespace, "KONG_PORT_MAPS", v.c)
-		if err != nil {
-			return err
-		}
-		kongProxyListen, hasProxyListen, err := k8sutils.GetEnvValueFromContainer(context.Background(), proxyContainer, namespace, "KONG_PROXY_LISTEN", v.c)
-		if err != nil {
-			return err
+		var listeners *operatorv1beta1.KongListenersConfig
+		if kongConfig != nil && kongConfig.Listeners != nil {
+			listeners = kongConfig.Listeners
 		}
 
-		var portNumberMap map[int32]int32 = make(map[int32]int32, 0)
-		if hasKongPortMaps {
-			portNumberMap, err = parseKongPortMaps(kongPortMaps)
+		var (
+			portNumberMap   = make(map[int32]int32, 0)
+			hasKongPortMaps bool
+		)
+		if listeners != nil && len(listeners.PortMaps) > 0 {
+			hasKongPortMaps = true
+			for _, pm := range listeners.PortMaps {
+				portNumberMap[pm.Port] = pm.TargetPort
+			}
+		} else {
+			kongPortMaps, found, err := k8sutils.GetEnvValueFromContainer(context.Background(), proxyContainer, namespace, "KONG_PORT_MAPS", v.c)
 			if err != nil {
 				return err
 			}
-
+			if found {
+				hasKongPortMaps = true
+				portNumberMap, err = parseKongPortMaps(kongPortMaps)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
-		var listenPortNumbers []int32 = make([]int32, 0)
-		if hasProxyListen {
-			listenPortNumbers, err = parseKongProxyListenPortNumbers(kongProxyListen)
+		var (
+			listenPortNumbers = make([]int32, 0)
+			hasProxyListen    bool
+		)
+		if listeners != nil && len(listeners.Proxy) > 0 {
+			hasProxyListen = true
+			for _, l := range listeners.Proxy {
+				listenPortNumbers = append(listenPortNumbers, l.Port)
+			}
+		} else {
+			kongProxyListen, found, err := k8sutils.GetEnvValueFromContainer(context.Background(), proxyContainer, namespace, "KONG_PROXY_LISTEN", v.c)
 			if err != nil {
 				return err
 			}
-
+			if found {
+				hasProxyListen = true
+				listenPortNumbers, err = parseKongProxyListenPortNumbers(kongProxyListen)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 		for _, port := range opts.Ports {
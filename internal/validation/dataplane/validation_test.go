@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -11,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	operatorv1alpha1 "github.com/kong/gateway-operator/api/v1alpha1"
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/pkg/consts"
 )
@@ -541,6 +543,101 @@ func TestDataPlaneIngressServiceOptions(t *testing.T) {
 			hasError: true,
 			errMsg:   "target port 8888 not included in KONG_PROXY_LISTEN",
 		},
+		{
+			msg: "dataplane with ingress service options matching kongConfig listeners should be valid",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-kong-config-listeners",
+					Namespace: "default",
+				},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+							DeploymentOptions: operatorv1beta1.DeploymentOptions{
+								PodTemplateSpec: &corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										Containers: []corev1.Container{
+											{
+												Name:  consts.DataPlaneProxyContainerName,
+												Image: consts.DefaultDataPlaneImage,
+											},
+										},
+									},
+								},
+							},
+						},
+						Network: operatorv1beta1.DataPlaneNetworkOptions{
+							Services: &operatorv1beta1.DataPlaneServices{
+								Ingress: &operatorv1beta1.DataPlaneServiceOptions{
+									Ports: []operatorv1beta1.DataPlaneServicePort{
+										{Name: "http", Port: int32(80), TargetPort: intstr.FromInt(8080)},
+									},
+								},
+							},
+						},
+						KongConfig: &operatorv1beta1.KongConfig{
+							Listeners: &operatorv1beta1.KongListenersConfig{
+								Proxy: []operatorv1beta1.KongListener{
+									{Port: 8080},
+								},
+								PortMaps: []operatorv1beta1.KongPortMap{
+									{Port: 80, TargetPort: 8080},
+								},
+							},
+						},
+					},
+				},
+			},
+			hasError: false,
+		},
+		{
+			msg: "dataplane with ingress service options having target port not in kongConfig proxy listeners should be invalid",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-kong-config-listeners",
+					Namespace: "default",
+				},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+							DeploymentOptions: operatorv1beta1.DeploymentOptions{
+								PodTemplateSpec: &corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										Containers: []corev1.Container{
+											{
+												Name:  consts.DataPlaneProxyContainerName,
+												Image: consts.DefaultDataPlaneImage,
+											},
+										},
+									},
+								},
+							},
+						},
+						Network: operatorv1beta1.DataPlaneNetworkOptions{
+							Services: &operatorv1beta1.DataPlaneServices{
+								Ingress: &operatorv1beta1.DataPlaneServiceOptions{
+									Ports: []operatorv1beta1.DataPlaneServicePort{
+										{Name: "http", Port: int32(80), TargetPort: intstr.FromInt(8080)},
+									},
+								},
+							},
+						},
+						KongConfig: &operatorv1beta1.KongConfig{
+							Listeners: &operatorv1beta1.KongListenersConfig{
+								Proxy: []operatorv1beta1.KongListener{
+									{Port: 8000},
+								},
+								PortMaps: []operatorv1beta1.KongPortMap{
+									{Port: 80, TargetPort: 8080},
+								},
+							},
+						},
+					},
+				},
+			},
+			hasError: true,
+			errMsg:   "target port 8080 not included in KONG_PROXY_LISTEN",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -824,3 +921,371 @@ func TestValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDataPlaneKongConfig(t *testing.T) {
+	podTemplateSpec := func(env ...corev1.EnvVar) *corev1.PodTemplateSpec {
+		return &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  consts.DataPlaneProxyContainerName,
+						Image: consts.DefaultDataPlaneImage,
+						Env:   env,
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		kongConfig      *operatorv1beta1.KongConfig
+		podTemplateSpec *corev1.PodTemplateSpec
+		errMsg          string
+	}{
+		{
+			name:            "kongConfig not set",
+			podTemplateSpec: podTemplateSpec(corev1.EnvVar{Name: "KONG_LOG_LEVEL", Value: "debug"}),
+		},
+		{
+			name: "kongConfig not conflicting with the proxy container env",
+			kongConfig: &operatorv1beta1.KongConfig{
+				LogLevel: lo.ToPtr(operatorv1beta1.KongLogLevelDebug),
+			},
+			podTemplateSpec: podTemplateSpec(corev1.EnvVar{Name: "KONG_DATABASE", Value: "off"}),
+		},
+		{
+			name: "kongConfig conflicting with the proxy container env",
+			kongConfig: &operatorv1beta1.KongConfig{
+				NginxDirectives: []operatorv1beta1.KongNginxDirective{
+					{Block: operatorv1beta1.KongNginxBlockHTTP, Name: "client_max_body_size", Value: "8m"},
+				},
+			},
+			podTemplateSpec: podTemplateSpec(corev1.EnvVar{Name: "KONG_NGINX_HTTP_CLIENT_MAX_BODY_SIZE", Value: "1m"}),
+			errMsg:          "environment variable KONG_NGINX_HTTP_CLIENT_MAX_BODY_SIZE of the proxy container conflicts with spec.kongConfig, only one of them can be set",
+		},
+		{
+			name: "nginx directive set twice in the same block",
+			kongConfig: &operatorv1beta1.KongConfig{
+				NginxDirectives: []operatorv1beta1.KongNginxDirective{
+					{Block: operatorv1beta1.KongNginxBlockProxy, Name: "proxy_buffer_size", Value: "8k"},
+					{Block: operatorv1beta1.KongNginxBlockProxy, Name: "proxy_buffer_size", Value: "16k"},
+				},
+			},
+			podTemplateSpec: podTemplateSpec(),
+			errMsg:          "nginx directive proxy_buffer_size is set more than once in the proxy block",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &Validator{
+				c: fakeclient.NewClientBuilder().Build(),
+			}
+			err := v.ValidateDataPlaneKongConfig(tc.kongConfig, tc.podTemplateSpec)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidateDataPlaneDatabase(t *testing.T) {
+	database := &operatorv1beta1.DataPlaneDatabaseOptions{
+		Postgres: operatorv1beta1.DataPlanePostgresOptions{
+			Host:                 "postgres.db.svc",
+			CredentialsSecretRef: operatorv1beta1.DataPlanePostgresCredentialsSecretRef{Name: "kong-pg-credentials"},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		dataplane *operatorv1beta1.DataPlane
+		errMsg    string
+	}{
+		{
+			name:      "database not set",
+			dataplane: &operatorv1beta1.DataPlane{},
+		},
+		{
+			name: "database set",
+			dataplane: &operatorv1beta1.DataPlane{
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Database: database,
+					},
+				},
+			},
+		},
+		{
+			name: "database set with BlueGreen rollout",
+			dataplane: &operatorv1beta1.DataPlane{
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Database: database,
+						Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+							Rollout: &operatorv1beta1.Rollout{
+								Strategy: operatorv1beta1.RolloutStrategy{
+									BlueGreen: &operatorv1beta1.BlueGreenStrategy{},
+								},
+							},
+						},
+					},
+				},
+			},
+			errMsg: "DataPlane with database cannot use BlueGreen rollout yet",
+		},
+		{
+			name: "database set with extensions",
+			dataplane: &operatorv1beta1.DataPlane{
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Database: database,
+						Extensions: []operatorv1alpha1.ExtensionRef{
+							{
+								Group: "gateway-operator.konghq.com",
+								Kind:  "KonnectExtension",
+								NamespacedRef: operatorv1alpha1.NamespacedRef{
+									Name: "konnect-extension",
+								},
+							},
+						},
+					},
+				},
+			},
+			errMsg: "DataPlane with database cannot use extensions",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &Validator{
+				c: fakeclient.NewClientBuilder().Build(),
+			}
+			err := v.ValidateDataPlaneDatabase(tc.dataplane)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidateDataPlaneHybrid(t *testing.T) {
+	database := &operatorv1beta1.DataPlaneDatabaseOptions{
+		Postgres: operatorv1beta1.DataPlanePostgresOptions{
+			Host:                 "postgres.db.svc",
+			CredentialsSecretRef: operatorv1beta1.DataPlanePostgresCredentialsSecretRef{Name: "kong-pg-credentials"},
+		},
+	}
+	controlPlane := &operatorv1beta1.DataPlaneHybridOptions{
+		Role: operatorv1beta1.DataPlaneHybridRoleControlPlane,
+	}
+	dataPlane := &operatorv1beta1.DataPlaneHybridOptions{
+		Role:            operatorv1beta1.DataPlaneHybridRoleDataPlane,
+		ControlPlaneRef: &operatorv1beta1.DataPlaneHybridControlPlaneRef{Name: "cp"},
+	}
+
+	testCases := []struct {
+		name      string
+		dataplane *operatorv1beta1.DataPlane
+		errMsg    string
+	}{
+		{
+			name:      "hybrid not set",
+			dataplane: &operatorv1beta1.DataPlane{},
+		},
+		{
+			name: "control plane with database",
+			dataplane: &operatorv1beta1.DataPlane{
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Database: database,
+						Hybrid:   controlPlane,
+					},
+				},
+			},
+		},
+		{
+			name: "control plane without database",
+			dataplane: &operatorv1beta1.DataPlane{
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Hybrid: controlPlane,
+					},
+				},
+			},
+			errMsg: "DataPlane with the control_plane hybrid role requires a database",
+		},
+		{
+			name: "data plane referencing a control plane",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "dp"},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Hybrid: dataPlane,
+					},
+				},
+			},
+		},
+		{
+			name: "data plane with database",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "dp"},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Database: database,
+						Hybrid:   dataPlane,
+					},
+				},
+			},
+			errMsg: "DataPlane with the data_plane hybrid role cannot use a database",
+		},
+		{
+			name: "data plane without controlPlaneRef",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "dp"},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Hybrid: &operatorv1beta1.DataPlaneHybridOptions{
+							Role: operatorv1beta1.DataPlaneHybridRoleDataPlane,
+						},
+					},
+				},
+			},
+			errMsg: "DataPlane with the data_plane hybrid role requires controlPlaneRef",
+		},
+		{
+			name: "data plane referencing itself",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "cp"},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Hybrid: dataPlane,
+					},
+				},
+			},
+			errMsg: "DataPlane cannot reference itself as the hybrid control plane",
+		},
+		{
+			name: "data plane with BlueGreen rollout",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "dp"},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Hybrid: dataPlane,
+						Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+							Rollout: &operatorv1beta1.Rollout{
+								Strategy: operatorv1beta1.RolloutStrategy{
+									BlueGreen: &operatorv1beta1.BlueGreenStrategy{},
+								},
+							},
+						},
+					},
+				},
+			},
+			errMsg: "DataPlane in hybrid mode cannot use BlueGreen rollout yet",
+		},
+		{
+			name: "data plane with extensions",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "dp"},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Hybrid: dataPlane,
+						Extensions: []operatorv1alpha1.ExtensionRef{
+							{
+								Group: "gateway-operator.konghq.com",
+								Kind:  "KonnectExtension",
+								NamespacedRef: operatorv1alpha1.NamespacedRef{
+									Name: "konnect-extension",
+								},
+							},
+						},
+					},
+				},
+			},
+			errMsg: "DataPlane in hybrid mode cannot use extensions",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &Validator{
+				c: fakeclient.NewClientBuilder().Build(),
+			}
+			err := v.ValidateDataPlaneHybrid(tc.dataplane)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidateDataPlanePatches(t *testing.T) {
+	testCases := []struct {
+		name    string
+		patches []operatorv1beta1.ResourcePatch
+		errMsg  string
+	}{
+		{
+			name: "no patches",
+		},
+		{
+			name: "valid patches",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "add", "path": "/spec/publishNotReadyAddresses", "value": true}]`,
+				},
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindNetworkPolicy},
+					Type:   operatorv1beta1.ResourcePatchTypeStrategicMerge,
+					Patch:  "metadata:\n  annotations:\n    team: gateway\n",
+				},
+			},
+		},
+		{
+			name: "unsupported target kind",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindClusterRole},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "remove", "path": "/rules/0"}]`,
+				},
+			},
+			errMsg: `invalid DataPlane patches: patch 0: unsupported target kind "ClusterRole"`,
+		},
+		{
+			name: "invalid JSON patch operation",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindDeployment},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "merge", "path": "/spec"}]`,
+				},
+			},
+			errMsg: `invalid DataPlane patches: patch 0: invalid JSON patch: invalid operation {"op":"merge","path":"/spec"}: unsupported operation`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &Validator{
+				c: fakeclient.NewClientBuilder().Build(),
+			}
+			err := v.ValidateDataPlanePatches(tc.patches)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.errMsg)
+			}
+		})
+	}
+}
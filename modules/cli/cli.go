@@ -41,11 +41,15 @@ func New(m metadata.Info) *CLI {
 	flagSet.BoolVar(&cfg.ControlPlaneControllerEnabled, "enable-controller-controlplane", true, "Enable the ControlPlane controller.")
 	flagSet.BoolVar(&cfg.DataPlaneControllerEnabled, "enable-controller-dataplane", true, "Enable the DataPlane controller.")
 	flagSet.BoolVar(&cfg.DataPlaneBlueGreenControllerEnabled, "enable-controller-dataplane-bluegreen", true, "Enable the DataPlane BlueGreen controller. Mutually exclusive with DataPlane controller.")
+	flagSet.BoolVar(&cfg.DataPlaneConfigurationSyncStatusEnabled, "enable-dataplane-configuration-sync-status", false, "Enable reporting the configuration hash loaded by each DataPlane Pod, retrieved periodically from its Admin API, in the DataPlane's status.")
 
 	// controllers for specialized APIs and features
 	flagSet.BoolVar(&cfg.AIGatewayControllerEnabled, "enable-controller-aigateway", false, "Enable the AIGateway controller. (Experimental).")
 	flagSet.BoolVar(&cfg.KongPluginInstallationControllerEnabled, "enable-controller-kongplugininstallation", false, "Enable the KongPluginInstallation controller.")
 	flagSet.DurationVar(&cfg.KonnectSyncPeriod, "konnect-sync-period", consts.DefaultKonnectSyncPeriod, "Sync period for Konnect entities. After a successful reconciliation of Konnect entities the controller will wait this duration before enforcing configuration on Konnect once again.")
+	flagSet.Float64Var(&cfg.KonnectAPIRateLimit, "konnect-api-rate-limit", consts.DefaultKonnectAPIRateLimit, "Maximum number of requests per second sent to Konnect API for a single organization. Throttled requests are retried at the time indicated by Konnect.")
+	flagSet.IntVar(&cfg.KonnectAPIBurst, "konnect-api-burst", consts.DefaultKonnectAPIBurst, "Maximum number of requests sent to Konnect API at once for a single organization.")
+	flagSet.DurationVar(&cfg.KonnectAPITokenExpiryWarningPeriod, "konnect-api-token-expiry-warning-period", consts.DefaultKonnectAPITokenExpiryWarningPeriod, "Period before the expiry of a Konnect API token in which the operator sets the TokenExpiring condition on KonnectAPIAuthConfigurations and emits warning events.")
 
 	// controllers for Konnect APIs
 	flagSet.BoolVar(&cfg.KonnectControllersEnabled, "enable-controller-konnect", false, "Enable the Konnect controllers.")
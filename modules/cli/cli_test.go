@@ -162,8 +162,12 @@ func expectedDefaultCfg() manager.Config {
 		ControlPlaneControllerEnabled:           true,
 		DataPlaneControllerEnabled:              true,
 		DataPlaneBlueGreenControllerEnabled:     true,
+		DataPlaneConfigurationSyncStatusEnabled: false,
 		KonnectControllersEnabled:               false,
 		KonnectSyncPeriod:                       consts.DefaultKonnectSyncPeriod,
+		KonnectAPIRateLimit:                     consts.DefaultKonnectAPIRateLimit,
+		KonnectAPIBurst:                         consts.DefaultKonnectAPIBurst,
+		KonnectAPITokenExpiryWarningPeriod:      consts.DefaultKonnectAPITokenExpiryWarningPeriod,
 		KongPluginInstallationControllerEnabled: false,
 		ValidatingWebhookEnabled:                true,
 		WebhookCertificateConfigBaseImage:       consts.WebhookCertificateConfigBaseImage,
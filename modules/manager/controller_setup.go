@@ -9,6 +9,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -48,6 +49,8 @@ const (
 	DataPlaneControllerName = "DataPlane"
 	// DataPlaneBlueGreenControllerName is the name of the DataPlaneBlueGreen controller.
 	DataPlaneBlueGreenControllerName = "DataPlaneBlueGreen"
+	// DataPlaneConfigurationSyncStatusControllerName is the name of the DataPlane configuration sync status updater.
+	DataPlaneConfigurationSyncStatusControllerName = "DataPlaneConfigurationSyncStatus"
 	// DataPlaneOwnedServiceFinalizerControllerName is the name of the DataPlaneOwnedServiceFinalizer controller.
 	DataPlaneOwnedServiceFinalizerControllerName = "DataPlaneOwnedServiceFinalizer"
 	// DataPlaneOwnedSecretFinalizerControllerName is the name of the DataPlaneOwnedSecretFinalizer controller.
@@ -76,6 +79,8 @@ const (
 	KongPluginBindingControllerName = "KongPluginBinding"
 	// KongPluginControllerName is the name of the KongPlugin controller.
 	KongPluginControllerName = "KongPlugin"
+	// KongClusterPluginControllerName is the name of the KongClusterPlugin controller.
+	KongClusterPluginControllerName = "KongClusterPlugin"
 	// KongUpstreamControllerName is the name of the KongUpstream controller.
 	KongUpstreamControllerName = "KongUpstream"
 	// KongTargetControllerName is the name of the KongTarget controller.
@@ -114,6 +119,10 @@ const (
 	KongSNIControllerName = "KongSNI"
 	// KongDataPlaneClientCertificateControllerName is the name of KongDataPlaneClientCertificate controller.
 	KongDataPlaneClientCertificateControllerName = "KongDataPlaneClientCertificate"
+	// KonnectBulkSyncControllerName is the name of the KonnectBulkSync controller.
+	KonnectBulkSyncControllerName = "KonnectBulkSync"
+	// KonnectDataPlaneNodesControllerName is the name of the KonnectDataPlaneNodes controller.
+	KonnectDataPlaneNodesControllerName = "KonnectDataPlaneNodes"
 )
 
 // SetupControllersShim runs SetupControllers and returns its result as a slice of the map values.
@@ -180,6 +189,9 @@ func setupIndexes(ctx context.Context, mgr manager.Manager, cfg Config) error {
 		if err := index.DataPlaneOnDataPlaneKonnecExtension(ctx, mgr.GetCache()); err != nil {
 			return fmt.Errorf("failed to setup index for DataPlanes on KonnectExtensions: %w", err)
 		}
+		if err := index.HybridControlPlaneOnDataPlane(ctx, mgr.GetCache()); err != nil {
+			return fmt.Errorf("failed to setup index for hybrid control planes on DataPlane: %w", err)
+		}
 	}
 	return nil
 }
@@ -325,6 +337,11 @@ func SetupControllers(mgr manager.Manager, c *Config) (map[string]ControllerDef,
 					Version:  configurationv1.SchemeGroupVersion.Version,
 					Resource: "kongplugins",
 				},
+				{
+					Group:    configurationv1.SchemeGroupVersion.Group,
+					Version:  configurationv1.SchemeGroupVersion.Version,
+					Resource: "kongclusterplugins",
+				},
 				{
 					Group:    configurationv1alpha1.SchemeGroupVersion.Group,
 					Version:  configurationv1alpha1.SchemeGroupVersion.Version,
@@ -452,6 +469,20 @@ func SetupControllers(mgr manager.Manager, c *Config) (map[string]ControllerDef,
 				DefaultImage: consts.DefaultDataPlaneImage,
 			},
 		},
+		// DataPlane configuration sync status
+		DataPlaneConfigurationSyncStatusControllerName: {
+			Enabled: c.DataPlaneConfigurationSyncStatusEnabled &&
+				(c.DataPlaneControllerEnabled || c.GatewayControllerEnabled || c.DataPlaneBlueGreenControllerEnabled),
+			Controller: dataplane.NewConfigurationSyncStatusUpdater(
+				mgr.GetClient(),
+				mgr.GetAPIReader(),
+				dataplane.NewAdminAPIConfigurationHashGetter(mgr.GetClient(), types.NamespacedName{
+					Namespace: c.ClusterCASecretNamespace,
+					Name:      c.ClusterCASecretName,
+				}),
+				c.DevelopmentMode,
+			),
+		},
 		DataPlaneOwnedServiceFinalizerControllerName: {
 			Enabled: c.DataPlaneControllerEnabled || c.DataPlaneBlueGreenControllerEnabled,
 			Controller: dataplane.NewDataPlaneOwnedResourceFinalizerReconciler[corev1.Service](
@@ -505,8 +536,16 @@ func SetupControllers(mgr manager.Manager, c *Config) (map[string]ControllerDef,
 		if err := SetupCacheIndicesForKonnectTypes(ctx, mgr, c.DevelopmentMode); err != nil {
 			return nil, err
 		}
+		if err := konnect.RegisterEntitiesMetrics(mgr.GetClient()); err != nil {
+			return nil, fmt.Errorf("failed to register Konnect entities metrics: %w", err)
+		}
 
-		sdkFactory := konnectops.NewSDKFactory()
+		sdkFactory := konnectops.NewSDKFactory(
+			konnectops.WithRateLimiterConfig(konnectops.RateLimiterConfig{
+				Limit: c.KonnectAPIRateLimit,
+				Burst: c.KonnectAPIBurst,
+			}),
+		)
 		konnectControllers := map[string]ControllerDef{
 			KonnectAPIAuthConfigurationControllerName: {
 				Enabled: c.KonnectControllersEnabled,
@@ -514,6 +553,7 @@ func SetupControllers(mgr manager.Manager, c *Config) (map[string]ControllerDef,
 					sdkFactory,
 					c.DevelopmentMode,
 					mgr.GetClient(),
+					konnect.WithTokenExpiryWarningPeriod(c.KonnectAPITokenExpiryWarningPeriod),
 				),
 			},
 			KonnectGatewayControlPlaneControllerName: {
@@ -525,6 +565,24 @@ func SetupControllers(mgr manager.Manager, c *Config) (map[string]ControllerDef,
 					konnect.WithKonnectEntitySyncPeriod[konnectv1alpha1.KonnectGatewayControlPlane](c.KonnectSyncPeriod),
 				),
 			},
+			KonnectBulkSyncControllerName: {
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewKonnectBulkSyncReconciler(
+					sdkFactory,
+					c.DevelopmentMode,
+					mgr.GetClient(),
+					c.KonnectSyncPeriod,
+				),
+			},
+			KonnectDataPlaneNodesControllerName: {
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewKonnectDataPlaneNodesReconciler(
+					sdkFactory,
+					c.DevelopmentMode,
+					mgr.GetClient(),
+					c.KonnectSyncPeriod,
+				),
+			},
 			KongServiceControllerName: {
 				Enabled: c.KonnectControllersEnabled,
 				Controller: konnect.NewKonnectEntityReconciler(
@@ -685,6 +743,13 @@ func SetupControllers(mgr manager.Manager, c *Config) (map[string]ControllerDef,
 					mgr.GetClient(),
 				),
 			},
+			KongClusterPluginControllerName: {
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewKongClusterPluginReconciler(
+					c.DevelopmentMode,
+					mgr.GetClient(),
+				),
+			},
 			KongVaultControllerName: {
 				Enabled: c.KonnectControllersEnabled,
 				Controller: konnect.NewKonnectEntityReconciler(
@@ -78,15 +78,19 @@ type Config struct {
 	LoggerOpts               *zap.Options
 
 	// controllers for standard APIs and features
-	GatewayControllerEnabled            bool
-	ControlPlaneControllerEnabled       bool
-	DataPlaneControllerEnabled          bool
-	DataPlaneBlueGreenControllerEnabled bool
+	GatewayControllerEnabled                bool
+	ControlPlaneControllerEnabled           bool
+	DataPlaneControllerEnabled              bool
+	DataPlaneBlueGreenControllerEnabled     bool
+	DataPlaneConfigurationSyncStatusEnabled bool
 
 	// Controllers for specialty APIs and experimental features.
 	AIGatewayControllerEnabled              bool
 	KongPluginInstallationControllerEnabled bool
 	KonnectSyncPeriod                       time.Duration
+	KonnectAPIRateLimit                     float64
+	KonnectAPIBurst                         int
+	KonnectAPITokenExpiryWarningPeriod      time.Duration
 
 	// Controllers for Konnect APIs.
 	KonnectControllersEnabled bool
@@ -76,10 +76,31 @@ const (
 	// that is used to indicate that a Service is a webhook service.
 	ControlPlaneServiceKindWebhook = "webhook"
 
+	// ControlPlaneServiceKindMetrics is the value for the ControlPlaneServiceLabel
+	// that is used to indicate that a Service is a metrics service.
+	ControlPlaneServiceKindMetrics = "metrics"
+
 	// CertPurposeLabel indicates the purpose of a certificate.
 	CertPurposeLabel = OperatorLabelPrefix + "cert-purpose"
 )
 
+// -----------------------------------------------------------------------------
+// Consts - Standard Kubernetes Object Annotations
+// -----------------------------------------------------------------------------
+
+const (
+	// ReconcileAnnotation is the annotation that can be set on objects reconciled
+	// by the operator to control their reconciliation.
+	// When set to ReconcileAnnotationValuePaused the operator does not perform any
+	// changes in Konnect nor to the resources it manages for the object, until
+	// the annotation is removed.
+	ReconcileAnnotation = OperatorAnnotationPrefix + "reconcile"
+
+	// ReconcileAnnotationValuePaused is the value of ReconcileAnnotation which
+	// pauses the reconciliation of the object.
+	ReconcileAnnotationValuePaused = "paused"
+)
+
 // -----------------------------------------------------------------------------
 // Consts - Names and Paths for Shared Resources
 // -----------------------------------------------------------------------------
@@ -93,6 +114,15 @@ const (
 	// volume will be mounted.
 	ClusterCertificateVolumeMountPath = "/var/cluster-certificate"
 
+	// HybridClusterCertificateVolume is the name of the volume that holds the
+	// certificate and keys which are used for the Kong Gateway hybrid mode
+	// communication between control plane and data plane DataPlanes.
+	HybridClusterCertificateVolume = "hybrid-cluster-certificate"
+
+	// HybridClusterCertificateVolumeMountPath holds the path where the hybrid
+	// cluster certificate volume will be mounted.
+	HybridClusterCertificateVolumeMountPath = "/var/hybrid-cluster-certificate"
+
 	// TLSCRT is the filename for the tls.crt.
 	TLSCRT = "tls.crt"
 
@@ -169,4 +199,16 @@ const (
 const (
 	// DefaultKonnectSyncPeriod is the default sync period for Konnect entities.
 	DefaultKonnectSyncPeriod = time.Minute
+
+	// DefaultKonnectAPIRateLimit is the default number of requests per second
+	// which can be sent to Konnect API for a single organization.
+	DefaultKonnectAPIRateLimit = 10
+
+	// DefaultKonnectAPIBurst is the default maximum number of requests which
+	// can be sent to Konnect API at once for a single organization.
+	DefaultKonnectAPIBurst = 20
+
+	// DefaultKonnectAPITokenExpiryWarningPeriod is the default period before
+	// the expiry of a Konnect token in which the operator warns about it.
+	DefaultKonnectAPITokenExpiryWarningPeriod = 7 * 24 * time.Hour
 )
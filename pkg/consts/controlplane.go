@@ -62,5 +62,35 @@ const (
 	ControlPlaneAdmissionWebhookVolumeMountPath = "/admission-webhook"
 )
 
+// -----------------------------------------------------------------------------
+// Consts - ControlPlane metrics-related parameters
+// -----------------------------------------------------------------------------
+
+const (
+	// ControlPlaneMetricsPortName is the name of the port on which the control plane exposes its metrics.
+	ControlPlaneMetricsPortName = "metrics"
+	// ControlPlaneMetricsPort is the port on which the control plane exposes its metrics.
+	ControlPlaneMetricsPort = 10255
+)
+
+// -----------------------------------------------------------------------------
+// Consts - ControlPlane Kong configuration parameters
+// -----------------------------------------------------------------------------
+
+const (
+	// DefaultControlPlaneIngressClass is the ingress class used by the control plane
+	// when no other is configured through the CONTROLLER_INGRESS_CLASS env var.
+	DefaultControlPlaneIngressClass = "kong"
+	// IngressClassAnnotation is the annotation which indicates the ingress class
+	// of the objects processed by the control plane.
+	IngressClassAnnotation = "kubernetes.io/ingress.class"
+	// KongGlobalPluginLabel is the label which makes the control plane configure
+	// a KongClusterPlugin as a global plugin.
+	KongGlobalPluginLabel = "global"
+	// OpenTelemetryPluginName is the name of the Kong plugin sending traces to
+	// an OpenTelemetry collector.
+	OpenTelemetryPluginName = "opentelemetry"
+)
+
 // TODO: https://github.com/Kong/gateway-operator/issues/141
 // Extract as constants all the Env var Keys used to configure the ControlPlane.
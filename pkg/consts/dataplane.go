@@ -22,6 +22,11 @@ const (
 	// the DataPlane controller to expose the DataPlane deployment.
 	DataPlaneServiceTypeLabel = "gateway-operator.konghq.com/dataplane-service-type"
 
+	// DataPlaneIngressServiceNameLabel is the label that is used to identify
+	// the additional ingress Services created by the DataPlane controller by
+	// their name from the DataPlane spec.
+	DataPlaneIngressServiceNameLabel = "gateway-operator.konghq.com/dataplane-ingress-service-name"
+
 	// DataPlaneServiceTypeLabelLegacy is the legacy label that is used for the services created by
 	// the DataPlane controller to expose the DataPlane deployment.
 	DataPlaneServiceTypeLabelLegacy = "konghq.com/dataplane-service-type"
@@ -40,6 +45,19 @@ const (
 	// shall be removed. This guarantees no interference with annotations from other sources (e.g. users).
 	AnnotationLastAppliedAnnotations = "gateway-operator.konghq.com/last-applied-annotations"
 
+	// DataPlaneMigrationPhaseLabel is the label that is used for the Jobs created by
+	// the DataPlane controller to run the database migrations. Its value is the
+	// phase of the migrations run by the Job.
+	DataPlaneMigrationPhaseLabel = "gateway-operator.konghq.com/dataplane-migration-phase"
+
+	// AnnotationDataPlaneMigrationImage is the annotation key to store the Kong Gateway
+	// image the database migrations Job runs the migrations of.
+	AnnotationDataPlaneMigrationImage = "gateway-operator.konghq.com/dataplane-migration-image"
+
+	// DataPlaneHybridCertificateLabel is the label that is used for the Secrets
+	// holding the hybrid mode cluster certificate of a DataPlane.
+	DataPlaneHybridCertificateLabel = "gateway-operator.konghq.com/dataplane-hybrid-certificate"
+
 	// DataPlanePodStateLabel indicates the state of a DataPlane Pod.
 	// Useful for progressive rollouts.
 	DataPlanePodStateLabel = "gateway-operator.konghq.com/dataplane-pod-state"
@@ -66,6 +84,18 @@ const (
 	// DataPlane proxy.
 	DataPlaneIngressServiceLabelValue ServiceType = "ingress"
 
+	// DataPlaneAdditionalIngressServiceLabelValue indicates that the service is
+	// one of the additional ingress services intended to expose the DataPlane proxy.
+	DataPlaneAdditionalIngressServiceLabelValue ServiceType = "additional-ingress"
+
+	// DataPlaneMetricsServiceLabelValue indicates that the service is intended to expose the
+	// DataPlane metrics.
+	DataPlaneMetricsServiceLabelValue ServiceType = "metrics"
+
+	// DataPlaneClusterServiceLabelValue indicates that the service is intended to expose the
+	// hybrid mode cluster and telemetry endpoints of a control plane DataPlane.
+	DataPlaneClusterServiceLabelValue ServiceType = "cluster"
+
 	// DataPlaneProxyServiceLabelValue is the legacy label value which indicates
 	// that the service is inteded to expose the DataPlane proxy.
 	DataPlaneProxyServiceLabelValueLegacy ServiceType = "proxy"
@@ -110,6 +140,10 @@ const (
 	// DataPlaneProxyContainerName is the name of the Kong proxy container
 	DataPlaneProxyContainerName = "proxy"
 
+	// DataPlaneMigrationsContainerName is the name of the container running
+	// the Kong Gateway database migrations.
+	DataPlaneMigrationsContainerName = "kong-migrations"
+
 	// DataPlaneReadyEndpoint is the endpoint to use for DataPlane readiness probe.
 	DataPlaneStatusEndpoint = "/status"
 
@@ -145,6 +179,14 @@ const (
 
 	// DefaultKongStatusPort is the port that the dataplane uses for status.
 	DataPlaneStatusPort = 8100
+
+	// DataPlaneClusterPort is the port that a control plane dataplane uses
+	// for the hybrid mode cluster communication.
+	DataPlaneClusterPort = 8005
+
+	// DataPlaneClusterTelemetryPort is the port that a control plane dataplane
+	// uses for the hybrid mode telemetry.
+	DataPlaneClusterTelemetryPort = 8006
 )
 
 // -----------------------------------------------------------------------------
@@ -154,6 +196,12 @@ const (
 	// DataPlaneAdminServicePortName is the port name of the DataPlane admin service.
 	DataPlaneAdminServicePortName = "admin"
 
+	// DataPlaneClusterServicePortName is the cluster port name of the DataPlane cluster service.
+	DataPlaneClusterServicePortName = "cluster"
+
+	// DataPlaneClusterTelemetryServicePortName is the telemetry port name of the DataPlane cluster service.
+	DataPlaneClusterTelemetryServicePortName = "telemetry"
+
 	// DataPlanePODDNSDiscoveryStrategy is DNS strategy to use when creating Gateway's Admin API addresses.
 	DataPlaneServiceDNSDiscoveryStrategy = "service"
 )
@@ -164,9 +212,13 @@ const (
 
 const (
 	// EnvVarKongDatabase is the environment variable name to specify database
-	// backend used for dataplane(Kong gateway). Currently only DBLess mode
-	// (empty, or "off") is supported.
+	// backend used for dataplane(Kong gateway). Only DBLess mode (empty, or "off")
+	// can be set through the environment variables, the database backend is
+	// configured through the DataPlane's spec.database.
 	EnvVarKongDatabase = "KONG_DATABASE"
+	// EnvVarKongPlugins is the environment variable name to specify the plugins
+	// enabled in the dataplane(Kong gateway).
+	EnvVarKongPlugins = "KONG_PLUGINS"
 )
 
 // -----------------------------------------------------------------------------
@@ -0,0 +1,32 @@
+package consts
+
+const (
+	// DataPlaneConditionTypeConfigurationSynced is a condition type indicating
+	// whether or not, all the ready Pods of a DataPlane have loaded the same
+	// configuration, as reported by the `/status` endpoint of their Admin API.
+	DataPlaneConditionTypeConfigurationSynced ConditionType = "ConfigurationSynced"
+)
+
+const (
+	// DataPlaneConditionReasonConfigurationSynced is a reason which indicates
+	// all the ready Pods of a DataPlane have loaded the same configuration.
+	DataPlaneConditionReasonConfigurationSynced ConditionReason = "ConfigurationSynced"
+
+	// DataPlaneConditionReasonConfigurationDiverged is a reason which indicates
+	// some of the ready Pods of a DataPlane have loaded a configuration which
+	// differs from the one loaded by the majority of the Pods.
+	DataPlaneConditionReasonConfigurationDiverged ConditionReason = "ConfigurationDiverged"
+
+	// DataPlaneConditionReasonConfigurationNotLoaded is a reason which indicates
+	// some of the ready Pods of a DataPlane have not loaded any configuration yet.
+	DataPlaneConditionReasonConfigurationNotLoaded ConditionReason = "ConfigurationNotLoaded"
+
+	// DataPlaneConditionReasonConfigurationStatusUnavailable is a reason which
+	// indicates the status of some of the ready Pods of a DataPlane couldn't
+	// be retrieved from their Admin API.
+	DataPlaneConditionReasonConfigurationStatusUnavailable ConditionReason = "StatusUnavailable"
+
+	// DataPlaneConditionReasonConfigurationNoReadyPods is a reason which indicates
+	// a DataPlane has no ready Pods whose configuration could be checked.
+	DataPlaneConditionReasonConfigurationNoReadyPods ConditionReason = "NoReadyPods"
+)
@@ -0,0 +1,23 @@
+package consts
+
+const (
+	// DataPlaneConditionTypeDatabaseMigrated is a condition type indicating whether
+	// or not, the database migrations of the DataPlane's Kong Gateway version
+	// have been completed.
+	DataPlaneConditionTypeDatabaseMigrated ConditionType = "DatabaseMigrated"
+)
+
+const (
+	// DataPlaneConditionReasonMigrationsInProgress is a reason which indicates
+	// the database migrations Jobs of a DataPlane are running or waiting for
+	// the DataPlane's Deployment to be rolled out.
+	DataPlaneConditionReasonMigrationsInProgress ConditionReason = "MigrationsInProgress"
+
+	// DataPlaneConditionReasonMigrationsFailed is a reason which indicates
+	// a database migrations Job of a DataPlane has failed.
+	DataPlaneConditionReasonMigrationsFailed ConditionReason = "MigrationsFailed"
+
+	// DataPlaneConditionReasonMigrationsCompleted is a reason which indicates
+	// all the database migrations of a DataPlane have been completed.
+	DataPlaneConditionReasonMigrationsCompleted ConditionReason = "MigrationsCompleted"
+)
@@ -0,0 +1,28 @@
+package consts
+
+const (
+	// DataPlaneConditionTypeHybridControlPlaneResolved is a condition type indicating
+	// whether or not, the control plane DataPlane referenced by a DataPlane with
+	// the data_plane hybrid mode role has been resolved.
+	DataPlaneConditionTypeHybridControlPlaneResolved ConditionType = "HybridControlPlaneResolved"
+)
+
+const (
+	// DataPlaneConditionReasonHybridControlPlaneResolved is a reason which indicates
+	// the referenced control plane DataPlane has been resolved and its cluster
+	// Service is used to connect to it.
+	DataPlaneConditionReasonHybridControlPlaneResolved ConditionReason = "ControlPlaneResolved"
+
+	// DataPlaneConditionReasonHybridControlPlaneNotFound is a reason which indicates
+	// the referenced control plane DataPlane does not exist.
+	DataPlaneConditionReasonHybridControlPlaneNotFound ConditionReason = "ControlPlaneNotFound"
+
+	// DataPlaneConditionReasonHybridControlPlaneInvalidRole is a reason which indicates
+	// the referenced DataPlane doesn't have the control_plane hybrid mode role.
+	DataPlaneConditionReasonHybridControlPlaneInvalidRole ConditionReason = "InvalidControlPlaneRole"
+
+	// DataPlaneConditionReasonHybridClusterServiceNotReady is a reason which indicates
+	// the cluster Service of the referenced control plane DataPlane has not been
+	// created yet.
+	DataPlaneConditionReasonHybridClusterServiceNotReady ConditionReason = "ClusterServiceNotReady"
+)
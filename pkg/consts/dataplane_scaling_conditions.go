@@ -0,0 +1,23 @@
+package consts
+
+const (
+	// DataPlaneConditionTypeEventDrivenScaling is a condition type indicating
+	// whether or not, the KEDA ScaledObject scaling a DataPlane configured with
+	// event-driven scaling has been provisioned.
+	DataPlaneConditionTypeEventDrivenScaling ConditionType = "EventDrivenScaling"
+)
+
+const (
+	// DataPlaneConditionReasonScaledObjectProvisioned is a reason which indicates
+	// the KEDA ScaledObject scaling a DataPlane has been provisioned.
+	DataPlaneConditionReasonScaledObjectProvisioned ConditionReason = "ScaledObjectProvisioned"
+
+	// DataPlaneConditionReasonKEDANotInstalled is a reason which indicates
+	// the KEDA ScaledObject scaling a DataPlane couldn't be provisioned because
+	// the KEDA CRDs are not installed in the cluster.
+	DataPlaneConditionReasonKEDANotInstalled ConditionReason = "KEDANotInstalled"
+
+	// DataPlaneConditionReasonScaledObjectNotProvisioned is a reason which indicates
+	// the KEDA ScaledObject scaling a DataPlane couldn't be created or updated.
+	DataPlaneConditionReasonScaledObjectNotProvisioned ConditionReason = "ScaledObjectNotProvisioned"
+)
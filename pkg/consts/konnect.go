@@ -12,3 +12,56 @@ const (
 	// It avoids that KongPlugins get deleted when KongPluginBindings are still referencing them.
 	PluginInUseFinalizer = "gateway.konghq.com/plugin-in-use"
 )
+
+const (
+	// DataPlaneNodesSyncedType is the type of the condition set on KonnectGatewayControlPlanes
+	// which reports the number of data plane nodes connected to the ControlPlane
+	// and whether they applied its latest configuration.
+	DataPlaneNodesSyncedType ConditionType = "DataPlaneNodesSynced"
+
+	// DataPlaneNodesSyncedReason is the reason set for the DataPlaneNodesSynced
+	// condition when all the connected data plane nodes applied the latest configuration.
+	DataPlaneNodesSyncedReason ConditionReason = "Synced"
+	// DataPlaneNodesOutOfSyncReason is the reason set for the DataPlaneNodesSynced
+	// condition when some of the connected data plane nodes did not apply the latest configuration.
+	DataPlaneNodesOutOfSyncReason ConditionReason = "OutOfSync"
+	// NoDataPlaneNodesConnectedReason is the reason set for the DataPlaneNodesSynced
+	// condition when no data plane nodes are connected to the ControlPlane.
+	NoDataPlaneNodesConnectedReason ConditionReason = "NoNodesConnected"
+)
+
+const (
+	// KonnectConnectedType is the type of the condition set on DataPlanes using
+	// a KonnectExtension which reports whether their Pods are connected to the
+	// Konnect ControlPlane as data plane nodes.
+	// The condition is informational and it is not taken into account when
+	// computing the DataPlane's readiness.
+	KonnectConnectedType ConditionType = "KonnectConnected"
+
+	// KonnectConnectedReason is the reason set for the KonnectConnected condition
+	// when all the ready Pods of the DataPlane are connected to Konnect.
+	KonnectConnectedReason ConditionReason = "Connected"
+	// KonnectNotConnectedReason is the reason set for the KonnectConnected condition
+	// when some of the ready Pods of the DataPlane are not connected to Konnect.
+	KonnectNotConnectedReason ConditionReason = "NotConnected"
+)
+
+const (
+	// KonnectAPIAuthTokenExpiringType is the type of the condition set on
+	// KonnectAPIAuthConfigurations which warns that the Konnect token expires
+	// soon or has already expired. It is only set when the token's expiry is known.
+	KonnectAPIAuthTokenExpiringType ConditionType = "TokenExpiring"
+
+	// KonnectAPIAuthTokenExpiringSoonReason is the reason set for the TokenExpiring
+	// condition when the token expires within the expiry warning period.
+	KonnectAPIAuthTokenExpiringSoonReason ConditionReason = "ExpiringSoon"
+	// KonnectAPIAuthTokenExpiredReason is the reason set for the TokenExpiring
+	// condition when the token has expired.
+	KonnectAPIAuthTokenExpiredReason ConditionReason = "Expired"
+	// KonnectAPIAuthTokenNotExpiringSoonReason is the reason set for the TokenExpiring
+	// condition when the token does not expire within the expiry warning period.
+	KonnectAPIAuthTokenNotExpiringSoonReason ConditionReason = "NotExpiringSoon"
+	// KonnectAPIAuthTokenExpiryUnknownReason is the reason set for the TokenExpiring
+	// condition when the token's expiry couldn't be determined.
+	KonnectAPIAuthTokenExpiryUnknownReason ConditionReason = "ExpiryUnknown"
+)
@@ -0,0 +1,7 @@
+package consts
+
+const (
+	// KonnectExtensionManagedLabelValue indicates that an object's lifecycle is managed
+	// by the KonnectExtension controller.
+	KonnectExtensionManagedLabelValue = "konnect-extension"
+)
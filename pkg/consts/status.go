@@ -52,3 +52,21 @@ const (
 	// InvalidSecretRefReason is a generic reason describing that the secret reference is invalid. It must be used when the ResolvedRefs condition is set to False.
 	InvalidSecretRefReason ConditionReason = "InvalidSecret"
 )
+
+// -----------------------------------------------------------------------------
+// Paused Condition Constants
+// -----------------------------------------------------------------------------
+
+const (
+	// PausedType indicates that the reconciliation of the resource is paused
+	// with the gateway-operator.konghq.com/reconcile annotation.
+	PausedType ConditionType = "Paused"
+
+	// ReconciliationPausedReason is the reason used with the Paused condition
+	// when the reconciliation is paused.
+	ReconciliationPausedReason ConditionReason = "ReconciliationPaused"
+
+	// ReconciliationPausedMessage is the message used with the Paused condition
+	// when the reconciliation is paused.
+	ReconciliationPausedMessage = "Reconciliation is paused with the " + ReconcileAnnotation + " annotation"
+)
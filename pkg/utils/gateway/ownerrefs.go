@@ -31,12 +31,26 @@ func ListDataPlanesForGateway(
 		return nil, fmt.Errorf("can't list dataplanes for gateway: gateway resource was missing namespace")
 	}
 
+	return ListDataPlanesForOwner(ctx, c, gateway.Namespace, gateway.UID)
+}
+
+// ListDataPlanesForOwner is a helper function to map a list of DataPlanes
+// in the provided namespace that are managed by the Gateway controller
+// and owned by the object with the provided UID.
+// The owner is either a Gateway or, for DataPlanes shared by multiple Gateways,
+// their GatewayClass.
+func ListDataPlanesForOwner(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	ownerUID types.UID,
+) ([]operatorv1beta1.DataPlane, error) {
 	dataplaneList := &operatorv1beta1.DataPlaneList{}
 
 	err := c.List(
 		ctx,
 		dataplaneList,
-		client.InNamespace(gateway.Namespace),
+		client.InNamespace(namespace),
 		client.MatchingLabels{consts.GatewayOperatorManagedByLabel: consts.GatewayManagedLabelValue},
 	)
 	if err != nil {
@@ -45,7 +59,7 @@ func ListDataPlanesForGateway(
 
 	dataplanes := make([]operatorv1beta1.DataPlane, 0)
 	for _, dataplane := range dataplaneList.Items {
-		if k8sutils.IsOwnedByRefUID(&dataplane, gateway.UID) {
+		if k8sutils.IsOwnedByRefUID(&dataplane, ownerUID) {
 			dataplanes = append(dataplanes, dataplane)
 		}
 	}
@@ -64,12 +78,26 @@ func ListControlPlanesForGateway(
 		return nil, fmt.Errorf("can't list dataplanes for gateway: gateway resource was missing namespace")
 	}
 
+	return ListControlPlanesForOwner(ctx, c, gateway.Namespace, gateway.UID)
+}
+
+// ListControlPlanesForOwner is a helper function to map a list of ControlPlanes
+// in the provided namespace that are managed by the Gateway controller
+// and owned by the object with the provided UID.
+// The owner is either a Gateway or, for ControlPlanes shared by multiple Gateways,
+// their GatewayClass.
+func ListControlPlanesForOwner(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	ownerUID types.UID,
+) ([]operatorv1beta1.ControlPlane, error) {
 	controlplaneList := &operatorv1beta1.ControlPlaneList{}
 
 	err := c.List(
 		ctx,
 		controlplaneList,
-		client.InNamespace(gateway.Namespace),
+		client.InNamespace(namespace),
 		client.MatchingLabels{
 			consts.GatewayOperatorManagedByLabel: consts.GatewayManagedLabelValue,
 		},
@@ -80,7 +108,7 @@ func ListControlPlanesForGateway(
 
 	controlplanes := make([]operatorv1beta1.ControlPlane, 0)
 	for _, controlplane := range controlplaneList.Items {
-		if k8sutils.IsOwnedByRefUID(&controlplane, gateway.UID) {
+		if k8sutils.IsOwnedByRefUID(&controlplane, ownerUID) {
 			controlplanes = append(controlplanes, controlplane)
 		}
 	}
@@ -112,34 +140,7 @@ func ListHTTPRoutesForGateway(
 
 	var httpRoutes []gwtypes.HTTPRoute
 	for _, httpRoute := range httpRoutesList.Items {
-		if !lo.ContainsBy(httpRoute.Spec.ParentRefs, func(parentRef gwtypes.ParentReference) bool {
-			gwGVK := gateway.GroupVersionKind()
-			if parentRef.Group != nil && string(*parentRef.Group) != gwGVK.Group {
-				return false
-			}
-			if parentRef.Kind != nil && string(*parentRef.Kind) != gwGVK.Kind {
-				return false
-			}
-			if string(parentRef.Name) != gateway.Name {
-				return false
-			}
-
-			if parentRef.SectionName != nil {
-				if !lo.ContainsBy(gateway.Spec.Listeners, func(listener gwtypes.Listener) bool {
-					if listener.Name != *parentRef.SectionName {
-						return false
-					}
-					if parentRef.Port != nil && listener.Port != *parentRef.Port {
-						return false
-					}
-					return true
-				}) {
-					return false
-				}
-			}
-
-			return true
-		}) {
+		if !lo.ContainsBy(httpRoute.Spec.ParentRefs, parentRefMatchesGateway(gateway)) {
 			continue
 		}
 
@@ -149,6 +150,74 @@ func ListHTTPRoutesForGateway(
 	return httpRoutes, nil
 }
 
+// ListGRPCRoutesForGateway is a helper function which returns a list of GRPCRoutes
+// that have the provided Gateway set as parent in their status.
+func ListGRPCRoutesForGateway(
+	ctx context.Context,
+	c client.Client,
+	gateway *gwtypes.Gateway,
+	opts ...client.ListOption,
+) ([]gwtypes.GRPCRoute, error) {
+	if gateway.Namespace == "" {
+		return nil, fmt.Errorf("can't list GRPCRoutes for gateway: Gateway %s was missing namespace", gateway.Name)
+	}
+
+	var grpcRoutesList gwtypes.GRPCRouteList
+	err := c.List(
+		ctx,
+		&grpcRoutesList,
+		opts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't list GRPCRoutes for gateway: %w", err)
+	}
+
+	var grpcRoutes []gwtypes.GRPCRoute
+	for _, grpcRoute := range grpcRoutesList.Items {
+		if !lo.ContainsBy(grpcRoute.Spec.ParentRefs, parentRefMatchesGateway(gateway)) {
+			continue
+		}
+
+		grpcRoutes = append(grpcRoutes, grpcRoute)
+	}
+
+	return grpcRoutes, nil
+}
+
+// parentRefMatchesGateway returns a predicate which checks whether a route's
+// ParentReference points to the provided Gateway (and to one of its listeners
+// when a section name is set).
+func parentRefMatchesGateway(gateway *gwtypes.Gateway) func(gwtypes.ParentReference) bool {
+	return func(parentRef gwtypes.ParentReference) bool {
+		gwGVK := gateway.GroupVersionKind()
+		if parentRef.Group != nil && string(*parentRef.Group) != gwGVK.Group {
+			return false
+		}
+		if parentRef.Kind != nil && string(*parentRef.Kind) != gwGVK.Kind {
+			return false
+		}
+		if string(parentRef.Name) != gateway.Name {
+			return false
+		}
+
+		if parentRef.SectionName != nil {
+			if !lo.ContainsBy(gateway.Spec.Listeners, func(listener gwtypes.Listener) bool {
+				if listener.Name != *parentRef.SectionName {
+					return false
+				}
+				if parentRef.Port != nil && listener.Port != *parentRef.Port {
+					return false
+				}
+				return true
+			}) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
 // GetDataPlaneForControlPlane retrieves the DataPlane object referenced by a ControlPlane
 func GetDataPlaneForControlPlane(
 	ctx context.Context,
@@ -210,12 +279,24 @@ func ListNetworkPoliciesForGateway(
 		return nil, fmt.Errorf("can't list networkpolicies for gateway: gateway resource was missing namespace")
 	}
 
+	return ListNetworkPoliciesForOwner(ctx, c, gateway.Namespace, gateway.UID)
+}
+
+// ListNetworkPoliciesForOwner is a helper function that returns a list of NetworkPolicies
+// in the provided namespace that are managed by the Gateway controller
+// and owned by the object with the provided UID.
+func ListNetworkPoliciesForOwner(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	ownerUID types.UID,
+) ([]networkingv1.NetworkPolicy, error) {
 	networkPolicyList := &networkingv1.NetworkPolicyList{}
 
 	err := c.List(
 		ctx,
 		networkPolicyList,
-		client.InNamespace(gateway.Namespace),
+		client.InNamespace(namespace),
 		client.MatchingLabels{consts.GatewayOperatorManagedByLabel: consts.GatewayManagedLabelValue},
 	)
 	if err != nil {
@@ -224,7 +305,7 @@ func ListNetworkPoliciesForGateway(
 
 	networkPolicies := make([]networkingv1.NetworkPolicy, 0)
 	for _, networkPolicy := range networkPolicyList.Items {
-		if k8sutils.IsOwnedByRefUID(&networkPolicy, gateway.UID) {
+		if k8sutils.IsOwnedByRefUID(&networkPolicy, ownerUID) {
 			networkPolicies = append(networkPolicies, networkPolicy)
 		}
 	}
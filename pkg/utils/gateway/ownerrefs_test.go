@@ -268,3 +268,78 @@ func TestListHTTPRoutesForGateway(t *testing.T) {
 		})
 	}
 }
+
+func TestListGRPCRoutesForGateway(t *testing.T) {
+	gateway := &gwtypes.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Gateway",
+			APIVersion: gwtypes.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw-1",
+			Namespace: "default",
+		},
+	}
+	grpcRoute := func(name string, parentRefs ...gwtypes.ParentReference) *gwtypes.GRPCRoute {
+		return &gwtypes.GRPCRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "default",
+				ResourceVersion: "1",
+			},
+			Spec: gwtypes.GRPCRouteSpec{
+				CommonRouteSpec: gwtypes.CommonRouteSpec{
+					ParentRefs: parentRefs,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		grpcRoutes []client.Object
+		expected   []gwtypes.GRPCRoute
+	}{
+		{
+			name: "returns GRPCRoute for a Gateway",
+			grpcRoutes: []client.Object{
+				grpcRoute("grpc-route-1", gwtypes.ParentReference{
+					Group: lo.ToPtr(gwtypes.Group(gwtypes.GroupVersion.Group)),
+					Kind:  lo.ToPtr(gwtypes.Kind("Gateway")),
+					Name:  gwtypes.ObjectName("gw-1"),
+				}),
+			},
+			expected: []gwtypes.GRPCRoute{
+				*grpcRoute("grpc-route-1", gwtypes.ParentReference{
+					Group: lo.ToPtr(gwtypes.Group(gwtypes.GroupVersion.Group)),
+					Kind:  lo.ToPtr(gwtypes.Kind("Gateway")),
+					Name:  gwtypes.ObjectName("gw-1"),
+				}),
+			},
+		},
+		{
+			name: "does not return GRPCRoute for a Gateway when it is not a parent",
+			grpcRoutes: []client.Object{
+				grpcRoute("grpc-route-1", gwtypes.ParentReference{
+					Group: lo.ToPtr(gwtypes.Group(gwtypes.GroupVersion.Group)),
+					Kind:  lo.ToPtr(gwtypes.Kind("Gateway")),
+					Name:  gwtypes.ObjectName("gw-2"),
+				}),
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().
+				WithScheme(scheme.Get()).
+				WithObjects(gateway).
+				WithObjects(tc.grpcRoutes...).
+				Build()
+			routes, err := ListGRPCRoutesForGateway(context.Background(), cl, gateway)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, routes)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	admregv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -116,6 +117,40 @@ func ListPodDisruptionBudgetsForOwner(
 	return pdbs, nil
 }
 
+// ListJobsForOwner is a helper function which gets a list of Jobs
+// using the provided list options and reduce by OwnerReference UID and namespace to efficiently
+// list only the objects owned by the provided UID.
+func ListJobsForOwner(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	uid types.UID,
+	listOpts ...client.ListOption,
+) ([]batchv1.Job, error) {
+	jobList := &batchv1.JobList{}
+
+	err := c.List(
+		ctx,
+		jobList,
+		append(
+			[]client.ListOption{client.InNamespace(namespace)},
+			listOpts...,
+		)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []batchv1.Job
+	for _, job := range jobList.Items {
+		if IsOwnedByRefUID(&job, uid) {
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs, nil
+}
+
 // ListServicesForOwner is a helper function which gets a list of Services
 // using the provided list options and reduce by OwnerReference UID and namespace to efficiently
 // list only the objects owned by the provided UID.
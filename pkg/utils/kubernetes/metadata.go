@@ -8,6 +8,8 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/gateway-operator/pkg/consts"
 )
 
 // -----------------------------------------------------------------------------
@@ -76,3 +78,9 @@ func TrimGenerateName(name string) string {
 	}
 	return name
 }
+
+// IsReconciliationPaused returns true if the reconciliation of the provided
+// object is paused with the gateway-operator.konghq.com/reconcile annotation.
+func IsReconciliationPaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[consts.ReconcileAnnotation] == consts.ReconcileAnnotationValuePaused
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/gateway-operator/pkg/consts"
 )
 
 func TestEnsureObjectMetaIsUpdated(t *testing.T) {
@@ -215,3 +217,35 @@ func TestEnsureObjectMetaIsUpdated(t *testing.T) {
 		})
 	}
 }
+
+func TestIsReconciliationPaused(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name: "no annotations",
+		},
+		{
+			name: "paused",
+			annotations: map[string]string{
+				consts.ReconcileAnnotation: consts.ReconcileAnnotationValuePaused,
+			},
+			expected: true,
+		},
+		{
+			name: "other value",
+			annotations: map[string]string{
+				consts.ReconcileAnnotation: "enabled",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &metav1.ObjectMeta{Annotations: tc.annotations}
+			assert.Equal(t, tc.expected, IsReconciliationPaused(obj))
+		})
+	}
+}
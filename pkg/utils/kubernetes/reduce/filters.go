@@ -11,6 +11,7 @@ import (
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/pkg/consts"
@@ -372,6 +373,31 @@ func FilterHPAs(hpas []autoscalingv2.HorizontalPodAutoscaler) []autoscalingv2.Ho
 	return append(hpas[:best], hpas[best+1:]...)
 }
 
+// -----------------------------------------------------------------------------
+// Filter functions - ScaledObjects
+// -----------------------------------------------------------------------------
+
+// FilterScaledObjects filters out the KEDA ScaledObject to be kept and returns all
+// the ScaledObjects to be deleted.
+// The filtered-out ScaledObject is decided as follows:
+// 1. creationTimestamp (older is better)
+func FilterScaledObjects(scaledObjects []unstructured.Unstructured) []unstructured.Unstructured {
+	if len(scaledObjects) < 2 {
+		return []unstructured.Unstructured{}
+	}
+
+	best := 0
+	for i, scaledObject := range scaledObjects {
+		creationTimestamp := scaledObject.GetCreationTimestamp()
+		bestCreationTimestamp := scaledObjects[best].GetCreationTimestamp()
+		if creationTimestamp.Before(&bestCreationTimestamp) {
+			best = i
+		}
+	}
+
+	return append(scaledObjects[:best], scaledObjects[best+1:]...)
+}
+
 // -----------------------------------------------------------------------------
 // Filter functions - PodDisruptionBudgets
 // -----------------------------------------------------------------------------
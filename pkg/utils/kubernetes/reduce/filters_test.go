@@ -14,6 +14,7 @@ import (
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/pkg/consts"
@@ -1033,6 +1034,48 @@ func TestFilterHPA(t *testing.T) {
 	}
 }
 
+func TestFilterScaledObjects(t *testing.T) {
+	now := time.Now()
+	scaledObject := func(name string, creationTimestamp time.Time) unstructured.Unstructured {
+		so := unstructured.Unstructured{}
+		so.SetName(name)
+		so.SetCreationTimestamp(metav1.NewTime(creationTimestamp))
+		return so
+	}
+	testCases := []struct {
+		name          string
+		scaledObjects []unstructured.Unstructured
+		expectedNames []string
+	}{
+		{
+			name: "a single ScaledObject is kept",
+			scaledObjects: []unstructured.Unstructured{
+				scaledObject("only", now),
+			},
+			expectedNames: []string{},
+		},
+		{
+			name: "the newer ones must be returned to be deleted",
+			scaledObjects: []unstructured.Unstructured{
+				scaledObject("newer", now),
+				scaledObject("older", now.Add(-time.Second)),
+				scaledObject("newer-2", now.Add(time.Second)),
+			},
+			expectedNames: []string{"newer", "newer-2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filtered := FilterScaledObjects(tc.scaledObjects)
+			filteredNames := lo.Map(filtered, func(so unstructured.Unstructured, _ int) string {
+				return so.GetName()
+			})
+			require.ElementsMatch(t, filteredNames, tc.expectedNames)
+		})
+	}
+}
+
 func TestFilterPodDisruptionBudgets(t *testing.T) {
 	now := time.Now()
 	testCases := []struct {
@@ -12,6 +12,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
@@ -160,6 +161,21 @@ func ReduceHPAs(ctx context.Context, k8sClient client.Client, hpas []autoscaling
 	return nil
 }
 
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=delete
+
+// ScaledObjectFilterFunc filters a list of KEDA ScaledObjects and returns the ones that should be deleted.
+type ScaledObjectFilterFunc func([]unstructured.Unstructured) []unstructured.Unstructured
+
+// ReduceScaledObjects detects the best KEDA ScaledObject in the set and deletes all the others.
+func ReduceScaledObjects(ctx context.Context, k8sClient client.Client, scaledObjects []unstructured.Unstructured, filter ScaledObjectFilterFunc) error {
+	for _, scaledObject := range filter(scaledObjects) {
+		if err := k8sClient.Delete(ctx, &scaledObject); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=delete
 
 // PDBFilterFunc filters a list of PodDisruptionBudgets and returns the ones that should be deleted.
@@ -97,6 +97,7 @@ func GenerateNewDeploymentForControlPlane(params GenerateNewDeploymentForControl
 						GenerateControlPlaneContainer(GenerateContainerForControlPlaneParams{
 							Image:                          params.ControlPlaneImage,
 							AdmissionWebhookCertSecretName: lo.ToPtr(params.AdmissionWebhookCertSecretName),
+							ExposeMetricsPort:              params.ControlPlane.Spec.Monitoring != nil,
 						}),
 					},
 				},
@@ -126,6 +127,10 @@ func GenerateNewDeploymentForControlPlane(params GenerateNewDeploymentForControl
 	// it with what's in the cluster.
 	pkgapisappsv1.SetDefaults_Deployment(deployment)
 
+	if err := ApplyResourcePatches(deployment, operatorv1beta1.ResourcePatchTargetKindDeployment, params.ControlPlane.Spec.Patches); err != nil {
+		return nil, err
+	}
+
 	return deployment, nil
 }
 
@@ -135,6 +140,9 @@ type GenerateContainerForControlPlaneParams struct {
 	// AdmissionWebhookCertSecretName is the name of the Secret that holds the certificate for the admission webhook.
 	// If this is nil, the admission webhook will not be enabled.
 	AdmissionWebhookCertSecretName *string
+	// ExposeMetricsPort adds the metrics port to the container. It's set when
+	// the ControlPlane's metrics are scraped (i.e. spec.monitoring is set).
+	ExposeMetricsPort bool
 }
 
 // GenerateControlPlaneContainer generates a control plane container.
@@ -163,6 +171,13 @@ func GenerateControlPlaneContainer(params GenerateContainerForControlPlaneParams
 		ReadinessProbe: GenerateControlPlaneProbe("/readyz", intstr.FromInt(10254)),
 		Resources:      *DefaultControlPlaneResources(),
 	}
+	if params.ExposeMetricsPort {
+		c.Ports = append(c.Ports, corev1.ContainerPort{
+			Name:          consts.ControlPlaneMetricsPortName,
+			ContainerPort: consts.ControlPlaneMetricsPort,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
 	// Only add the admission webhook volume mount and port if the secret name is provided.
 	if params.AdmissionWebhookCertSecretName != nil && *params.AdmissionWebhookCertSecretName != "" {
 		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
@@ -266,6 +281,14 @@ func GenerateNewDeploymentForDataPlane(
 		dpOpts.Scaling.HorizontalScaling.MinReplicas != nil:
 		deployment.Spec.Replicas = dpOpts.Scaling.HorizontalScaling.MinReplicas
 
+	// The same applies to event-driven scaling: start with the minReplicas
+	// value before the KEDA ScaledObject takes over.
+	case dpOpts.Replicas == nil &&
+		dpOpts.Scaling != nil &&
+		dpOpts.Scaling.EventDrivenScaling != nil &&
+		dpOpts.Scaling.EventDrivenScaling.MinReplicas != nil:
+		deployment.Spec.Replicas = dpOpts.Scaling.EventDrivenScaling.MinReplicas
+
 	// We set the default to 1 if no replicas or scaling is specified because
 	// we cannot set the default in the CRD due to the fact that the default
 	// would prevent us from being able to use CRD Validation Rules to enforce
@@ -283,6 +306,10 @@ func GenerateNewDeploymentForDataPlane(
 		}
 	}
 
+	// Placement is set after the options as these can alter the pod selector
+	// (e.g. BlueGreen rollout selector) which the constraints are keyed to.
+	setDataPlanePlacement(deployment, dpOpts.Placement)
+
 	k8sutils.SetOwnerForObject(deployment, dataplane)
 	controllerutil.AddFinalizer(deployment, consts.DataPlaneOwnedWaitForOwnerFinalizer)
 
@@ -294,6 +321,50 @@ func GenerateNewDeploymentForDataPlane(
 	return &wrapped, nil
 }
 
+// setDataPlanePlacement sets the topology spread constraints and pod anti-affinity
+// of the provided DataPlane Deployment according to the placement policy.
+// The constraints select the pods using the Deployment's pod selector so that
+// pods of different Deployments (e.g. BlueGreen live and preview) are spread
+// independently.
+func setDataPlanePlacement(deployment *appsv1.Deployment, placement operatorv1beta1.DataPlanePlacementPolicy) {
+	var topologyKeys []string
+	switch placement {
+	case operatorv1beta1.DataPlanePlacementPolicyZoneSpread:
+		topologyKeys = []string{corev1.LabelTopologyZone, corev1.LabelHostname}
+	case operatorv1beta1.DataPlanePlacementPolicyHostSpread:
+		topologyKeys = []string{corev1.LabelHostname}
+	default:
+		return
+	}
+
+	podSpec := &deployment.Spec.Template.Spec
+	for _, topologyKey := range topologyKeys {
+		podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       topologyKey,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     deployment.Spec.Selector.DeepCopy(),
+		})
+	}
+
+	if placement == operatorv1beta1.DataPlanePlacementPolicyHostSpread {
+		if podSpec.Affinity == nil {
+			podSpec.Affinity = &corev1.Affinity{}
+		}
+		podSpec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+				{
+					Weight: 100,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						TopologyKey:   corev1.LabelHostname,
+						LabelSelector: deployment.Spec.Selector.DeepCopy(),
+					},
+				},
+			},
+		}
+	}
+}
+
 // GenerateDataPlaneContainer generates a DataPlane container.
 func GenerateDataPlaneContainer(image string) corev1.Container {
 	return corev1.Container{
@@ -455,6 +526,23 @@ func ClusterCertificateVolumeMount() corev1.VolumeMount {
 	}
 }
 
+// HybridClusterCertificateVolume returns a volume holding a hybrid mode cluster certificate
+// given a Secret holding a certificate.
+func HybridClusterCertificateVolume(certSecretName string) corev1.Volume {
+	volume := ClusterCertificateVolume(certSecretName)
+	volume.Name = consts.HybridClusterCertificateVolume
+	return volume
+}
+
+// HybridClusterCertificateVolumeMount returns a volume mount for the hybrid mode cluster certificate.
+func HybridClusterCertificateVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      consts.HybridClusterCertificateVolume,
+		ReadOnly:  true,
+		MountPath: consts.HybridClusterCertificateVolumeMountPath,
+	}
+}
+
 // Deployment is a wrapper for appsv1.Deployment. It provides additional methods to modify parts of the Deployment,
 // such as to add a Volume or set an environment variable. These "With" methods do not return errors to allow chaining,
 // and may no-op if target subsection is not available or overwrite existing conflicting configuration. If the presence
@@ -277,6 +277,104 @@ func TestGenerateNewDeploymentForDataPlane(t *testing.T) {
 	}
 }
 
+func TestGenerateNewDeploymentForDataPlanePlacement(t *testing.T) {
+	newDataPlane := func(placement operatorv1beta1.DataPlanePlacementPolicy) *operatorv1beta1.DataPlane {
+		return &operatorv1beta1.DataPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dataplane-name",
+				Namespace: "test-namespace",
+			},
+			Spec: operatorv1beta1.DataPlaneSpec{
+				DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+					Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+						Placement: placement,
+					},
+				},
+			},
+		}
+	}
+	// rolloutSelectorOpt mimics the BlueGreen rollout selector which differs
+	// between the live and preview Deployments.
+	rolloutSelectorOpt := func(selector string) DeploymentOpt {
+		return func(d *appsv1.Deployment) {
+			d.Spec.Selector.MatchLabels[consts.OperatorLabelSelector] = selector
+			d.Spec.Template.Labels[consts.OperatorLabelSelector] = selector
+		}
+	}
+	expectedSelector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"app":                        "dataplane-name",
+			consts.OperatorLabelSelector: "preview-selector",
+		},
+	}
+
+	tests := []struct {
+		name                              string
+		placement                         operatorv1beta1.DataPlanePlacementPolicy
+		expectedTopologySpreadConstraints []corev1.TopologySpreadConstraint
+		expectedAffinity                  *corev1.Affinity
+	}{
+		{
+			name: "unset placement doesn't set any constraints",
+		},
+		{
+			name:      "none placement doesn't set any constraints",
+			placement: operatorv1beta1.DataPlanePlacementPolicyNone,
+		},
+		{
+			name:      "zoneSpread placement spreads pods across zones and nodes",
+			placement: operatorv1beta1.DataPlanePlacementPolicyZoneSpread,
+			expectedTopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+				{
+					MaxSkew:           1,
+					TopologyKey:       "topology.kubernetes.io/zone",
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+					LabelSelector:     expectedSelector,
+				},
+				{
+					MaxSkew:           1,
+					TopologyKey:       "kubernetes.io/hostname",
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+					LabelSelector:     expectedSelector,
+				},
+			},
+		},
+		{
+			name:      "hostSpread placement spreads pods across nodes",
+			placement: operatorv1beta1.DataPlanePlacementPolicyHostSpread,
+			expectedTopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+				{
+					MaxSkew:           1,
+					TopologyKey:       "kubernetes.io/hostname",
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+					LabelSelector:     expectedSelector,
+				},
+			},
+			expectedAffinity: &corev1.Affinity{
+				PodAntiAffinity: &corev1.PodAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+						{
+							Weight: 100,
+							PodAffinityTerm: corev1.PodAffinityTerm{
+								TopologyKey:   "kubernetes.io/hostname",
+								LabelSelector: expectedSelector,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment, err := GenerateNewDeploymentForDataPlane(newDataPlane(tt.placement), "kong:3.0", rolloutSelectorOpt("preview-selector"))
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedTopologySpreadConstraints, deployment.Spec.Template.Spec.TopologySpreadConstraints)
+			require.Equal(t, tt.expectedAffinity, deployment.Spec.Template.Spec.Affinity)
+		})
+	}
+}
+
 func TestGenerateNewDeploymentForControlPlane(t *testing.T) {
 	tests := []struct {
 		name                     string
@@ -443,7 +541,7 @@ func TestGenerateNewDeploymentForControlPlane(t *testing.T) {
 			},
 		},
 		{
-			name: "no webhook cert secret name specified doesn't the webhook volume, volume mount nor port",
+			name: "no webhook cert secret name specified doesn't the webhook volume, volume mount nor port, monitoring adds the metrics port",
 			generateControlPlaneArgs: GenerateNewDeploymentForControlPlaneParams{
 				ControlPlane: &operatorv1beta1.ControlPlane{
 					ObjectMeta: metav1.ObjectMeta{
@@ -455,6 +553,11 @@ func TestGenerateNewDeploymentForControlPlane(t *testing.T) {
 						APIVersion: "gateway-operator.konghq.com/v1beta1",
 						Kind:       "ControlPlane",
 					},
+					Spec: operatorv1beta1.ControlPlaneSpec{
+						ControlPlaneOptions: operatorv1beta1.ControlPlaneOptions{
+							Monitoring: &operatorv1beta1.MonitoringOptions{},
+						},
+					},
 				},
 				ControlPlaneImage:       "kong/kubernetes-ingress-controller:3.1.5",
 				AdminMTLSCertSecretName: "cluster-certificate-secret-name",
@@ -546,6 +649,11 @@ func TestGenerateNewDeploymentForControlPlane(t *testing.T) {
 											ContainerPort: 10254,
 											Protocol:      corev1.ProtocolTCP,
 										},
+										{
+											Name:          "metrics",
+											ContainerPort: 10255,
+											Protocol:      corev1.ProtocolTCP,
+										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
@@ -50,5 +50,9 @@ func GenerateHPAForDataPlane(dataplane *operatorv1beta1.DataPlane, deploymentNam
 	// it with what's in the cluster.
 	pkgapisautoscalingv2.SetDefaults_HorizontalPodAutoscaler(hpa)
 
+	if err := ApplyResourcePatches(hpa, operatorv1beta1.ResourcePatchTargetKindHorizontalPodAutoscaler, dataplane.Spec.Patches); err != nil {
+		return nil, err
+	}
+
 	return hpa, nil
 }
@@ -4,11 +4,14 @@ import (
 	"fmt"
 
 	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
 	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
 )
 
 // -----------------------------------------------------------------------------
@@ -94,3 +97,86 @@ func newWebhookCertificateConfigJobCommon(namespace, serviceAccountName string,
 	}
 	return job
 }
+
+// DataPlaneMigrationPhase is the phase of the Kong Gateway database migrations,
+// matching the `kong migrations` subcommand running it.
+type DataPlaneMigrationPhase string
+
+const (
+	// DataPlaneMigrationPhaseBootstrap bootstraps the database schema.
+	// It's a no-op for an already bootstrapped database.
+	DataPlaneMigrationPhaseBootstrap DataPlaneMigrationPhase = "bootstrap"
+	// DataPlaneMigrationPhaseUp runs the migrations of a new Kong Gateway version
+	// which are compatible with the previous version.
+	DataPlaneMigrationPhaseUp DataPlaneMigrationPhase = "up"
+	// DataPlaneMigrationPhaseFinish runs the migrations of a new Kong Gateway
+	// version which can only be run once no nodes of the previous version are running.
+	DataPlaneMigrationPhaseFinish DataPlaneMigrationPhase = "finish"
+)
+
+// GenerateNewMigrationJobForDataPlane generates a Job running the provided phase
+// of the database migrations for the given DataPlane. The Job's container is
+// derived from the proxy container of the provided DataPlane Deployment so that
+// the migrations run with the same image, environment (e.g. the database
+// connection and the enabled plugins) and volumes (e.g. custom plugins).
+func GenerateNewMigrationJobForDataPlane(
+	dataplane *operatorv1beta1.DataPlane,
+	deployment *appsv1.Deployment,
+	phase DataPlaneMigrationPhase,
+) (*batchv1.Job, error) {
+	podSpec := deployment.Spec.Template.Spec.DeepCopy()
+	proxy := k8sutils.GetPodContainerByName(podSpec, consts.DataPlaneProxyContainerName)
+	if proxy == nil {
+		return nil, fmt.Errorf("couldn't find proxy container in DataPlane %s Deployment", dataplane.Name)
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dataplane.Namespace,
+			GenerateName: k8sutils.TrimGenerateName(
+				fmt.Sprintf("%s-%s-migrations-%s-", consts.DataPlanePrefix, dataplane.Name, phase),
+			),
+			Labels: map[string]string{
+				"app":                               dataplane.Name,
+				consts.DataPlaneMigrationPhaseLabel: string(phase),
+			},
+			Annotations: map[string]string{
+				consts.AnnotationDataPlaneMigrationImage: proxy.Image,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					// The Pods are deliberately not labeled with the DataPlane's
+					// app label so that they're not selected by its Services.
+					Labels: map[string]string{
+						consts.DataPlaneMigrationPhaseLabel: string(phase),
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyOnFailure,
+					ServiceAccountName: podSpec.ServiceAccountName,
+					SecurityContext:    podSpec.SecurityContext,
+					ImagePullSecrets:   podSpec.ImagePullSecrets,
+					Volumes:            podSpec.Volumes,
+					Containers: []corev1.Container{
+						{
+							Name:            consts.DataPlaneMigrationsContainerName,
+							Image:           proxy.Image,
+							ImagePullPolicy: proxy.ImagePullPolicy,
+							Command:         []string{"kong", "migrations", string(phase)},
+							Env:             proxy.Env,
+							EnvFrom:         proxy.EnvFrom,
+							VolumeMounts:    proxy.VolumeMounts,
+							SecurityContext: proxy.SecurityContext,
+						},
+					},
+				},
+			},
+		},
+	}
+	LabelObjectAsDataPlaneManaged(job)
+	k8sutils.SetOwnerForObject(job, dataplane)
+
+	return job, nil
+}
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+)
+
+// GenerateNewOpenTelemetryKongClusterPluginForControlPlane is a helper to generate the global
+// opentelemetry KongClusterPlugin configured by a control plane to send the traces of its data plane
+// to the OpenTelemetry collector.
+func GenerateNewOpenTelemetryKongClusterPluginForControlPlane(
+	cp *operatorv1beta1.ControlPlane,
+	ingressClass string,
+	tracing *operatorv1beta1.DataPlaneTracingOptions,
+) (*configurationv1.KongClusterPlugin, error) {
+	config := map[string]any{
+		"traces_endpoint": tracing.Endpoint,
+	}
+	if len(tracing.ResourceAttributes) > 0 {
+		config["resource_attributes"] = tracing.ResourceAttributes
+	}
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshaling %s plugin configuration: %w", consts.OpenTelemetryPluginName, err)
+	}
+
+	plugin := &configurationv1.KongClusterPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			// KongClusterPlugins are cluster-wide so the ControlPlane's namespace
+			// is included in the name to make it easier to find the owner.
+			GenerateName: k8sutils.TrimGenerateName(
+				fmt.Sprintf("%s-%s-%s-%s-", consts.ControlPlanePrefix, cp.Namespace, cp.Name, consts.OpenTelemetryPluginName),
+			),
+			Labels: map[string]string{
+				"app":                        cp.Name,
+				consts.KongGlobalPluginLabel: "true",
+			},
+			Annotations: map[string]string{
+				consts.IngressClassAnnotation: ingressClass,
+			},
+		},
+		PluginName: consts.OpenTelemetryPluginName,
+		Config: apiextensionsv1.JSON{
+			Raw: rawConfig,
+		},
+	}
+	LabelObjectAsControlPlaneManaged(plugin)
+	return plugin, nil
+}
@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+)
+
+func TestGenerateNewOpenTelemetryKongClusterPluginForControlPlane(t *testing.T) {
+	cp := &operatorv1beta1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-1",
+			Namespace: "default",
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		tracing        *operatorv1beta1.DataPlaneTracingOptions
+		expectedConfig string
+	}{
+		{
+			name: "endpoint only",
+			tracing: &operatorv1beta1.DataPlaneTracingOptions{
+				Endpoint: "http://otel-collector.observability:4318/v1/traces",
+			},
+			expectedConfig: `{"traces_endpoint":"http://otel-collector.observability:4318/v1/traces"}`,
+		},
+		{
+			name: "endpoint and resource attributes",
+			tracing: &operatorv1beta1.DataPlaneTracingOptions{
+				Endpoint: "http://otel-collector.observability:4318/v1/traces",
+				ResourceAttributes: map[string]string{
+					"service.name": "kong",
+				},
+			},
+			expectedConfig: `{"resource_attributes":{"service.name":"kong"},"traces_endpoint":"http://otel-collector.observability:4318/v1/traces"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin, err := GenerateNewOpenTelemetryKongClusterPluginForControlPlane(cp, "kong", tc.tracing)
+			require.NoError(t, err)
+			assert.Equal(t, "controlplane-default-cp-1-opentelemetry-", plugin.GenerateName)
+			assert.Equal(t, "opentelemetry", plugin.PluginName)
+			assert.Equal(t, map[string]string{
+				"app":                                    "cp-1",
+				"global":                                 "true",
+				"gateway-operator.konghq.com/managed-by": "controlplane",
+				"konghq.com/gateway-operator":            "controlplane",
+			}, plugin.Labels)
+			assert.Equal(t, map[string]string{
+				"kubernetes.io/ingress.class": "kong",
+			}, plugin.Annotations)
+			assert.JSONEq(t, tc.expectedConfig, string(plugin.Config.Raw))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package resources
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	"github.com/kong/gateway-operator/pkg/consts"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+)
+
+// -----------------------------------------------------------------------------
+// Prometheus Operator monitor generators
+// -----------------------------------------------------------------------------
+
+// MonitoringGroupVersion is the group version of the Prometheus Operator resources.
+var MonitoringGroupVersion = schema.GroupVersion{Group: "monitoring.coreos.com", Version: "v1"}
+
+// MonitorGVR returns the GroupVersionResource of the provided Prometheus Operator monitor kind.
+func MonitorGVR(kind operatorv1beta1.MonitorKind) schema.GroupVersionResource {
+	switch kind {
+	case operatorv1beta1.MonitorKindPodMonitor:
+		return MonitoringGroupVersion.WithResource("podmonitors")
+	default:
+		return MonitoringGroupVersion.WithResource("servicemonitors")
+	}
+}
+
+// MonitorKindOrDefault returns the monitor kind set in the provided options or
+// the default ServiceMonitor kind when it's not set.
+func MonitorKindOrDefault(opts *operatorv1beta1.MonitoringOptions) operatorv1beta1.MonitorKind {
+	if opts.Kind == "" {
+		return operatorv1beta1.MonitorKindServiceMonitor
+	}
+	return opts.Kind
+}
+
+// GenerateMonitorForControlPlane is a helper to generate the ServiceMonitor
+// or PodMonitor scraping the metrics of a control plane.
+func GenerateMonitorForControlPlane(cp *operatorv1beta1.ControlPlane, opts *operatorv1beta1.MonitoringOptions) *unstructured.Unstructured {
+	selector := map[string]string{"app": cp.Name}
+	if MonitorKindOrDefault(opts) == operatorv1beta1.MonitorKindServiceMonitor {
+		selector[consts.ControlPlaneServiceLabel] = consts.ControlPlaneServiceKindMetrics
+	}
+	monitor := generateMonitor(
+		cp,
+		fmt.Sprintf("%s-%s-", consts.ControlPlanePrefix, cp.Name),
+		opts,
+		selector,
+		consts.ControlPlaneMetricsPortName,
+	)
+	LabelObjectAsControlPlaneManaged(monitor)
+	return monitor
+}
+
+// GenerateMonitorForDataPlane is a helper to generate the ServiceMonitor
+// or PodMonitor scraping the metrics exposed on the status port of a data plane.
+// PodMonitors only select the Pods of the data plane's live Deployment.
+func GenerateMonitorForDataPlane(dataplane *operatorv1beta1.DataPlane, opts *operatorv1beta1.MonitoringOptions) *unstructured.Unstructured {
+	selector := map[string]string{"app": dataplane.Name}
+	switch {
+	case MonitorKindOrDefault(opts) == operatorv1beta1.MonitorKindServiceMonitor:
+		selector[consts.DataPlaneServiceTypeLabel] = string(consts.DataPlaneMetricsServiceLabelValue)
+	case dataplane.Status.Selector != "":
+		selector[consts.OperatorLabelSelector] = dataplane.Status.Selector
+	}
+	monitor := generateMonitor(
+		dataplane,
+		fmt.Sprintf("%s-%s-", consts.DataPlanePrefix, dataplane.Name),
+		opts,
+		selector,
+		"metrics",
+	)
+	LabelObjectAsDataPlaneManaged(monitor)
+	return monitor
+}
+
+// generateMonitor generates a ServiceMonitor or a PodMonitor, depending on the kind set in
+// the provided options, selecting the Services or Pods with the provided labels and
+// scraping their port with the provided name.
+func generateMonitor(
+	owner client.Object,
+	generateName string,
+	opts *operatorv1beta1.MonitoringOptions,
+	selector map[string]string,
+	portName string,
+) *unstructured.Unstructured {
+	endpoint := map[string]any{
+		"port": portName,
+		"path": "/metrics",
+	}
+	if opts.Interval != nil {
+		endpoint["interval"] = *opts.Interval
+	}
+	if opts.ScrapeTimeout != nil {
+		endpoint["scrapeTimeout"] = *opts.ScrapeTimeout
+	}
+
+	matchLabels := make(map[string]any, len(selector))
+	for k, v := range selector {
+		matchLabels[k] = v
+	}
+
+	kind := MonitorKindOrDefault(opts)
+	endpointsField := "endpoints"
+	if kind == operatorv1beta1.MonitorKindPodMonitor {
+		endpointsField = "podMetricsEndpoints"
+	}
+
+	monitor := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"selector": map[string]any{
+					"matchLabels": matchLabels,
+				},
+				endpointsField: []any{endpoint},
+			},
+		},
+	}
+	monitor.SetGroupVersionKind(MonitoringGroupVersion.WithKind(string(kind)))
+	monitor.SetNamespace(owner.GetNamespace())
+	monitor.SetGenerateName(k8sutils.TrimGenerateName(generateName))
+
+	labels := make(map[string]string, len(opts.Labels)+1)
+	for k, v := range opts.Labels {
+		labels[k] = v
+	}
+	labels["app"] = owner.GetName()
+	monitor.SetLabels(labels)
+	k8sutils.SetOwnerForObject(monitor, owner)
+
+	return monitor
+}
+
+// MonitorSpecEqual returns true if the specs of the provided monitors are equal.
+func MonitorSpecEqual(m1, m2 *unstructured.Unstructured) bool {
+	return equality.Semantic.DeepEqual(m1.Object["spec"], m2.Object["spec"])
+}
@@ -0,0 +1,140 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+)
+
+func TestGenerateMonitorForDataPlane(t *testing.T) {
+	dataplane := &operatorv1beta1.DataPlane{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway-operator.konghq.com/v1beta1",
+			Kind:       "DataPlane",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dp-1",
+			Namespace: "default",
+			UID:       "dp-uid",
+		},
+		Status: operatorv1beta1.DataPlaneStatus{
+			Selector: "dp-selector",
+		},
+	}
+
+	testCases := []struct {
+		name                   string
+		opts                   operatorv1beta1.MonitoringOptions
+		expectedKind           string
+		expectedEndpointsField string
+		expectedSelector       map[string]any
+		expectedEndpoint       map[string]any
+	}{
+		{
+			name:                   "default kind is ServiceMonitor",
+			opts:                   operatorv1beta1.MonitoringOptions{},
+			expectedKind:           "ServiceMonitor",
+			expectedEndpointsField: "endpoints",
+			expectedSelector: map[string]any{
+				"app": "dp-1",
+				"gateway-operator.konghq.com/dataplane-service-type": "metrics",
+			},
+			expectedEndpoint: map[string]any{
+				"port": "metrics",
+				"path": "/metrics",
+			},
+		},
+		{
+			name: "PodMonitor with interval and scrape timeout",
+			opts: operatorv1beta1.MonitoringOptions{
+				Kind:          operatorv1beta1.MonitorKindPodMonitor,
+				Interval:      lo.ToPtr("30s"),
+				ScrapeTimeout: lo.ToPtr("10s"),
+			},
+			expectedKind:           "PodMonitor",
+			expectedEndpointsField: "podMetricsEndpoints",
+			expectedSelector: map[string]any{
+				"app":                                  "dp-1",
+				"gateway-operator.konghq.com/selector": "dp-selector",
+			},
+			expectedEndpoint: map[string]any{
+				"port":          "metrics",
+				"path":          "/metrics",
+				"interval":      "30s",
+				"scrapeTimeout": "10s",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			monitor := GenerateMonitorForDataPlane(dataplane, &tc.opts)
+			assert.Equal(t, "monitoring.coreos.com/v1", monitor.GetAPIVersion())
+			assert.Equal(t, tc.expectedKind, monitor.GetKind())
+			assert.Equal(t, "default", monitor.GetNamespace())
+			assert.Equal(t, "dataplane-dp-1-", monitor.GetGenerateName())
+			require.Len(t, monitor.GetOwnerReferences(), 1)
+			assert.Equal(t, dataplane.UID, monitor.GetOwnerReferences()[0].UID)
+
+			selector, ok, err := unstructured.NestedMap(monitor.Object, "spec", "selector", "matchLabels")
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedSelector, selector)
+
+			endpoints, ok, err := unstructured.NestedSlice(monitor.Object, "spec", tc.expectedEndpointsField)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Len(t, endpoints, 1)
+			assert.Equal(t, tc.expectedEndpoint, endpoints[0])
+		})
+	}
+}
+
+func TestGenerateMonitorForControlPlane(t *testing.T) {
+	cp := &operatorv1beta1.ControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway-operator.konghq.com/v1beta1",
+			Kind:       "ControlPlane",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp-1",
+			Namespace: "default",
+			UID:       "cp-uid",
+		},
+	}
+
+	monitor := GenerateMonitorForControlPlane(cp, &operatorv1beta1.MonitoringOptions{
+		Labels: map[string]string{
+			"release": "prometheus",
+		},
+	})
+	assert.Equal(t, "ServiceMonitor", monitor.GetKind())
+	assert.Equal(t, map[string]string{
+		"app":                                    "cp-1",
+		"release":                                "prometheus",
+		"gateway-operator.konghq.com/managed-by": "controlplane",
+		"konghq.com/gateway-operator":            "controlplane",
+	}, monitor.GetLabels())
+
+	selector, _, err := unstructured.NestedMap(monitor.Object, "spec", "selector", "matchLabels")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"app":                                 "cp-1",
+		"gateway-operator.konghq.com/service": "metrics",
+	}, selector)
+
+	endpoints, _, err := unstructured.NestedSlice(monitor.Object, "spec", "endpoints")
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{
+			"port": "metrics",
+			"path": "/metrics",
+		},
+	}, endpoints)
+}
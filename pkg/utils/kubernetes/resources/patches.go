@@ -0,0 +1,383 @@
+package resources
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/goccy/go-json"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+)
+
+// ApplyResourcePatches applies the user provided patches targeting the provided
+// kind and the object's name to the object, in order.
+// The object's name, namespace, owner references and the labels set on it before
+// the patches were applied are preserved.
+func ApplyResourcePatches(
+	obj client.Object,
+	kind operatorv1beta1.ResourcePatchTargetKind,
+	patches []operatorv1beta1.ResourcePatch,
+) error {
+	for i, p := range patches {
+		if !ResourcePatchTargets(p.Target, kind, obj) {
+			continue
+		}
+		if err := applyResourcePatch(obj, p); err != nil {
+			return fmt.Errorf("failed applying patch %d to %s %s: %w", i, kind, resourcePatchObjectName(obj), err)
+		}
+	}
+	return nil
+}
+
+// ResourcePatchTargets returns true if the provided target selects the object of the provided kind.
+func ResourcePatchTargets(
+	target operatorv1beta1.ResourcePatchTarget,
+	kind operatorv1beta1.ResourcePatchTargetKind,
+	obj client.Object,
+) bool {
+	if target.Kind != kind {
+		return false
+	}
+	return target.Name == "" ||
+		target.Name == obj.GetName() ||
+		(obj.GetGenerateName() != "" && target.Name == obj.GetGenerateName())
+}
+
+// EnsureResourcePatchesAreApplied enforces on the existing object the fields
+// set by the user provided patches targeting the provided kind and the generated
+// object's name. Their values are taken from the generated object, to which
+// the patches are expected to have already been applied, so that patches are
+// never applied twice.
+//
+// Only the fields touched by the patches are enforced: the fields at the paths
+// of JSON patch operations and the fields set in strategic merge patch documents.
+// Lists are enforced as a whole, keeping the fields of their elements which are
+// not set in the generated object (e.g. node ports assigned to Service ports).
+// Metadata other than labels and annotations is never changed.
+//
+// This complements the callers' comparison of the fields they reconcile, which
+// doesn't cover all the fields the patches can set.
+// It returns true if the existing object was changed.
+func EnsureResourcePatchesAreApplied(
+	existing client.Object,
+	generated client.Object,
+	kind operatorv1beta1.ResourcePatchTargetKind,
+	patches []operatorv1beta1.ResourcePatch,
+) (bool, error) {
+	var paths [][]string
+	for i, p := range patches {
+		if !ResourcePatchTargets(p.Target, kind, generated) {
+			continue
+		}
+		patchPaths, err := resourcePatchPaths(p)
+		if err != nil {
+			return false, fmt.Errorf("failed enforcing patch %d on %s %s: %w", i, kind, existing.GetName(), err)
+		}
+		paths = append(paths, patchPaths...)
+	}
+	if len(paths) == 0 {
+		return false, nil
+	}
+
+	existingDoc, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	if err != nil {
+		return false, fmt.Errorf("failed converting %s %s: %w", kind, existing.GetName(), err)
+	}
+	generatedDoc, err := runtime.DefaultUnstructuredConverter.ToUnstructured(generated)
+	if err != nil {
+		return false, fmt.Errorf("failed converting generated %s %s: %w", kind, resourcePatchObjectName(generated), err)
+	}
+
+	updatedDoc := runtime.DeepCopyJSON(existingDoc)
+	for _, path := range paths {
+		if !isEnforceableResourcePatchPath(path) {
+			continue
+		}
+		path = truncateResourcePatchPathToList(path, generatedDoc, existingDoc)
+		if v, ok := getResourcePatchPath(generatedDoc, path); ok {
+			if list, ok := v.([]any); ok {
+				existingList, _ := getResourcePatchPath(existingDoc, path)
+				v = mergeResourcePatchListElements(list, existingList)
+			}
+			setResourcePatchPath(updatedDoc, path, runtime.DeepCopyJSONValue(v))
+		} else {
+			removeResourcePatchPath(updatedDoc, path)
+		}
+	}
+
+	if reflect.DeepEqual(existingDoc, updatedDoc) {
+		return false, nil
+	}
+
+	v := reflect.ValueOf(existing).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(updatedDoc, existing); err != nil {
+		return false, fmt.Errorf("failed converting patched %s %s: %w", kind, existing.GetName(), err)
+	}
+	return true, nil
+}
+
+// resourcePatchPaths returns the paths of the fields touched by the provided patch.
+func resourcePatchPaths(p operatorv1beta1.ResourcePatch) ([][]string, error) {
+	patch, err := decodeResourcePatch(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths [][]string
+	switch p.Type {
+	case operatorv1beta1.ResourcePatchTypeJSONPatch:
+		for _, o := range patch.(jsonpatch.Patch) {
+			for _, pointer := range []func() (string, error){o.Path, o.From} {
+				// From is only set for move and copy operations.
+				if s, err := pointer(); err == nil && s != "" {
+					paths = append(paths, parseJSONPointer(s))
+				}
+			}
+		}
+	case operatorv1beta1.ResourcePatchTypeStrategicMerge:
+		var doc map[string]any
+		if err := json.Unmarshal(patch.([]byte), &doc); err != nil {
+			return nil, err
+		}
+		paths = strategicMergePatchPaths(doc, nil)
+	}
+	return paths, nil
+}
+
+// strategicMergePatchPaths returns the paths of the leaf fields set in the provided
+// strategic merge patch document. Objects holding patch directives (e.g. $patch)
+// are returned as a whole.
+func strategicMergePatchPaths(doc map[string]any, prefix []string) [][]string {
+	for k := range doc {
+		if strings.HasPrefix(k, "$") {
+			return [][]string{prefix}
+		}
+	}
+
+	var paths [][]string
+	for k, v := range doc {
+		path := append(slices.Clone(prefix), k)
+		if m, ok := v.(map[string]any); ok && len(m) > 0 {
+			paths = append(paths, strategicMergePatchPaths(m, path)...)
+			continue
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// parseJSONPointer splits the provided RFC 6901 JSON pointer into its unescaped tokens.
+func parseJSONPointer(pointer string) []string {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, t := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(t)
+	}
+	return tokens
+}
+
+// isEnforceableResourcePatchPath returns false for paths outside of the object's
+// spec and data, apart from its labels and annotations.
+func isEnforceableResourcePatchPath(path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+	switch path[0] {
+	case "apiVersion", "kind", "status":
+		return false
+	case "metadata":
+		return len(path) > 1 && (path[1] == "labels" || path[1] == "annotations")
+	default:
+		return true
+	}
+}
+
+// truncateResourcePatchPathToList truncates the provided path to the first list
+// it goes through in any of the provided documents.
+func truncateResourcePatchPathToList(path []string, docs ...map[string]any) []string {
+	for i := 1; i < len(path); i++ {
+		for _, doc := range docs {
+			if v, ok := getResourcePatchPath(doc, path[:i]); ok {
+				if _, isList := v.([]any); isList {
+					return path[:i]
+				}
+			}
+		}
+	}
+	return path
+}
+
+// mergeResourcePatchListElements returns the generated list with the fields of
+// the existing list's elements, at the same index, which are not set in the
+// generated elements.
+func mergeResourcePatchListElements(generated []any, existing any) []any {
+	existingList, _ := existing.([]any)
+	merged := make([]any, 0, len(generated))
+	for i, g := range generated {
+		gm, ok := g.(map[string]any)
+		if !ok || i >= len(existingList) {
+			merged = append(merged, g)
+			continue
+		}
+		em, ok := existingList[i].(map[string]any)
+		if !ok {
+			merged = append(merged, g)
+			continue
+		}
+		m := maps.Clone(em)
+		maps.Copy(m, gm)
+		merged = append(merged, m)
+	}
+	return merged
+}
+
+func getResourcePatchPath(doc map[string]any, path []string) (any, bool) {
+	var current any = doc
+	for _, token := range path {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[token]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func setResourcePatchPath(doc map[string]any, path []string, value any) {
+	current := doc
+	for _, token := range path[:len(path)-1] {
+		next, ok := current[token].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			current[token] = next
+		}
+		current = next
+	}
+	current[path[len(path)-1]] = value
+}
+
+func removeResourcePatchPath(doc map[string]any, path []string) {
+	parent, ok := getResourcePatchPath(doc, path[:len(path)-1])
+	if !ok {
+		return
+	}
+	if m, ok := parent.(map[string]any); ok {
+		delete(m, path[len(path)-1])
+	}
+}
+
+// ValidateResourcePatches checks that the provided patches target one of the
+// provided kinds and that their patch documents can be decoded according to
+// their types.
+func ValidateResourcePatches(
+	patches []operatorv1beta1.ResourcePatch,
+	kinds ...operatorv1beta1.ResourcePatchTargetKind,
+) error {
+	for i, p := range patches {
+		if !slices.Contains(kinds, p.Target.Kind) {
+			return fmt.Errorf("patch %d: unsupported target kind %q", i, p.Target.Kind)
+		}
+		if _, err := decodeResourcePatch(p); err != nil {
+			return fmt.Errorf("patch %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func applyResourcePatch(obj client.Object, p operatorv1beta1.ResourcePatch) error {
+	patch, err := decodeResourcePatch(p)
+	if err != nil {
+		return err
+	}
+
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	var patched []byte
+	switch p.Type {
+	case operatorv1beta1.ResourcePatchTypeJSONPatch:
+		patched, err = patch.(jsonpatch.Patch).Apply(original)
+	case operatorv1beta1.ResourcePatchTypeStrategicMerge:
+		patched, err = strategicpatch.StrategicMergePatch(original, patch.([]byte), obj)
+	}
+	if err != nil {
+		return err
+	}
+
+	var (
+		name            = obj.GetName()
+		generateName    = obj.GetGenerateName()
+		namespace       = obj.GetNamespace()
+		ownerReferences = obj.GetOwnerReferences()
+		labels          = maps.Clone(obj.GetLabels())
+	)
+
+	// Reset the object so that fields removed by the patch are not retained.
+	v := reflect.ValueOf(obj).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	if err := json.Unmarshal(patched, obj); err != nil {
+		return fmt.Errorf("failed to unmarshal patched object: %w", err)
+	}
+
+	obj.SetName(name)
+	obj.SetGenerateName(generateName)
+	obj.SetNamespace(namespace)
+	obj.SetOwnerReferences(ownerReferences)
+	if len(labels) > 0 {
+		patchedLabels := obj.GetLabels()
+		if patchedLabels == nil {
+			patchedLabels = make(map[string]string, len(labels))
+		}
+		maps.Copy(patchedLabels, labels)
+		obj.SetLabels(patchedLabels)
+	}
+	return nil
+}
+
+// decodeResourcePatch decodes the patch document of the provided patch. It returns
+// a jsonpatch.Patch for JSONPatch patches and the JSON document for StrategicMerge patches.
+func decodeResourcePatch(p operatorv1beta1.ResourcePatch) (any, error) {
+	doc, err := yaml.YAMLToJSON([]byte(p.Patch))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse patch: %w", err)
+	}
+
+	switch p.Type {
+	case operatorv1beta1.ResourcePatchTypeJSONPatch:
+		patch, err := jsonpatch.DecodePatch(doc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON patch: %w", err)
+		}
+		if len(patch) == 0 {
+			return nil, fmt.Errorf("JSON patch has no operations")
+		}
+		return patch, nil
+	case operatorv1beta1.ResourcePatchTypeStrategicMerge:
+		var m map[string]any
+		if err := json.Unmarshal(doc, &m); err != nil || m == nil {
+			return nil, fmt.Errorf("strategic merge patch must be an object")
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unsupported patch type %q", p.Type)
+	}
+}
+
+func resourcePatchObjectName(obj client.Object) string {
+	if name := obj.GetName(); name != "" {
+		return name
+	}
+	return obj.GetGenerateName()
+}
@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+)
+
+func TestApplyResourcePatches(t *testing.T) {
+	newService := func() *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "default",
+				GenerateName:    "dataplane-admin-dp-",
+				Labels:          map[string]string{"app": "dp"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "DataPlane", Name: "dp", UID: "dp-uid"}},
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: map[string]string{"app": "dp"},
+				Ports: []corev1.ServicePort{
+					{Name: "admin", Port: 8444},
+					{Name: "status", Port: 8100},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		kind     operatorv1beta1.ResourcePatchTargetKind
+		patches  []operatorv1beta1.ResourcePatch
+		expected func() *corev1.Service
+		errMsg   string
+	}{
+		{
+			name: "JSON patch",
+			kind: operatorv1beta1.ResourcePatchTargetKindService,
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "remove", "path": "/spec/ports/1"}, {"op": "add", "path": "/spec/publishNotReadyAddresses", "value": true}]`,
+				},
+			},
+			expected: func() *corev1.Service {
+				svc := newService()
+				svc.Spec.Ports = svc.Spec.Ports[:1]
+				svc.Spec.PublishNotReadyAddresses = true
+				return svc
+			},
+		},
+		{
+			name: "YAML strategic merge patch targeting the name prefix",
+			kind: operatorv1beta1.ResourcePatchTargetKindService,
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService, Name: "dataplane-admin-dp-"},
+					Type:   operatorv1beta1.ResourcePatchTypeStrategicMerge,
+					Patch:  "metadata:\n  annotations:\n    team: gateway\nspec:\n  ports:\n  - port: 8444\n    targetPort: 9444\n",
+				},
+			},
+			expected: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations = map[string]string{"team": "gateway"}
+				svc.Spec.Ports[0].TargetPort = intstr.FromInt(9444)
+				return svc
+			},
+		},
+		{
+			name: "patches not targeting the object are skipped",
+			kind: operatorv1beta1.ResourcePatchTargetKindService,
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService, Name: "other"},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "remove", "path": "/spec/ports"}]`,
+				},
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindDeployment},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "remove", "path": "/spec/ports"}]`,
+				},
+			},
+			expected: newService,
+		},
+		{
+			name: "name, namespace, owner references and labels are preserved",
+			kind: operatorv1beta1.ResourcePatchTargetKindService,
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch: `[
+						{"op": "replace", "path": "/metadata/namespace", "value": "other"},
+						{"op": "remove", "path": "/metadata/ownerReferences"},
+						{"op": "replace", "path": "/metadata/labels", "value": {"app": "other", "team": "gateway"}}
+					]`,
+				},
+			},
+			expected: func() *corev1.Service {
+				svc := newService()
+				svc.Labels["team"] = "gateway"
+				return svc
+			},
+		},
+		{
+			name: "failing JSON patch",
+			kind: operatorv1beta1.ResourcePatchTargetKindService,
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "test", "path": "/spec/type", "value": "LoadBalancer"}]`,
+				},
+			},
+			errMsg: "failed applying patch 0 to Service dataplane-admin-dp-",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newService()
+			err := ApplyResourcePatches(svc, tc.kind, tc.patches)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected(), svc)
+		})
+	}
+}
+
+func TestEnsureResourcePatchesAreApplied(t *testing.T) {
+	newService := func() *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    "default",
+				GenerateName: "dataplane-admin-dp-",
+				Labels:       map[string]string{"app": "dp"},
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeNodePort,
+				Selector: map[string]string{"app": "dp"},
+				Ports: []corev1.ServicePort{
+					{Name: "admin", Port: 8444},
+					{Name: "status", Port: 8100},
+				},
+			},
+		}
+	}
+	newExisting := func() *corev1.Service {
+		svc := newService()
+		svc.Name = "dataplane-admin-dp-abcde"
+		svc.ResourceVersion = "1"
+		svc.Annotations = map[string]string{"added-by-other-controller": "just-preserve-it"}
+		svc.Spec.ClusterIP = "10.0.0.1"
+		svc.Spec.SessionAffinity = corev1.ServiceAffinityNone
+		svc.Spec.Ports[0].NodePort = 30444
+		svc.Spec.Ports[1].NodePort = 30100
+		return svc
+	}
+
+	testCases := []struct {
+		name     string
+		patches  []operatorv1beta1.ResourcePatch
+		existing func() *corev1.Service
+		updated  bool
+		expected func() *corev1.Service
+	}{
+		{
+			name: "strategic merge patch fields are enforced",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeStrategicMerge,
+					Patch:  "metadata:\n  annotations:\n    team: gateway\nspec:\n  sessionAffinity: ClientIP\n",
+				},
+			},
+			existing: newExisting,
+			updated:  true,
+			expected: func() *corev1.Service {
+				svc := newExisting()
+				svc.Annotations["team"] = "gateway"
+				svc.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+				return svc
+			},
+		},
+		{
+			name: "JSON patch removing a list element enforces the list, keeping fields assigned by the cluster",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "remove", "path": "/spec/ports/1"}]`,
+				},
+			},
+			existing: newExisting,
+			updated:  true,
+			expected: func() *corev1.Service {
+				svc := newExisting()
+				svc.Spec.Ports = svc.Spec.Ports[:1]
+				return svc
+			},
+		},
+		{
+			name: "strategic merge patch removing a field enforces its removal",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeStrategicMerge,
+					Patch:  "metadata:\n  annotations:\n    added-by-other-controller: null\n",
+				},
+			},
+			existing: newExisting,
+			updated:  true,
+			expected: func() *corev1.Service {
+				svc := newExisting()
+				svc.Annotations = map[string]string{}
+				return svc
+			},
+		},
+		{
+			name: "up to date object is not changed",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "add", "path": "/spec/sessionAffinity", "value": "ClientIP"}]`,
+				},
+			},
+			existing: func() *corev1.Service {
+				svc := newExisting()
+				svc.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+				return svc
+			},
+			expected: func() *corev1.Service {
+				svc := newExisting()
+				svc.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+				return svc
+			},
+		},
+		{
+			name: "metadata other than labels and annotations is not changed",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "replace", "path": "/metadata/namespace", "value": "other"}, {"op": "add", "path": "/metadata/name", "value": "other"}]`,
+				},
+			},
+			existing: newExisting,
+			expected: newExisting,
+		},
+		{
+			name: "patches not targeting the object are skipped",
+			patches: []operatorv1beta1.ResourcePatch{
+				{
+					Target: operatorv1beta1.ResourcePatchTarget{Kind: operatorv1beta1.ResourcePatchTargetKindService, Name: "other"},
+					Type:   operatorv1beta1.ResourcePatchTypeJSONPatch,
+					Patch:  `[{"op": "add", "path": "/spec/sessionAffinity", "value": "ClientIP"}]`,
+				},
+			},
+			existing: newExisting,
+			expected: newExisting,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			generated := newService()
+			require.NoError(t, ApplyResourcePatches(generated, operatorv1beta1.ResourcePatchTargetKindService, tc.patches))
+
+			existing := tc.existing()
+			updated, err := EnsureResourcePatchesAreApplied(existing, generated, operatorv1beta1.ResourcePatchTargetKindService, tc.patches)
+			require.NoError(t, err)
+			require.Equal(t, tc.updated, updated)
+			require.Equal(t, tc.expected(), existing)
+		})
+	}
+}
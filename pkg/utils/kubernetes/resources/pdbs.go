@@ -48,5 +48,9 @@ func GeneratePodDisruptionBudgetForDataPlane(dataplane *operatorv1beta1.DataPlan
 
 	k8sutils.SetOwnerForObject(pdb, dataplane)
 
+	if err := ApplyResourcePatches(pdb, operatorv1beta1.ResourcePatchTargetKindPodDisruptionBudget, dataplane.Spec.Patches); err != nil {
+		return nil, err
+	}
+
 	return pdb, nil
 }
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+)
+
+// -----------------------------------------------------------------------------
+// KEDA ScaledObject generators
+// -----------------------------------------------------------------------------
+
+// KEDAGroupVersion is the group version of the KEDA resources.
+var KEDAGroupVersion = schema.GroupVersion{Group: "keda.sh", Version: "v1alpha1"}
+
+const (
+	// ScaledObjectKind is the kind of the KEDA ScaledObject resource.
+	ScaledObjectKind = "ScaledObject"
+
+	// dataPlaneRequestRateQuery is the Prometheus query template used for
+	// the RequestRate triggers. It has to be formatted with the namespace
+	// and the pod name regex of the DataPlane.
+	dataPlaneRequestRateQuery = `sum(rate(kong_http_requests_total{namespace="%s",pod=~"%s"}[1m]))`
+
+	// dataPlaneActiveConnectionsQuery is the Prometheus query template used for
+	// the ActiveConnections triggers. It has to be formatted with the namespace
+	// and the pod name regex of the DataPlane.
+	dataPlaneActiveConnectionsQuery = `sum(kong_nginx_connections_total{namespace="%s",pod=~"%s",state="active"})`
+)
+
+// ScaledObjectGVR returns the GroupVersionResource of the KEDA ScaledObject resource.
+func ScaledObjectGVR() schema.GroupVersionResource {
+	return KEDAGroupVersion.WithResource("scaledobjects")
+}
+
+// GenerateScaledObjectForDataPlane generates a KEDA ScaledObject for the given DataPlane.
+// The provided deploymentName is the name of the Deployment that the ScaledObject
+// will target using its scaleTargetRef.
+func GenerateScaledObjectForDataPlane(dataplane *operatorv1beta1.DataPlane, deploymentName string) (
+	*unstructured.Unstructured, error,
+) {
+	scaling := dataplane.Spec.Deployment.DeploymentOptions.Scaling
+	if scaling == nil || scaling.EventDrivenScaling == nil {
+		return nil, fmt.Errorf("cannot generate ScaledObject for DataPlane %s which doesn't have event-driven autoscaling turned on", dataplane.Name)
+	}
+	eventDriven := scaling.EventDrivenScaling
+
+	// Pods of a Deployment are named <deployment name>-<pod template hash>-<suffix>.
+	podRegex := fmt.Sprintf("%s-[a-z0-9]+-[a-z0-9]+", deploymentName)
+	triggers := make([]any, 0, len(eventDriven.Triggers)+len(eventDriven.Schedules))
+	for _, t := range eventDriven.Triggers {
+		var query string
+		switch t.Type {
+		case operatorv1beta1.EventDrivenScalingTriggerTypeRequestRate:
+			query = fmt.Sprintf(dataPlaneRequestRateQuery, dataplane.Namespace, podRegex)
+		case operatorv1beta1.EventDrivenScalingTriggerTypeActiveConnections:
+			query = fmt.Sprintf(dataPlaneActiveConnectionsQuery, dataplane.Namespace, podRegex)
+		case operatorv1beta1.EventDrivenScalingTriggerTypePrometheus:
+			if t.Query == nil {
+				return nil, fmt.Errorf("query is required for %s triggers", t.Type)
+			}
+			query = *t.Query
+		default:
+			return nil, fmt.Errorf("unsupported event-driven scaling trigger type %s", t.Type)
+		}
+		triggers = append(triggers, map[string]any{
+			"type": "prometheus",
+			"metadata": map[string]any{
+				"serverAddress": t.ServerAddress,
+				"query":         query,
+				"threshold":     t.Threshold,
+			},
+		})
+	}
+	for _, s := range eventDriven.Schedules {
+		triggers = append(triggers, map[string]any{
+			"type": "cron",
+			"metadata": map[string]any{
+				"timezone":        s.Timezone,
+				"start":           s.Start,
+				"end":             s.End,
+				"desiredReplicas": fmt.Sprintf("%d", s.MinReplicas),
+			},
+		})
+	}
+
+	spec := map[string]any{
+		"scaleTargetRef": map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       deploymentName,
+		},
+		"maxReplicaCount": int64(eventDriven.MaxReplicas),
+		"triggers":        triggers,
+	}
+	if eventDriven.MinReplicas != nil {
+		spec["minReplicaCount"] = int64(*eventDriven.MinReplicas)
+	}
+	if eventDriven.PollingInterval != nil {
+		spec["pollingInterval"] = int64(*eventDriven.PollingInterval)
+	}
+	if eventDriven.CooldownPeriod != nil {
+		spec["cooldownPeriod"] = int64(*eventDriven.CooldownPeriod)
+	}
+
+	scaledObject := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": spec,
+		},
+	}
+	scaledObject.SetGroupVersionKind(KEDAGroupVersion.WithKind(ScaledObjectKind))
+	scaledObject.SetNamespace(dataplane.Namespace)
+	scaledObject.SetName(dataplane.Name)
+
+	labels := GetManagedLabelForOwner(dataplane)
+	labels["app"] = dataplane.Name
+	scaledObject.SetLabels(labels)
+	k8sutils.SetOwnerForObject(scaledObject, dataplane)
+
+	return scaledObject, nil
+}
+
+// ScaledObjectSpecEqual returns true if the specs of the provided ScaledObjects are equal.
+func ScaledObjectSpecEqual(so1, so2 *unstructured.Unstructured) bool {
+	return equality.Semantic.DeepEqual(so1.Object["spec"], so2.Object["spec"])
+}
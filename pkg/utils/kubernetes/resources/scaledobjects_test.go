@@ -0,0 +1,158 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	operatorv1beta1 "github.com/kong/gateway-operator/api/v1beta1"
+)
+
+func TestGenerateScaledObjectForDataPlane(t *testing.T) {
+	newDataPlane := func(scaling *operatorv1beta1.Scaling) *operatorv1beta1.DataPlane {
+		dp := &operatorv1beta1.DataPlane{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "gateway-operator.konghq.com/v1beta1",
+				Kind:       "DataPlane",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dp-1",
+				Namespace: "default",
+				UID:       "dp-uid",
+			},
+		}
+		dp.Spec.Deployment.Scaling = scaling
+		return dp
+	}
+
+	t.Run("no event-driven scaling returns an error", func(t *testing.T) {
+		_, err := GenerateScaledObjectForDataPlane(newDataPlane(nil), "dataplane-dp-1-abcde")
+		require.Error(t, err)
+	})
+
+	t.Run("prometheus trigger without a query returns an error", func(t *testing.T) {
+		_, err := GenerateScaledObjectForDataPlane(newDataPlane(&operatorv1beta1.Scaling{
+			EventDrivenScaling: &operatorv1beta1.EventDrivenScaling{
+				MaxReplicas: 5,
+				Triggers: []operatorv1beta1.EventDrivenScalingTrigger{
+					{
+						Type:          operatorv1beta1.EventDrivenScalingTriggerTypePrometheus,
+						ServerAddress: "http://prometheus.monitoring:9090",
+						Threshold:     "100",
+					},
+				},
+			},
+		}), "dataplane-dp-1-abcde")
+		require.Error(t, err)
+	})
+
+	t.Run("triggers and schedules are rendered", func(t *testing.T) {
+		dp := newDataPlane(&operatorv1beta1.Scaling{
+			EventDrivenScaling: &operatorv1beta1.EventDrivenScaling{
+				MinReplicas:     lo.ToPtr(int32(2)),
+				MaxReplicas:     10,
+				PollingInterval: lo.ToPtr(int32(15)),
+				CooldownPeriod:  lo.ToPtr(int32(120)),
+				Triggers: []operatorv1beta1.EventDrivenScalingTrigger{
+					{
+						Type:          operatorv1beta1.EventDrivenScalingTriggerTypeRequestRate,
+						ServerAddress: "http://prometheus.monitoring:9090",
+						Threshold:     "100",
+					},
+					{
+						Type:          operatorv1beta1.EventDrivenScalingTriggerTypeActiveConnections,
+						ServerAddress: "http://prometheus.monitoring:9090",
+						Threshold:     "500",
+					},
+					{
+						Type:          operatorv1beta1.EventDrivenScalingTriggerTypePrometheus,
+						ServerAddress: "http://prometheus.monitoring:9090",
+						Threshold:     "0.5",
+						Query:         lo.ToPtr("sum(custom_metric)"),
+					},
+				},
+				Schedules: []operatorv1beta1.EventDrivenScalingSchedule{
+					{
+						Timezone:    "Europe/Warsaw",
+						Start:       "0 8 * * 1-5",
+						End:         "0 18 * * 1-5",
+						MinReplicas: 4,
+					},
+				},
+			},
+		})
+
+		so, err := GenerateScaledObjectForDataPlane(dp, "dataplane-dp-1-abcde")
+		require.NoError(t, err)
+
+		assert.Equal(t, "keda.sh/v1alpha1", so.GetAPIVersion())
+		assert.Equal(t, "ScaledObject", so.GetKind())
+		assert.Equal(t, "default", so.GetNamespace())
+		assert.Equal(t, "dp-1", so.GetName())
+		assert.Equal(t, "dp-1", so.GetLabels()["app"])
+		require.Len(t, so.GetOwnerReferences(), 1)
+		assert.Equal(t, dp.UID, so.GetOwnerReferences()[0].UID)
+
+		scaleTargetRef, _, err := unstructured.NestedStringMap(so.Object, "spec", "scaleTargetRef")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       "dataplane-dp-1-abcde",
+		}, scaleTargetRef)
+
+		for field, expected := range map[string]int64{
+			"minReplicaCount": 2,
+			"maxReplicaCount": 10,
+			"pollingInterval": 15,
+			"cooldownPeriod":  120,
+		} {
+			value, found, err := unstructured.NestedInt64(so.Object, "spec", field)
+			require.NoError(t, err)
+			require.True(t, found, field)
+			assert.Equal(t, expected, value, field)
+		}
+
+		triggers, _, err := unstructured.NestedSlice(so.Object, "spec", "triggers")
+		require.NoError(t, err)
+		assert.Equal(t, []any{
+			map[string]any{
+				"type": "prometheus",
+				"metadata": map[string]any{
+					"serverAddress": "http://prometheus.monitoring:9090",
+					"query":         `sum(rate(kong_http_requests_total{namespace="default",pod=~"dataplane-dp-1-abcde-[a-z0-9]+-[a-z0-9]+"}[1m]))`,
+					"threshold":     "100",
+				},
+			},
+			map[string]any{
+				"type": "prometheus",
+				"metadata": map[string]any{
+					"serverAddress": "http://prometheus.monitoring:9090",
+					"query":         `sum(kong_nginx_connections_total{namespace="default",pod=~"dataplane-dp-1-abcde-[a-z0-9]+-[a-z0-9]+",state="active"})`,
+					"threshold":     "500",
+				},
+			},
+			map[string]any{
+				"type": "prometheus",
+				"metadata": map[string]any{
+					"serverAddress": "http://prometheus.monitoring:9090",
+					"query":         "sum(custom_metric)",
+					"threshold":     "0.5",
+				},
+			},
+			map[string]any{
+				"type": "cron",
+				"metadata": map[string]any{
+					"timezone":        "Europe/Warsaw",
+					"start":           "0 8 * * 1-5",
+					"end":             "0 18 * * 1-5",
+					"desiredReplicas": "4",
+				},
+			},
+		}, triggers)
+	})
+}
@@ -65,6 +65,9 @@ func GenerateNewIngressServiceForDataPlane(dataplane *operatorv1beta1.DataPlane,
 			ExternalTrafficPolicy: getDataPlaneIngressServiceExternalTrafficPolicy(dataplane),
 		},
 	}
+	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		svc.Spec.LoadBalancerClass = getDataPlaneIngressServiceLoadBalancerClass(dataplane)
+	}
 	LabelObjectAsDataPlaneManaged(svc)
 
 	for _, opt := range opts {
@@ -82,6 +85,10 @@ func GenerateNewIngressServiceForDataPlane(dataplane *operatorv1beta1.DataPlane,
 	k8sutils.SetOwnerForObject(svc, dataplane)
 	controllerutil.AddFinalizer(svc, consts.DataPlaneOwnedWaitForOwnerFinalizer)
 
+	if err := ApplyResourcePatches(svc, operatorv1beta1.ResourcePatchTargetKindService, dataplane.Spec.Patches); err != nil {
+		return nil, err
+	}
+
 	return svc, nil
 }
 
@@ -105,7 +112,7 @@ var DefaultDataPlaneIngressServicePorts = []corev1.ServicePort{
 }
 
 func getDataPlaneIngressServiceType(dataplane *operatorv1beta1.DataPlane) corev1.ServiceType {
-	if dataplane == nil || dataplane.Spec.Network.Services == nil {
+	if dataplane == nil || dataplane.Spec.Network.Services == nil || dataplane.Spec.Network.Services.Ingress == nil {
 		return DefaultDataPlaneIngressServiceType
 	}
 
@@ -113,13 +120,87 @@ func getDataPlaneIngressServiceType(dataplane *operatorv1beta1.DataPlane) corev1
 }
 
 func getDataPlaneIngressServiceExternalTrafficPolicy(dataplane *operatorv1beta1.DataPlane) corev1.ServiceExternalTrafficPolicy {
-	if dataplane == nil || dataplane.Spec.Network.Services == nil {
+	if dataplane == nil || dataplane.Spec.Network.Services == nil || dataplane.Spec.Network.Services.Ingress == nil {
 		return corev1.ServiceExternalTrafficPolicyCluster
 	}
 
 	return dataplane.Spec.Network.Services.Ingress.ExternalTrafficPolicy
 }
 
+func getDataPlaneIngressServiceLoadBalancerClass(dataplane *operatorv1beta1.DataPlane) *string {
+	if dataplane == nil || dataplane.Spec.Network.Services == nil || dataplane.Spec.Network.Services.Ingress == nil {
+		return nil
+	}
+
+	return dataplane.Spec.Network.Services.Ingress.LoadBalancerClass
+}
+
+// GenerateNewAdditionalIngressServiceForDataPlane is a helper to generate one of
+// the additional dataplane ingress services, configured with the provided options.
+func GenerateNewAdditionalIngressServiceForDataPlane(
+	dataplane *operatorv1beta1.DataPlane,
+	serviceOpts operatorv1beta1.DataPlaneNamedServiceOptions,
+	opts ...ServiceOpt,
+) (*corev1.Service, error) {
+	serviceType := serviceOpts.Type
+	if serviceType == "" {
+		serviceType = DefaultDataPlaneIngressServiceType
+	}
+	externalTrafficPolicy := serviceOpts.ExternalTrafficPolicy
+	if externalTrafficPolicy == "" {
+		externalTrafficPolicy = corev1.ServiceExternalTrafficPolicyCluster
+	}
+	ports := DefaultDataPlaneIngressServicePorts
+	if len(serviceOpts.Ports) > 0 {
+		ports = servicePortsFromDataPlaneServicePorts(serviceOpts.Ports)
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    dataplane.Namespace,
+			GenerateName: k8sutils.TrimGenerateName(fmt.Sprintf("%s-ingress-%s-%s-", consts.DataPlanePrefix, serviceOpts.Name, dataplane.Name)),
+			Labels: map[string]string{
+				"app":                                   dataplane.Name,
+				consts.DataPlaneServiceTypeLabel:        string(consts.DataPlaneAdditionalIngressServiceLabelValue),
+				consts.DataPlaneIngressServiceNameLabel: serviceOpts.Name,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: serviceType,
+			Selector: map[string]string{
+				"app": dataplane.Name,
+			},
+			Ports:                 ports,
+			ExternalTrafficPolicy: externalTrafficPolicy,
+		},
+	}
+	if serviceType == corev1.ServiceTypeLoadBalancer {
+		svc.Spec.LoadBalancerClass = serviceOpts.LoadBalancerClass
+	}
+	LabelObjectAsDataPlaneManaged(svc)
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	if selectorOverride, ok := dataplane.Annotations[consts.ServiceSelectorOverrideAnnotation]; ok {
+		newSelector, err := getSelectorOverrides(selectorOverride)
+		if err != nil {
+			return nil, err
+		}
+		svc.Spec.Selector = newSelector
+	}
+
+	k8sutils.SetOwnerForObject(svc, dataplane)
+	controllerutil.AddFinalizer(svc, consts.DataPlaneOwnedWaitForOwnerFinalizer)
+
+	if err := ApplyResourcePatches(svc, operatorv1beta1.ResourcePatchTargetKindService, dataplane.Spec.Patches); err != nil {
+		return nil, err
+	}
+
+	return svc, nil
+}
+
 // ServiceOpt is an option function for a Service.
 type ServiceOpt func(*corev1.Service)
 
@@ -153,26 +234,30 @@ func ServicePortsFromDataPlaneIngressOpt(dataplane *operatorv1beta1.DataPlane) S
 			len(dataplane.Spec.Network.Services.Ingress.Ports) == 0 {
 			return
 		}
-		newPorts := make([]corev1.ServicePort, 0)
-		alreadyUsedPorts := make(map[int32]struct{})
-		for _, p := range dataplane.Spec.Network.Services.Ingress.Ports {
-			targetPort := intstr.FromInt(consts.DataPlaneProxyPort)
-			if !cmp.Equal(p.TargetPort, intstr.IntOrString{}) {
-				targetPort = p.TargetPort
-			}
-			if _, ok := alreadyUsedPorts[p.Port]; !ok {
-				newPorts = append(newPorts, corev1.ServicePort{
-					// Currently, only TCP protocol supported.
-					Name:       fmt.Sprintf("port-%d", p.Port),
-					Protocol:   corev1.ProtocolTCP,
-					Port:       p.Port,
-					TargetPort: targetPort,
-				})
-				alreadyUsedPorts[p.Port] = struct{}{}
-			}
+		service.Spec.Ports = servicePortsFromDataPlaneServicePorts(dataplane.Spec.Network.Services.Ingress.Ports)
+	}
+}
+
+func servicePortsFromDataPlaneServicePorts(ports []operatorv1beta1.DataPlaneServicePort) []corev1.ServicePort {
+	newPorts := make([]corev1.ServicePort, 0)
+	alreadyUsedPorts := make(map[int32]struct{})
+	for _, p := range ports {
+		targetPort := intstr.FromInt(consts.DataPlaneProxyPort)
+		if !cmp.Equal(p.TargetPort, intstr.IntOrString{}) {
+			targetPort = p.TargetPort
+		}
+		if _, ok := alreadyUsedPorts[p.Port]; !ok {
+			newPorts = append(newPorts, corev1.ServicePort{
+				// Currently, only TCP protocol supported.
+				Name:       fmt.Sprintf("port-%d", p.Port),
+				Protocol:   corev1.ProtocolTCP,
+				Port:       p.Port,
+				TargetPort: targetPort,
+			})
+			alreadyUsedPorts[p.Port] = struct{}{}
 		}
-		service.Spec.Ports = newPorts
 	}
+	return newPorts
 }
 
 // GenerateNewAdminServiceForDataPlane is a helper to generate the headless dataplane admin service
@@ -227,6 +312,11 @@ func GenerateNewAdminServiceForDataPlane(dataplane *operatorv1beta1.DataPlane, o
 
 	k8sutils.SetOwnerForObject(adminService, dataplane)
 	controllerutil.AddFinalizer(adminService, consts.DataPlaneOwnedWaitForOwnerFinalizer)
+
+	if err := ApplyResourcePatches(adminService, operatorv1beta1.ResourcePatchTargetKindService, dataplane.Spec.Patches); err != nil {
+		return nil, err
+	}
+
 	return adminService, nil
 }
 
@@ -279,5 +369,132 @@ func GenerateNewAdmissionWebhookServiceForControlPlane(cp *operatorv1beta1.Contr
 	LabelObjectAsControlPlaneManaged(svc)
 	k8sutils.SetOwnerForObject(svc, cp)
 
+	if err := ApplyResourcePatches(svc, operatorv1beta1.ResourcePatchTargetKindService, cp.Spec.Patches); err != nil {
+		return nil, err
+	}
+
+	return svc, nil
+}
+
+// GenerateNewMetricsServiceForControlPlane is a helper to generate the metrics service for a control
+// plane.
+func GenerateNewMetricsServiceForControlPlane(cp *operatorv1beta1.ControlPlane) (*corev1.Service, error) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    cp.Namespace,
+			GenerateName: k8sutils.TrimGenerateName(fmt.Sprintf("%s-metrics-%s-", consts.ControlPlanePrefix, cp.Name)),
+			Labels: map[string]string{
+				"app":                           cp.Name,
+				consts.ControlPlaneServiceLabel: consts.ControlPlaneServiceKindMetrics,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: map[string]string{"app": cp.Name},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       consts.ControlPlaneMetricsPortName,
+					Protocol:   corev1.ProtocolTCP,
+					Port:       consts.ControlPlaneMetricsPort,
+					TargetPort: intstr.FromString(consts.ControlPlaneMetricsPortName),
+				},
+			},
+		},
+	}
+	pkgapiscorev1.SetDefaults_Service(svc)
+	LabelObjectAsControlPlaneManaged(svc)
+	k8sutils.SetOwnerForObject(svc, cp)
+
+	if err := ApplyResourcePatches(svc, operatorv1beta1.ResourcePatchTargetKindService, cp.Spec.Patches); err != nil {
+		return nil, err
+	}
+
+	return svc, nil
+}
+
+// GenerateNewMetricsServiceForDataPlane is a helper to generate the metrics service
+// exposing the status port of a data plane.
+func GenerateNewMetricsServiceForDataPlane(dataplane *operatorv1beta1.DataPlane, opts ...ServiceOpt) (*corev1.Service, error) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    dataplane.Namespace,
+			GenerateName: k8sutils.TrimGenerateName(fmt.Sprintf("%s-metrics-%s-", consts.DataPlanePrefix, dataplane.Name)),
+			Labels: map[string]string{
+				"app":                            dataplane.Name,
+				consts.DataPlaneServiceTypeLabel: string(consts.DataPlaneMetricsServiceLabelValue),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: map[string]string{"app": dataplane.Name},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "metrics",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       consts.DataPlaneMetricsPort,
+					TargetPort: intstr.FromInt(consts.DataPlaneMetricsPort),
+				},
+			},
+		},
+	}
+	pkgapiscorev1.SetDefaults_Service(svc)
+	LabelObjectAsDataPlaneManaged(svc)
+	k8sutils.SetOwnerForObject(svc, dataplane)
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	if err := ApplyResourcePatches(svc, operatorv1beta1.ResourcePatchTargetKindService, dataplane.Spec.Patches); err != nil {
+		return nil, err
+	}
+
+	return svc, nil
+}
+
+// GenerateNewClusterServiceForDataPlane is a helper to generate the service
+// exposing the hybrid mode cluster and telemetry endpoints of a control plane
+// data plane.
+func GenerateNewClusterServiceForDataPlane(dataplane *operatorv1beta1.DataPlane, opts ...ServiceOpt) (*corev1.Service, error) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    dataplane.Namespace,
+			GenerateName: k8sutils.TrimGenerateName(fmt.Sprintf("%s-cluster-%s-", consts.DataPlanePrefix, dataplane.Name)),
+			Labels: map[string]string{
+				"app":                            dataplane.Name,
+				consts.DataPlaneServiceTypeLabel: string(consts.DataPlaneClusterServiceLabelValue),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: map[string]string{"app": dataplane.Name},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       consts.DataPlaneClusterServicePortName,
+					Protocol:   corev1.ProtocolTCP,
+					Port:       consts.DataPlaneClusterPort,
+					TargetPort: intstr.FromInt(consts.DataPlaneClusterPort),
+				},
+				{
+					Name:       consts.DataPlaneClusterTelemetryServicePortName,
+					Protocol:   corev1.ProtocolTCP,
+					Port:       consts.DataPlaneClusterTelemetryPort,
+					TargetPort: intstr.FromInt(consts.DataPlaneClusterTelemetryPort),
+				},
+			},
+		},
+	}
+	pkgapiscorev1.SetDefaults_Service(svc)
+	LabelObjectAsDataPlaneManaged(svc)
+	k8sutils.SetOwnerForObject(svc, dataplane)
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	if err := ApplyResourcePatches(svc, operatorv1beta1.ResourcePatchTargetKindService, dataplane.Spec.Patches); err != nil {
+		return nil, err
+	}
+
 	return svc, nil
 }
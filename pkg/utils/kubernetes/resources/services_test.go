@@ -227,3 +227,135 @@ func TestGenerateNewIngressServiceForDataPlane(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateNewAdditionalIngressServiceForDataPlane(t *testing.T) {
+	dataplane := &operatorv1beta1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dp-1",
+			Namespace: "default",
+			UID:       types.UID("1234"),
+		},
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.konghq.com/v1beta1",
+			Kind:       "DataPlane",
+		},
+	}
+	expectedObjectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			GenerateName: "dataplane-ingress-" + name + "-dp-1-",
+			Namespace:    "default",
+			Labels: map[string]string{
+				"app": "dp-1",
+				"gateway-operator.konghq.com/dataplane-service-type":         "additional-ingress",
+				"gateway-operator.konghq.com/dataplane-ingress-service-name": name,
+				"gateway-operator.konghq.com/managed-by":                     "dataplane",
+				"konghq.com/gateway-operator":                                "dataplane",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "gateway.konghq.com/v1beta1",
+					Kind:       "DataPlane",
+					Name:       "dp-1",
+					UID:        "1234",
+					Controller: lo.ToPtr(true),
+				},
+			},
+			Finalizers: []string{
+				"gateway-operator.konghq.com/wait-for-owner",
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		serviceOpts operatorv1beta1.DataPlaneNamedServiceOptions
+		expectedSvc *corev1.Service
+	}{
+		{
+			name: "defaults",
+			serviceOpts: operatorv1beta1.DataPlaneNamedServiceOptions{
+				Name: "public",
+			},
+			expectedSvc: &corev1.Service{
+				ObjectMeta: expectedObjectMeta("public"),
+				Spec: corev1.ServiceSpec{
+					Type:  corev1.ServiceTypeLoadBalancer,
+					Ports: DefaultDataPlaneIngressServicePorts,
+					Selector: map[string]string{
+						"app": "dp-1",
+					},
+					ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyTypeCluster,
+				},
+			},
+		},
+		{
+			name: "LoadBalancer with load balancer class and ports",
+			serviceOpts: operatorv1beta1.DataPlaneNamedServiceOptions{
+				Name: "public",
+				DataPlaneServiceOptions: operatorv1beta1.DataPlaneServiceOptions{
+					Ports: []operatorv1beta1.DataPlaneServicePort{
+						{
+							Port:       8443,
+							TargetPort: intstr.FromInt(8443),
+						},
+					},
+					ServiceOptions: operatorv1beta1.ServiceOptions{
+						Type:                  corev1.ServiceTypeLoadBalancer,
+						ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyTypeLocal,
+						LoadBalancerClass:     lo.ToPtr("service.k8s.aws/nlb"),
+					},
+				},
+			},
+			expectedSvc: &corev1.Service{
+				ObjectMeta: expectedObjectMeta("public"),
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Name:       "port-8443",
+							Protocol:   corev1.ProtocolTCP,
+							Port:       8443,
+							TargetPort: intstr.FromInt(8443),
+						},
+					},
+					Selector: map[string]string{
+						"app": "dp-1",
+					},
+					ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyTypeLocal,
+					LoadBalancerClass:     lo.ToPtr("service.k8s.aws/nlb"),
+				},
+			},
+		},
+		{
+			name: "load balancer class is not set for ClusterIP",
+			serviceOpts: operatorv1beta1.DataPlaneNamedServiceOptions{
+				Name: "internal",
+				DataPlaneServiceOptions: operatorv1beta1.DataPlaneServiceOptions{
+					ServiceOptions: operatorv1beta1.ServiceOptions{
+						Type:              corev1.ServiceTypeClusterIP,
+						LoadBalancerClass: lo.ToPtr("service.k8s.aws/nlb"),
+					},
+				},
+			},
+			expectedSvc: &corev1.Service{
+				ObjectMeta: expectedObjectMeta("internal"),
+				Spec: corev1.ServiceSpec{
+					Type:  corev1.ServiceTypeClusterIP,
+					Ports: DefaultDataPlaneIngressServicePorts,
+					Selector: map[string]string{
+						"app": "dp-1",
+					},
+					ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyTypeCluster,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, err := GenerateNewAdditionalIngressServiceForDataPlane(dataplane, tc.serviceOpts)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSvc, svc)
+		})
+	}
+}
@@ -55,6 +55,23 @@ func SetCondition(condition metav1.Condition, resource ConditionsAware) {
 	resource.SetConditions(newConditions)
 }
 
+// RemoveCondition removes the condition with the given type from the provided
+// resource. It returns true if the condition was found and removed.
+func RemoveCondition(cType consts.ConditionType, resource ConditionsAware) bool {
+	conditions := resource.GetConditions()
+	newConditions := make([]metav1.Condition, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition.Type != string(cType) {
+			newConditions = append(newConditions, condition)
+		}
+	}
+	if len(newConditions) == len(conditions) {
+		return false
+	}
+	resource.SetConditions(newConditions)
+	return true
+}
+
 // GetCondition returns the condition with the given type, if it exists. If the condition does not exists it returns false.
 func GetCondition(cType consts.ConditionType, resource ConditionsAware) (metav1.Condition, bool) {
 	for _, condition := range resource.GetConditions() {
@@ -181,7 +198,8 @@ func SetAcceptedConditionOnGateway(resource ConditionsAndListenerConditionsAndGe
 
 func areAllConditionsHaveTrueStatus(resource ConditionsAware) bool {
 	for _, condition := range resource.GetConditions() {
-		if condition.Type == string(gatewayv1.GatewayConditionProgrammed) {
+		if condition.Type == string(gatewayv1.GatewayConditionProgrammed) ||
+			condition.Type == string(consts.KonnectConnectedType) {
 			continue
 		}
 		if condition.Type != string(consts.ReadyType) && condition.Status != metav1.ConditionTrue {
@@ -259,3 +277,25 @@ func NeedsUpdate(current, updated ConditionsAware) bool {
 	}
 	return false
 }
+
+// SetPausedCondition sets the Paused condition to True on the provided resource.
+// It returns false, without changing the resource, when the condition is already
+// set for the resource's current generation.
+func SetPausedCondition(resource ConditionsAndGenerationAware) bool {
+	if cond, ok := GetCondition(consts.PausedType, resource); ok &&
+		cond.Status == metav1.ConditionTrue &&
+		cond.ObservedGeneration == resource.GetGeneration() {
+		return false
+	}
+	SetCondition(
+		NewConditionWithGeneration(
+			consts.PausedType,
+			metav1.ConditionTrue,
+			consts.ReconciliationPausedReason,
+			consts.ReconciliationPausedMessage,
+			resource.GetGeneration(),
+		),
+		resource,
+	)
+	return true
+}
@@ -487,6 +487,20 @@ func TestSetReady(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"false_konnect_connected_is_ignored",
+			[]metav1.Condition{
+				{
+					Type:   "otherType",
+					Status: metav1.ConditionTrue,
+				},
+				{
+					Type:   string(consts.KonnectConnectedType),
+					Status: metav1.ConditionFalse,
+				},
+			},
+			true,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			resource := &TestResource{
@@ -510,6 +524,41 @@ func TestInitReady(t *testing.T) {
 	assert.NotEmpty(t, conditions[0].LastTransitionTime)
 }
 
+func TestRemoveCondition(t *testing.T) {
+	resource := &TestResource{
+		Conditions: []metav1.Condition{
+			{Type: string(consts.ReadyType), Status: metav1.ConditionTrue},
+			{Type: string(consts.PausedType), Status: metav1.ConditionTrue},
+		},
+	}
+
+	assert.True(t, RemoveCondition(consts.PausedType, resource))
+	assert.Equal(t, []metav1.Condition{
+		{Type: string(consts.ReadyType), Status: metav1.ConditionTrue},
+	}, resource.GetConditions())
+
+	assert.False(t, RemoveCondition(consts.PausedType, resource))
+	assert.Len(t, resource.GetConditions(), 1)
+}
+
+func TestSetPausedCondition(t *testing.T) {
+	resource := &TestResource{Generation: 1}
+
+	assert.True(t, SetPausedCondition(resource))
+	c, ok := GetCondition(consts.PausedType, resource)
+	assert.True(t, ok)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, string(consts.ReconciliationPausedReason), c.Reason)
+	assert.EqualValues(t, 1, c.ObservedGeneration)
+
+	assert.False(t, SetPausedCondition(resource), "condition is already set for the current generation")
+
+	resource.Generation = 2
+	assert.True(t, SetPausedCondition(resource))
+	c, _ = GetCondition(consts.PausedType, resource)
+	assert.EqualValues(t, 2, c.ObservedGeneration)
+}
+
 func TestNeedsUpdate(t *testing.T) {
 	defaultCondition := metav1.Condition{
 		Type:    "type",
@@ -22,6 +22,7 @@ import (
 
 	"github.com/kong/gateway-operator/api/v1beta1"
 	gwtypes "github.com/kong/gateway-operator/internal/types"
+	"github.com/kong/gateway-operator/internal/utils/gatewayclass"
 	"github.com/kong/gateway-operator/modules/manager/metadata"
 	"github.com/kong/gateway-operator/pkg/consts"
 	testutils "github.com/kong/gateway-operator/pkg/utils/test"
@@ -45,30 +46,9 @@ var skippedTestsForTraditionalCompatibleRouter = []string{
 }
 
 var (
-	commonSupportedFeatures = sets.New(
-		// core features
-		features.SupportHTTPRoute,
-		features.SupportGateway,
-		features.SupportReferenceGrant,
+	traditionalCompatibleRouterSupportedFeatures = gatewayclass.SupportedFeatures()
 
-		// Gateway extended
-		features.SupportGatewayPort8080,
-
-		// HTTPRoute extended
-		features.SupportHTTPRouteResponseHeaderModification,
-		features.SupportHTTPRoutePathRewrite,
-		features.SupportHTTPRouteHostRewrite,
-	)
-
-	traditionalCompatibleRouterSupportedFeatures = commonSupportedFeatures.Clone().Insert(
-	// add here the traditional compatible router specific features
-	)
-
-	expressionsRouterSupportedFeatures = commonSupportedFeatures.Clone().Insert(
-		// extended
-		features.SupportHTTPRouteMethodMatching,
-		features.SupportHTTPRouteQueryParamMatching,
-	)
+	expressionsRouterSupportedFeatures = gatewayclass.ExpressionsRouterSupportedFeatures()
 )
 
 type ConformanceConfig struct {
@@ -132,6 +112,7 @@ func TestGatewayConformance(t *testing.T) {
 	opts.Mode = mode
 	opts.ConformanceProfiles = sets.New(
 		suite.GatewayHTTPConformanceProfileName,
+		suite.GatewayGRPCConformanceProfileName,
 	)
 	opts.SupportedFeatures = supportedFeatures
 	opts.Implementation = conformancev1.Implementation{
@@ -182,6 +163,16 @@ func createGatewayConfiguration(ctx context.Context, t *testing.T, c Conformance
 												Name:  "KONG_ROUTER_FLAVOR",
 												Value: string(c.KongRouterFlavor),
 											},
+											{
+												// NOTE: GRPCRoute conformance tests send gRPC requests
+												// over cleartext HTTP/2 (h2c), hence http2 has to be
+												// enabled on the plain text proxy listener as well.
+												Name: "KONG_PROXY_LISTEN",
+												Value: fmt.Sprintf(
+													"0.0.0.0:%d http2 reuseport backlog=16384, 0.0.0.0:%d http2 ssl reuseport backlog=16384",
+													consts.DataPlaneProxyPort, consts.DataPlaneProxySSLPort,
+												),
+											},
 										},
 									},
 								},
@@ -76,6 +76,7 @@ func TestKongConsumerCredential_ACL(t *testing.T) {
 	}
 
 	factory := ops.NewMockSDKFactory(t)
+	mockKonnectEntitiesNotFoundByUID(factory.SDK)
 	factory.SDK.KongCredentialsACLSDK.EXPECT().
 		CreateACLWithConsumer(
 			mock.Anything,
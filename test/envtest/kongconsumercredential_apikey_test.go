@@ -75,6 +75,7 @@ func TestKongConsumerCredential_APIKey(t *testing.T) {
 	}
 
 	factory := ops.NewMockSDKFactory(t)
+	mockKonnectEntitiesNotFoundByUID(factory.SDK)
 	factory.SDK.KongCredentialsAPIKeySDK.EXPECT().
 		CreateKeyAuthWithConsumer(
 			mock.Anything,
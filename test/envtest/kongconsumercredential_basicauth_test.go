@@ -77,6 +77,7 @@ func TestKongConsumerCredential_BasicAuth(t *testing.T) {
 	}
 
 	factory := ops.NewMockSDKFactory(t)
+	mockKonnectEntitiesNotFoundByUID(factory.SDK)
 	factory.SDK.KongCredentialsBasicAuthSDK.EXPECT().
 		CreateBasicAuthWithConsumer(
 			mock.Anything,
@@ -75,6 +75,7 @@ func TestKongConsumerCredential_HMAC(t *testing.T) {
 	}
 
 	factory := ops.NewMockSDKFactory(t)
+	mockKonnectEntitiesNotFoundByUID(factory.SDK)
 	factory.SDK.KongCredentialsHMACSDK.EXPECT().
 		CreateHmacAuthWithConsumer(
 			mock.Anything,
@@ -75,6 +75,7 @@ func TestKongConsumerCredential_JWT(t *testing.T) {
 	}
 
 	factory := ops.NewMockSDKFactory(t)
+	mockKonnectEntitiesNotFoundByUID(factory.SDK)
 	factory.SDK.KongCredentialsJWTSDK.EXPECT().
 		CreateJwtWithConsumer(
 			mock.Anything,
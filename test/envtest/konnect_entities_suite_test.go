@@ -77,6 +77,7 @@ func testNewKonnectEntityReconciler[
 
 		cl := client.NewNamespacedClient(mgr.GetClient(), ns.Name)
 		factory := ops.NewMockSDKFactory(t)
+		mockKonnectEntitiesNotFoundByUID(factory.SDK)
 		sdk := factory.SDK
 		reconciler := konnect.NewKonnectEntityReconciler[T, TEnt](factory, false, cl)
 		require.NoError(t, reconciler.SetupWithManager(ctx, mgr))
@@ -0,0 +1,55 @@
+package envtest
+
+import (
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kong/gateway-operator/controller/konnect/ops"
+)
+
+// mockKonnectEntitiesNotFoundByUID sets up the mock SDK to report that there
+// are no entities in Konnect created for the reconciled objects when these are
+// looked up by their UID prior to being created.
+// The expectations are optional so that tests which don't create any entities
+// are not affected.
+func mockKonnectEntitiesNotFoundByUID(sdk *ops.MockSDKWrapper) {
+	sdk.ControlPlaneSDK.EXPECT().ListControlPlanes(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListControlPlanesResponse{ListControlPlanesResponse: &sdkkonnectcomp.ListControlPlanesResponse{}}, nil)
+	sdk.ServicesSDK.EXPECT().ListService(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListServiceResponse{Object: &sdkkonnectops.ListServiceResponseBody{}}, nil)
+	sdk.RoutesSDK.EXPECT().ListRoute(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListRouteResponse{Object: &sdkkonnectops.ListRouteResponseBody{}}, nil)
+	sdk.ConsumersSDK.EXPECT().ListConsumer(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListConsumerResponse{Object: &sdkkonnectops.ListConsumerResponseBody{}}, nil)
+	sdk.ConsumerGroupSDK.EXPECT().ListConsumerGroup(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListConsumerGroupResponse{Object: &sdkkonnectops.ListConsumerGroupResponseBody{}}, nil)
+	sdk.PluginSDK.EXPECT().ListPlugin(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListPluginResponse{Object: &sdkkonnectops.ListPluginResponseBody{}}, nil)
+	sdk.UpstreamsSDK.EXPECT().ListUpstream(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListUpstreamResponse{Object: &sdkkonnectops.ListUpstreamResponseBody{}}, nil)
+	sdk.TargetsSDK.EXPECT().ListTargetWithUpstream(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListTargetWithUpstreamResponse{Object: &sdkkonnectops.ListTargetWithUpstreamResponseBody{}}, nil)
+	sdk.VaultSDK.EXPECT().ListVault(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListVaultResponse{Object: &sdkkonnectops.ListVaultResponseBody{}}, nil)
+	sdk.KeysSDK.EXPECT().ListKey(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListKeyResponse{Object: &sdkkonnectops.ListKeyResponseBody{}}, nil)
+	sdk.KeySetsSDK.EXPECT().ListKeySet(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListKeySetResponse{Object: &sdkkonnectops.ListKeySetResponseBody{}}, nil)
+	sdk.CACertificatesSDK.EXPECT().ListCaCertificate(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListCaCertificateResponse{Object: &sdkkonnectops.ListCaCertificateResponseBody{}}, nil)
+	sdk.CertificatesSDK.EXPECT().ListCertificate(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListCertificateResponse{Object: &sdkkonnectops.ListCertificateResponseBody{}}, nil)
+	sdk.SNIsSDK.EXPECT().ListSni(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListSniResponse{Object: &sdkkonnectops.ListSniResponseBody{}}, nil)
+	sdk.KongCredentialsBasicAuthSDK.EXPECT().ListBasicAuth(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListBasicAuthResponse{Object: &sdkkonnectops.ListBasicAuthResponseBody{}}, nil)
+	sdk.KongCredentialsAPIKeySDK.EXPECT().ListKeyAuth(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListKeyAuthResponse{Object: &sdkkonnectops.ListKeyAuthResponseBody{}}, nil)
+	sdk.KongCredentialsACLSDK.EXPECT().ListACL(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListACLResponse{Object: &sdkkonnectops.ListACLResponseBody{}}, nil)
+	sdk.KongCredentialsJWTSDK.EXPECT().ListJwt(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListJwtResponse{Object: &sdkkonnectops.ListJwtResponseBody{}}, nil)
+	sdk.KongCredentialsHMACSDK.EXPECT().ListHmacAuth(mock.Anything, mock.Anything).Maybe().
+		Return(&sdkkonnectops.ListHmacAuthResponse{Object: &sdkkonnectops.ListHmacAuthResponseBody{}}, nil)
+}